@@ -0,0 +1,150 @@
+// Package classifier identifies licenses from their text.
+//
+// License texts are normalized, following the SPDX license matching guidelines, and compared against embedded SPDX
+// license templates. Variable text, such as the name of the copyright holder, and optional sections of the templates are
+// honoured, so licenses with modified headers or copyright notices are still recognised.
+package classifier
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+//go:generate go run ../cmd/templategen/main.go -dir templates -out template_db.go
+
+// DefaultThreshold is the confidence a match must reach, unless configured otherwise, for a license to be identified
+const DefaultThreshold = 0.8
+
+const (
+	// maxCandidates limits how many templates are compared in full against a text
+	maxCandidates = 5
+	// minCoverage is the proportion of a template's words which must appear in a text for it to be compared in full
+	minCoverage = 0.5
+	// tolerance is the difference in confidence below which the template explaining more of the text is preferred.
+	// Without it a text such as BSD-3-Clause, which contains the whole of BSD-2-Clause, could match the shorter license.
+	tolerance = 0.05
+)
+
+var (
+	parseOnce  sync.Once
+	templates  []*template
+	dict       dictionary
+	errParsing error
+)
+
+func loadTemplates() ([]*template, dictionary, error) {
+	parseOnce.Do(func() {
+		templates, dict, errParsing = parseTemplates(templateText)
+	})
+	return templates, dict, errParsing
+}
+
+// ErrNoMatch is returned when a text does not match any license with enough confidence
+var ErrNoMatch = errors.New("text does not match a known license")
+
+// Match describes the license which best matches a text
+type Match struct {
+	// Identifier is the SPDX identifier of the license
+	Identifier string
+	// Confidence is a value between 0 and 1 describing how closely the text matched the license
+	Confidence float64
+}
+
+// Config allows the behaviour of a Classifier to be customised
+type Config struct {
+	// Threshold is the minimum confidence required to identify a license. DefaultThreshold is used when zero.
+	Threshold float64
+}
+
+// Classifier identifies licenses from their text
+type Classifier struct {
+	config Config
+}
+
+// New returns a Classifier using the default configuration
+func New() *Classifier {
+	return NewWithOptions(Config{})
+}
+
+// NewWithOptions returns a Classifier using the provided configuration
+func NewWithOptions(c Config) *Classifier {
+	if c.Threshold <= 0 {
+		c.Threshold = DefaultThreshold
+	}
+	return &Classifier{c}
+}
+
+// Identifiers returns the SPDX identifiers of the licenses the classifier can recognise
+func Identifiers() []string {
+	out := make([]string, 0, len(templateText))
+	for identifier := range templateText {
+		out = append(out, identifier)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Classify returns the license which best matches the text provided. If no license matches with at least the
+// configured confidence, the best match found is returned alongside an error wrapping ErrNoMatch.
+func (c *Classifier) Classify(text []byte) (Match, error) {
+	tt, d, err := loadTemplates()
+	if err != nil {
+		return Match{}, err
+	}
+
+	words := tokenize(string(text))
+	if len(words) == 0 {
+		return Match{}, ErrNoMatch
+	}
+	ids := make([]int32, len(words))
+	present := make(map[int32]bool, len(words))
+	for i, w := range words {
+		ids[i] = d.lookup(w)
+		present[ids[i]] = true
+	}
+
+	type candidate struct {
+		t        *template
+		coverage float64
+	}
+	candidates := make([]candidate, 0, len(tt))
+	for _, t := range tt {
+		if cov := t.coverage(present); cov >= minCoverage {
+			candidates = append(candidates, candidate{t, cov})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].coverage > candidates[j].coverage
+	})
+	if len(candidates) > maxCandidates {
+		candidates = candidates[:maxCandidates]
+	}
+
+	type result struct {
+		Match
+		matched int
+	}
+	results := make([]result, len(candidates))
+	var best result
+	for i, cand := range candidates {
+		conf, matched := cand.t.compare(ids)
+		results[i] = result{Match{Identifier: cand.t.identifier, Confidence: conf}, matched}
+		if conf > best.Confidence {
+			best = results[i]
+		}
+	}
+	for _, r := range results {
+		if r.Confidence >= best.Confidence-tolerance && r.matched > best.matched {
+			best = r
+		}
+	}
+	if best.Confidence < c.config.Threshold {
+		if best.Identifier == "" {
+			return Match{}, ErrNoMatch
+		}
+		return best.Match, fmt.Errorf("%w: closest was %s with %.0f%% confidence", ErrNoMatch, best.Identifier, best.Confidence*100)
+	}
+	return best.Match, nil
+}
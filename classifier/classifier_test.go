@@ -0,0 +1,169 @@
+package classifier_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent/classifier"
+)
+
+const mitWithHeader = `The MIT License (MIT)
+
+Copyright (c) 2017 Senseye Ltd
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+`
+
+const bsd3 = `Copyright (c) 2009 The Go Authors. All rights reserved.
+
+Redistribution and use in source and binary forms, with or without
+modification, are permitted provided that the following conditions are
+met:
+
+   * Redistributions of source code must retain the above copyright
+notice, this list of conditions and the following disclaimer.
+   * Redistributions in binary form must reproduce the above
+copyright notice, this list of conditions and the following disclaimer
+in the documentation and/or other materials provided with the
+distribution.
+   * Neither the name of Google Inc. nor the names of its
+contributors may be used to endorse or promote products derived from
+this software without specific prior written permission.
+
+THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+"AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+(INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+`
+
+const isc = `ISC License
+
+Copyright (c) 2004-2010 by Internet Systems Consortium, Inc. ("ISC")
+
+Permission to use, copy, modify, and/or distribute this software for any
+purpose with or without fee is hereby granted, provided that the above
+copyright notice and this permission notice appear in all copies.
+
+THE SOFTWARE IS PROVIDED "AS IS" AND ISC DISCLAIMS ALL WARRANTIES
+WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL ISC BE LIABLE FOR
+ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT
+OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+`
+
+func TestClassify(t *testing.T) {
+	cases := []struct {
+		d             string
+		in            string
+		outIdentifier string
+		expFailure    bool
+	}{
+		{"MIT with modified header", mitWithHeader, "MIT", false},
+		{"MIT within a comment", "// " + strings.Replace(mitWithHeader, "\n", "\n// ", -1), "MIT", false},
+		{"BSD-3-Clause is not mistaken for BSD-2-Clause", bsd3, "BSD-3-Clause", false},
+		{"ISC with modified copyright holder", isc, "ISC", false},
+		{"unknown text", "this is not a license, it is a shopping list: eggs, milk and bread", "", true},
+		{"empty text", "", "", true},
+	}
+
+	target := classifier.New()
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			out, err := target.Classify([]byte(c.in))
+			if (err != nil) != c.expFailure {
+				t.Errorf("expecting error: %t, got %s", c.expFailure, err)
+			}
+			if !c.expFailure && out.Identifier != c.outIdentifier {
+				t.Errorf("expecting %s, got %+v", c.outIdentifier, out)
+			}
+		})
+	}
+}
+
+func TestClassifyThreshold(t *testing.T) {
+	// removing the warranty disclaimer leaves roughly two thirds of the license
+	in := mitWithHeader[:strings.Index(mitWithHeader, "THE SOFTWARE IS PROVIDED")]
+
+	out, err := classifier.New().Classify([]byte(in))
+	if !errors.Is(err, classifier.ErrNoMatch) {
+		t.Errorf("expected ErrNoMatch, got %v", err)
+	}
+	if out.Identifier != "MIT" {
+		t.Errorf("expected the closest match to be MIT, got %+v", out)
+	}
+
+	out, err = classifier.NewWithOptions(classifier.Config{Threshold: 0.5}).Classify([]byte(in))
+	if err != nil {
+		t.Errorf("did not expect an error, got %v", err)
+	}
+	if out.Identifier != "MIT" || out.Confidence >= classifier.DefaultThreshold {
+		t.Errorf("expected MIT with reduced confidence, got %+v", out)
+	}
+}
+
+var (
+	varRegexp    = regexp.MustCompile(`(?s)<<var;.*?original="(.*?)";.*?>>`)
+	markupRegexp = regexp.MustCompile(`<<(beginOptional|endOptional)>>`)
+)
+
+// TestClassifyTemplates checks that every embedded template, rendered with the original text of its variables,
+// is classified as the license it describes
+func TestClassifyTemplates(t *testing.T) {
+	files, err := filepath.Glob("templates/*.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != len(classifier.Identifiers()) {
+		t.Errorf("expected %d templates, found %d", len(classifier.Identifiers()), len(files))
+	}
+
+	target := classifier.New()
+	for _, f := range files {
+		identifier := strings.TrimSuffix(filepath.Base(f), ".txt")
+		t.Run(identifier, func(t *testing.T) {
+			b, err := ioutil.ReadFile(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			text := varRegexp.ReplaceAllString(string(b), "$1")
+			text = markupRegexp.ReplaceAllString(text, "")
+
+			out, err := target.Classify([]byte(text))
+			if err != nil {
+				t.Fatalf("did not expect an error, got %v", err)
+			}
+			if out.Identifier != identifier || out.Confidence < 0.99 {
+				t.Errorf("expected %s, got %+v", identifier, out)
+			}
+		})
+	}
+}
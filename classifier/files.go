@@ -0,0 +1,204 @@
+package classifier
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	licenseFileRegexp = regexp.MustCompile(`(?i)^(un)?licen[cs]e|^copying|^notice`)
+	// suffixedFileRegexp matches license files named after the license they hold, such as LICENSE-MIT or LICENSE.APACHE,
+	// which by convention indicate a choice of licenses
+	suffixedFileRegexp = regexp.MustCompile(`(?i)^licen[cs]e[-._](.+)$`)
+	// textExtensions holds the extensions of license files, such as LICENSE.txt, which do not name a license
+	textExtensions   = map[string]bool{"txt": true, "md": true, "markdown": true, "rst": true, "html": true, "htm": true}
+	readmeRegexp     = regexp.MustCompile(`(?i)^readme`)
+	atxHeadingRegexp = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	setextRegexp     = regexp.MustCompile(`^(=+|-+)\s*$`)
+)
+
+// sourceExtensions holds the extensions of files which, despite their names, hold code rather than license text
+var sourceExtensions = map[string]bool{
+	".c": true, ".cc": true, ".cpp": true, ".go": true, ".h": true, ".java": true, ".js": true, ".json": true,
+	".py": true, ".rb": true, ".rs": true, ".sh": true, ".toml": true, ".ts": true, ".xml": true, ".yaml": true,
+	".yml": true,
+}
+
+// FileMatch is a license identified within a file
+type FileMatch struct {
+	Match
+	// Path is the path of the file holding the license text
+	Path string
+	// Section is true if the license was found within a section of a README file rather than a dedicated license file
+	Section bool
+	// Text is the text which was classified, either the whole file or the README section
+	Text string
+}
+
+// FindLicenseFiles returns the paths of the files within dir, but not its subdirectories, which are likely to hold
+// license text: LICENSE*, LICENCE*, COPYING*, NOTICE* and UNLICENSE* files
+func FindLicenseFiles(dir string) ([]string, error) {
+	return findFiles(dir, licenseFileRegexp)
+}
+
+func findFiles(dir string, r *regexp.Regexp) ([]string, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]string, 0)
+	for _, info := range infos {
+		name := info.Name()
+		if !info.Mode().IsRegular() || !r.MatchString(name) || sourceExtensions[strings.ToLower(filepath.Ext(name))] {
+			continue
+		}
+		out = append(out, filepath.Join(dir, name))
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// ClassifyDir identifies the licenses held within dir. Each license file is classified and those without a
+// recognised license, such as most NOTICE files, are skipped. When no license files are recognised the license section
+// of a README file is used instead. An error is returned if no licenses are found.
+func (c *Classifier) ClassifyDir(dir string) ([]FileMatch, error) {
+	files, err := FindLicenseFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	matches, closest := c.classifyFiles(files, false)
+	if len(matches) > 0 {
+		return matches, nil
+	}
+
+	readmes, err := findFiles(dir, readmeRegexp)
+	if err != nil {
+		return nil, err
+	}
+	matches, closestSection := c.classifyFiles(readmes, true)
+	if len(matches) > 0 {
+		return matches, nil
+	}
+	if closest == nil {
+		closest = closestSection
+	}
+	if len(files) == 0 && len(readmes) == 0 {
+		return nil, errors.New("unable to find any license files")
+	}
+	if closest != nil {
+		return nil, closest
+	}
+	return nil, ErrNoMatch
+}
+
+// classifyFiles classifies each file, or the license section of each file when sections is true. It returns the
+// files in which a license was recognised along with the first classification error encountered.
+func (c *Classifier) classifyFiles(files []string, sections bool) ([]FileMatch, error) {
+	var firstErr error
+	matches := make([]FileMatch, 0, len(files))
+	for _, f := range files {
+		text, err := ioutil.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		if sections {
+			text = []byte(licenseSection(string(text)))
+		}
+		m, err := c.Classify(text)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		matches = append(matches, FileMatch{Match: m, Path: f, Section: sections, Text: string(text)})
+	}
+	return matches, firstErr
+}
+
+type heading struct {
+	line  int
+	level int
+	text  string
+}
+
+// licenseSection returns the text of the first section of a Markdown or reStructuredText document whose heading
+// mentions licensing, or an empty string if there is no such section
+func licenseSection(doc string) string {
+	lines := strings.Split(doc, "\n")
+	headings := make([]heading, 0)
+	for i, line := range lines {
+		if m := atxHeadingRegexp.FindStringSubmatch(line); m != nil {
+			headings = append(headings, heading{i, len(m[1]), m[2]})
+			continue
+		}
+		// setext headings are underlined, the underline character denoting the level
+		if i > 0 && strings.TrimSpace(lines[i-1]) != "" && setextRegexp.MatchString(line) {
+			level := 2
+			if line[0] == '=' {
+				level = 1
+			}
+			headings = append(headings, heading{i - 1, level, lines[i-1]})
+		}
+	}
+	for i, h := range headings {
+		if !strings.Contains(strings.ToLower(h.text), "licen") {
+			continue
+		}
+		start := h.line + 1
+		if start < len(lines) && setextRegexp.MatchString(lines[start]) {
+			start++
+		}
+		end := len(lines)
+		for _, next := range headings[i+1:] {
+			if next.level <= h.level {
+				end = next.line
+				break
+			}
+		}
+		return strings.Join(lines[start:end], "\n")
+	}
+	return ""
+}
+
+// Expression combines the licenses found within a directory into an SPDX license expression. Licenses held in files
+// named after the license, such as LICENSE-MIT and LICENSE-APACHE, are offered as a choice. Otherwise the terms of
+// every license apply. As LGPL-3.0 is written as a set of additional permissions to GPL-3.0, the text of GPL-3.0
+// accompanying LGPL-3.0 is not considered a license in its own right.
+func Expression(matches []FileMatch) string {
+	identifiers := make([]string, 0, len(matches))
+	found := map[string]bool{}
+	suffixed := true
+	for _, m := range matches {
+		if !found[m.Identifier] {
+			found[m.Identifier] = true
+			identifiers = append(identifiers, m.Identifier)
+		}
+		if m.Section || !isSuffixed(filepath.Base(m.Path)) {
+			suffixed = false
+		}
+	}
+	if found["LGPL-3.0"] && found["GPL-3.0"] {
+		for i, identifier := range identifiers {
+			if identifier == "GPL-3.0" {
+				identifiers = append(identifiers[:i], identifiers[i+1:]...)
+				break
+			}
+		}
+	}
+	op := " AND "
+	if suffixed {
+		op = " OR "
+	}
+	return strings.Join(identifiers, op)
+}
+
+// isSuffixed returns true if the file is named after the license it holds, rather than only having an extension
+func isSuffixed(name string) bool {
+	m := suffixedFileRegexp.FindStringSubmatch(name)
+	return m != nil && !textExtensions[strings.ToLower(m[1])]
+}
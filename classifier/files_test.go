@@ -0,0 +1,114 @@
+package classifier_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/senseyeio/diligent/classifier"
+)
+
+const readmeWithLicense = `# Example
+
+An example project.
+
+## Installation
+
+    go get example.com/example
+
+## License
+
+` + isc + `
+## Contributing
+
+Please raise a pull request.
+`
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "diligent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestClassifyDir(t *testing.T) {
+	cases := []struct {
+		d             string
+		files         map[string]string
+		outExpression string
+		expFailure    bool
+	}{
+		{"single license file", map[string]string{"LICENSE": mitWithHeader, "main.go": "package main"}, "MIT", false},
+		{"license files named after their license", map[string]string{"LICENSE-MIT": mitWithHeader, "LICENSE-BSD": bsd3}, "BSD-3-Clause OR MIT", false},
+		{"multiple license files", map[string]string{"COPYING": bsd3, "LICENSE.txt": isc}, "BSD-3-Clause AND ISC", false},
+		{"unrecognised notice is skipped", map[string]string{"LICENSE": mitWithHeader, "NOTICE": "This product includes software developed by Example Ltd."}, "MIT", false},
+		{"code is not treated as a license file", map[string]string{"license.go": mitWithHeader, "README.md": readmeWithLicense}, "ISC", false},
+		{"README license section", map[string]string{"README.md": readmeWithLicense}, "ISC", false},
+		{"no license files", map[string]string{"main.go": "package main"}, "", true},
+		{"unrecognised license", map[string]string{"LICENSE": "All use is strictly prohibited."}, "", true},
+	}
+
+	target := classifier.New()
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			dir := writeFiles(t, c.files)
+			defer os.RemoveAll(dir)
+
+			out, err := target.ClassifyDir(dir)
+			if (err != nil) != c.expFailure {
+				t.Errorf("expecting error: %t, got %v", c.expFailure, err)
+			}
+			if !c.expFailure && classifier.Expression(out) != c.outExpression {
+				t.Errorf("expecting %s, got %+v", c.outExpression, out)
+			}
+		})
+	}
+}
+
+func TestExpression(t *testing.T) {
+	cases := []struct {
+		d   string
+		in  []classifier.FileMatch
+		out string
+	}{
+		{"LGPL-3.0 supplements GPL-3.0", []classifier.FileMatch{
+			{Match: classifier.Match{Identifier: "GPL-3.0"}, Path: "COPYING"},
+			{Match: classifier.Match{Identifier: "LGPL-3.0"}, Path: "COPYING.LESSER"},
+		}, "LGPL-3.0"},
+		{"duplicate licenses", []classifier.FileMatch{
+			{Match: classifier.Match{Identifier: "MIT"}, Path: "LICENSE"},
+			{Match: classifier.Match{Identifier: "MIT"}, Path: "COPYING"},
+		}, "MIT"},
+		{"README sections are not a choice", []classifier.FileMatch{
+			{Match: classifier.Match{Identifier: "MIT"}, Path: "LICENSE-MIT"},
+			{Match: classifier.Match{Identifier: "ISC"}, Path: "README.md", Section: true},
+		}, "MIT AND ISC"},
+		{"files named after licenses are a choice", []classifier.FileMatch{
+			{Match: classifier.Match{Identifier: "MIT"}, Path: "LICENSE-MIT"},
+			{Match: classifier.Match{Identifier: "Apache-2.0"}, Path: "LICENSE.APACHE"},
+		}, "MIT OR Apache-2.0"},
+		{"file extensions do not name a license", []classifier.FileMatch{
+			{Match: classifier.Match{Identifier: "MIT"}, Path: "LICENSE.txt"},
+			{Match: classifier.Match{Identifier: "Apache-2.0"}, Path: "LICENSE-APACHE"},
+		}, "MIT AND Apache-2.0"},
+		{"markdown license files are not a choice", []classifier.FileMatch{
+			{Match: classifier.Match{Identifier: "MIT"}, Path: "LICENSE.md"},
+			{Match: classifier.Match{Identifier: "ISC"}, Path: "LICENSE.RST"},
+		}, "MIT AND ISC"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			if out := classifier.Expression(c.in); out != c.out {
+				t.Errorf("expecting %s, got %s", c.out, out)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package classifier
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// equivalentWords contains spellings which should be treated as identical when comparing license texts, based on the
+// SPDX license matching guidelines
+var equivalentWords = map[string]string{
+	"acknowledgment": "acknowledgement",
+	"analogue":       "analog",
+	"authorisation":  "authorization",
+	"authorised":     "authorized",
+	"licence":        "license",
+	"licences":       "licenses",
+	"licenced":       "licensed",
+	"organisation":   "organization",
+	"organisations":  "organizations",
+	"whilst":         "while",
+	"https":          "http",
+}
+
+// copyrightRegexp matches the start of lines holding copyright notices, such as "Copyright (c) 2018 Senseye Ltd"
+var copyrightRegexp = regexp.MustCompile(`^(copyright\s*(\(c\)|©|\d|\{|\[|<|:)|\(c\)\s*\d|©|all rights reserved)`)
+
+// isCopyrightLine returns true for lines which hold copyright notices. These differ between every copy of a license
+// and are ignored when matching, as recommended by the SPDX license matching guidelines.
+func isCopyrightLine(line string) bool {
+	line = strings.TrimLeftFunc(line, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '(' && r != '©'
+	})
+	return copyrightRegexp.MatchString(strings.ToLower(line))
+}
+
+// tokenize normalizes text into a list of lower case words. Punctuation, whitespace and copyright notices are removed.
+func tokenize(text string) []string {
+	words := make([]string, 0, len(text)/5)
+	for _, line := range strings.Split(text, "\n") {
+		if isCopyrightLine(line) {
+			continue
+		}
+		fields := strings.FieldsFunc(strings.ToLower(line), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		for _, f := range fields {
+			if eq, ok := equivalentWords[f]; ok {
+				f = eq
+			}
+			words = append(words, f)
+		}
+	}
+	return words
+}
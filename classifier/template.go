@@ -0,0 +1,205 @@
+package classifier
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	beginOptionalToken = "zzbeginoptionalzz"
+	endOptionalToken   = "zzendoptionalzz"
+	varTokenPrefix     = "zzvar"
+)
+
+var (
+	tagRegexp      = regexp.MustCompile(`(?s)<<(.*?)>>`)
+	originalRegexp = regexp.MustCompile(`(?s)original="(.*?)";`)
+)
+
+// template is a parsed SPDX license template. Words are stored as identifiers within a dictionary shared by all
+// templates, allowing texts to be compared without string comparisons.
+type template struct {
+	identifier string
+	words      []int32
+	optional   []bool
+	// required is the number of words outside of optional sections
+	required int
+	// varBudget is the number of words within the text which can be attributed to template variables, such as the
+	// name of a copyright holder, without affecting the confidence of a match
+	varBudget int
+	// requiredSet contains the distinct words outside of optional sections
+	requiredSet map[int32]bool
+}
+
+type dictionary map[string]int32
+
+func (d dictionary) add(word string) int32 {
+	id, ok := d[word]
+	if !ok {
+		id = int32(len(d))
+		d[word] = id
+	}
+	return id
+}
+
+// lookup returns the identifier of a word, or -1 if the word does not appear in any template
+func (d dictionary) lookup(word string) int32 {
+	id, ok := d[word]
+	if !ok {
+		return -1
+	}
+	return id
+}
+
+// varBudget estimates how many words may replace a template variable: the variable's original text allowing for a
+// little variation, or a small number of words for variables without original text, such as copyright notices
+func varBudget(tag string) int {
+	var n int
+	if m := originalRegexp.FindStringSubmatch(tag); m != nil {
+		n = len(tokenize(m[1]))
+	}
+	if n == 0 {
+		return 5
+	}
+	return 2*n + 1
+}
+
+// parseTemplate parses the text of an SPDX license template, adding the words it contains to dict. Optional sections
+// and variables are honoured; variables are removed from the template and increase its variable budget.
+func parseTemplate(identifier, text string, dict dictionary) (*template, error) {
+	// replace the template markup with sentinel words so that markup survives normalization in place
+	var budgets []int
+	var parseErr error
+	marked := tagRegexp.ReplaceAllStringFunc(text, func(tag string) string {
+		inner := strings.TrimSpace(tag[2 : len(tag)-2])
+		switch {
+		case inner == "beginOptional" || strings.HasPrefix(inner, "beginOptional;"):
+			return " " + beginOptionalToken + " "
+		case inner == "endOptional":
+			return " " + endOptionalToken + " "
+		case strings.HasPrefix(inner, "var;"):
+			budgets = append(budgets, varBudget(inner))
+			return fmt.Sprintf(" %s%d ", varTokenPrefix, len(budgets)-1)
+		default:
+			parseErr = fmt.Errorf("template %s contains unknown markup '%s'", identifier, tag)
+			return tag
+		}
+	})
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	t := &template{
+		identifier:  identifier,
+		requiredSet: map[int32]bool{},
+	}
+	depth := 0
+	for _, word := range tokenize(marked) {
+		switch {
+		case word == beginOptionalToken:
+			depth++
+		case word == endOptionalToken:
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("template %s contains an unbalanced optional section", identifier)
+			}
+		case strings.HasPrefix(word, varTokenPrefix):
+			var i int
+			if _, err := fmt.Sscanf(word, varTokenPrefix+"%d", &i); err != nil || i >= len(budgets) {
+				return nil, fmt.Errorf("template %s contains an invalid variable", identifier)
+			}
+			t.varBudget += budgets[i]
+		default:
+			id := dict.add(word)
+			t.words = append(t.words, id)
+			t.optional = append(t.optional, depth > 0)
+			if depth == 0 {
+				t.required++
+				t.requiredSet[id] = true
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("template %s contains an unterminated optional section", identifier)
+	}
+	if t.required == 0 {
+		return nil, fmt.Errorf("template %s contains no required text", identifier)
+	}
+	return t, nil
+}
+
+// parseTemplates parses all of the embedded license templates, returning them ordered by identifier
+func parseTemplates(texts map[string]string) ([]*template, dictionary, error) {
+	dict := dictionary{}
+	templates := make([]*template, 0, len(texts))
+	for identifier, text := range texts {
+		t, err := parseTemplate(identifier, text, dict)
+		if err != nil {
+			return nil, nil, err
+		}
+		templates = append(templates, t)
+	}
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].identifier < templates[j].identifier
+	})
+	return templates, dict, nil
+}
+
+// coverage returns the proportion of the template's distinct required words which appear in the text. It is cheap to
+// calculate and is used to select templates worth comparing in full.
+func (t *template) coverage(text map[int32]bool) float64 {
+	found := 0
+	for w := range t.requiredSet {
+		if text[w] {
+			found++
+		}
+	}
+	return float64(found) / float64(len(t.requiredSet))
+}
+
+// compare compares the template against text, returning a confidence value between 0 and 1 along with the number of
+// words in the text which matched the template.
+//
+// The longest common subsequence between the words of the template and the text is found, favouring required words.
+// Confidence is the proportion of required words matched, penalised by any unmatched words in the text which cannot
+// be attributed to template variables.
+func (t *template) compare(text []int32) (float64, int) {
+	const (
+		requiredInc = 2<<32 | 1
+		optionalInc = 1 << 32
+	)
+	// each cell packs a weighted match score, in which required words count double, above the number of required
+	// words matched
+	m := len(t.words)
+	prev := make([]int64, m+1)
+	cur := make([]int64, m+1)
+	for _, w := range text {
+		for j := 0; j < m; j++ {
+			best := prev[j+1]
+			if cur[j] > best {
+				best = cur[j]
+			}
+			if t.words[j] == w {
+				inc := int64(requiredInc)
+				if t.optional[j] {
+					inc = optionalInc
+				}
+				if c := prev[j] + inc; c > best {
+					best = c
+				}
+			}
+			cur[j+1] = best
+		}
+		prev, cur = cur, prev
+	}
+
+	matchedRequired := int(prev[m] & 0xffffffff)
+	matched := int(prev[m]>>32) - matchedRequired
+	extra := len(text) - matched - t.varBudget
+	if extra < 0 {
+		extra = 0
+	}
+	return float64(matchedRequired) / float64(t.required+extra), matched
+}
@@ -0,0 +1,49 @@
+package classifier
+
+import (
+	"strings"
+)
+
+// LicenseText returns the text of a license for which the classifier has a template, with the template markup
+// removed and variable text replaced by its original value. Identifiers with an "-only" or "-or-later" suffix, or
+// ending with "+", use the template of the license they refer to.
+func LicenseText(identifier string) (string, bool) {
+	for _, suffix := range []string{"+", "-only", "-or-later"} {
+		identifier = strings.TrimSuffix(identifier, suffix)
+	}
+	text, ok := templateText[identifier]
+	if !ok {
+		return "", false
+	}
+	text = tagRegexp.ReplaceAllStringFunc(text, func(tag string) string {
+		if m := originalRegexp.FindStringSubmatch(tag); m != nil {
+			return m[1]
+		}
+		return ""
+	})
+	return strings.TrimSpace(text) + "\n", true
+}
+
+// CopyrightLines returns the lines of text which hold copyright notices, with surrounding whitespace removed
+func CopyrightLines(text string) []string {
+	out := make([]string, 0)
+	for _, line := range strings.Split(text, "\n") {
+		if isCopyrightLine(line) {
+			out = append(out, strings.TrimSpace(line))
+		}
+	}
+	return out
+}
+
+// RemoveCopyrightLines returns text without the lines which hold copyright notices, allowing the texts of a license
+// distributed by different copyright holders to be compared
+func RemoveCopyrightLines(text string) string {
+	lines := strings.Split(text, "\n")
+	out := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if !isCopyrightLine(line) {
+			out = append(out, strings.TrimRight(line, " \t\r"))
+		}
+	}
+	return strings.TrimSpace(strings.Join(out, "\n")) + "\n"
+}
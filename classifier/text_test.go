@@ -0,0 +1,40 @@
+package classifier_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent/classifier"
+)
+
+func TestLicenseText(t *testing.T) {
+	text, ok := classifier.LicenseText("MIT")
+	if !ok {
+		t.Fatal("expected the text of MIT")
+	}
+	if strings.Contains(text, "<<") || !strings.HasPrefix(text, "MIT License") || !strings.Contains(text, "Permission is hereby granted") {
+		t.Errorf("unexpected text %s", text)
+	}
+	m, err := classifier.New().Classify([]byte(text))
+	if err != nil || m.Identifier != "MIT" {
+		t.Errorf("expected the text to be classified as MIT, got %+v %v", m, err)
+	}
+	if _, ok := classifier.LicenseText("GPL-3.0-or-later"); !ok {
+		t.Error("expected the text of GPL-3.0-or-later")
+	}
+	if _, ok := classifier.LicenseText("woowoo"); ok {
+		t.Error("did not expect the text of an unknown license")
+	}
+}
+
+func TestCopyrightLines(t *testing.T) {
+	text := "MIT License\n\n  Copyright (c) 2017 Senseye Ltd  \nCopyright 2018 Harry\n\nPermission is hereby granted\n"
+	exp := []string{"Copyright (c) 2017 Senseye Ltd", "Copyright 2018 Harry"}
+	if out := classifier.CopyrightLines(text); !reflect.DeepEqual(out, exp) {
+		t.Errorf("expected %v, got %v", exp, out)
+	}
+	if out := classifier.RemoveCopyrightLines(text); out != "MIT License\n\n\nPermission is hereby granted\n" {
+		t.Errorf("unexpected text %q", out)
+	}
+}
@@ -6,12 +6,13 @@ import (
 
 // checkCmd represents the check command
 var checkCmd = &cobra.Command{
-	Use:   "check [path]",
-	Short: "Ensures the licenses are compatible with your license whitelist",
-	Long:  `Calling check will ensure that the licenses associated with your dependencies are compatible with your license whitelist.`,
-	Args:  cobra.ExactArgs(1),
+	Use:         "check [path]",
+	Short:       "Ensures the licenses are compatible with your license whitelist",
+	Long:        `Calling check will ensure that the licenses associated with your dependencies are compatible with your license whitelist.`,
+	Args:        cobra.ExactArgs(1),
+	Annotations: map[string]string{pathArgAnnotation: "0"},
 	Run: func(cmd *cobra.Command, args []string) {
-		if len(licenseWhitelist) == 0 {
+		if !hasPolicy() && projectLicense == "" {
 			warning("your whitelist is empty, consider using the ls command instead")
 		}
 		run(args)
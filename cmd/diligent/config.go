@@ -0,0 +1,172 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/config"
+	"github.com/senseyeio/diligent/policy"
+	"github.com/spf13/cobra"
+)
+
+// pathArgAnnotation annotates commands with the index of the argument holding the path they scan, which is where the
+// search for a configuration file begins
+const pathArgAnnotation = "path-arg"
+
+var (
+	// configSource is the path of the configuration file loaded, if any
+	configSource string
+	// effectiveConfig is the configuration after flags have been applied
+	effectiveConfig config.Config
+	// outputs holds the reports written by ls and check
+	outputs []config.Output
+	// overrides assign licenses to dependencies, replacing any license determined or warning raised
+	overrides []diligent.Override
+	// policyRules allow and deny licenses in addition to the whitelist and denylist
+	policyRules []policy.Rule
+	// exceptions permit dependencies whose licenses are not whitelisted until their expiry dates
+	exceptions []diligent.Exception
+)
+
+// configCmd represents the config command
+var configCmd = &cobra.Command{
+	Use:   "config [path]",
+	Short: "Prints the effective configuration",
+	Long: `Calling config will print the configuration used when scanning path, which defaults to the current directory.
+The configuration is read from the nearest .diligent.yml, .diligent.yaml or .diligent.toml file, searching from path
+upward, with any flags provided overriding the values held in the file.`,
+	Args:        cobra.MaximumNArgs(1),
+	Annotations: map[string]string{pathArgAnnotation: "0"},
+	Run: func(cmd *cobra.Command, args []string) {
+		b, err := effectiveConfig.YAML()
+		if err != nil {
+			fatal(65, err.Error())
+		}
+		if configSource != "" {
+			fmt.Printf("# %s\n", configSource)
+		} else {
+			fmt.Println("# no configuration file found")
+		}
+		fmt.Print(string(b))
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(configCmd)
+	applyCommonFlags(configCmd)
+	applyWhitelistFlag(configCmd)
+}
+
+// loadConfig loads the configuration file provided using --config or, failing that, found from the path scanned by
+// the command, and applies it to the flags which were not provided
+func loadConfig(cmd *cobra.Command, args []string) error {
+	path := configFile
+	if path == "" {
+		var err error
+		if path, err = config.Find(scanPath(cmd, args)); err != nil || path == "" {
+			return err
+		}
+	}
+	c, err := config.Load(path)
+	if err != nil {
+		return err
+	}
+	configSource = path
+	applyConfig(cmd, c)
+	return nil
+}
+
+// scanPath returns the path scanned by the command, or the current directory if it does not scan one
+func scanPath(cmd *cobra.Command, args []string) string {
+	i, err := strconv.Atoi(cmd.Annotations[pathArgAnnotation])
+	if err != nil || i >= len(args) {
+		return "."
+	}
+	return args[i]
+}
+
+// applyConfig sets the options which were not provided as flags to the values held in the configuration
+func applyConfig(cmd *cobra.Command, c config.Config) {
+	flags := cmd.Flags()
+	if !flags.Changed("whitelist") && len(c.Whitelist) > 0 {
+		licenseWhitelist = c.Whitelist
+	}
+	if !flags.Changed("denylist") && len(c.Denylist) > 0 {
+		licenseDenylist = c.Denylist
+	}
+	if !flags.Changed("ignore") && len(c.Ignore) > 0 {
+		pkgIgnore = c.Ignore
+	}
+	if !flags.Changed("licenses") && c.Licenses != "" {
+		definitionsFile = c.Licenses
+	}
+	if !flags.Changed("npm-dev-deps") && c.NPM.DevDependencies {
+		npmDevDeps = true
+	}
+	if !flags.Changed("npm-registry") && c.NPM.Registry != "" {
+		npmAPIURL = c.NPM.Registry
+	}
+	if !flags.Changed("go-scan-headers") && c.Go.ScanHeaders {
+		goScanHeaders = true
+	}
+	if !flags.Changed("github-api-url") && c.GitHub.APIURL != "" {
+		githubAPIURL = c.GitHub.APIURL
+	}
+	if !flags.Changed("distribution") && c.Distribution != "" {
+		distribution = string(c.Distribution)
+	}
+	if !flags.Changed("project-license") && c.ProjectLicense != "" {
+		projectLicense = c.ProjectLicense
+	}
+	policyRules = c.Rules
+	overrides = c.Overrides
+	exceptions = c.Exceptions
+	outputs = c.Outputs
+}
+
+// checkConfig returns an error describing the first invalid rule, override or exception, if any
+func checkConfig() error {
+	if _, err := policy.New(policyRules); err != nil {
+		return fmt.Errorf("%s: %v", configSource, err)
+	}
+	for _, o := range overrides {
+		if err := o.Validate(); err != nil {
+			return fmt.Errorf("%s: %v", configSource, err)
+		}
+	}
+	for _, e := range exceptions {
+		if err := e.Validate(); err != nil {
+			return fmt.Errorf("%s: %v", configSource, err)
+		}
+	}
+	return nil
+}
+
+// setOutputs replaces the outputs held in the configuration with the output described by the flags, if any output
+// flags were provided or the configuration holds no outputs
+func setOutputs(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	if len(outputs) == 0 || flags.Changed("format") || flags.Changed("csv") || flags.Changed("out") || flags.Changed("template") {
+		outputs = []config.Output{{Format: outputFormat, File: outputFilename, Template: templateFilename}}
+	}
+}
+
+// currentConfig describes the options in use
+func currentConfig() config.Config {
+	return config.Config{
+		Whitelist:      licenseWhitelist,
+		Denylist:       licenseDenylist,
+		Rules:          policyRules,
+		Distribution:   policy.Distribution(distribution),
+		ProjectLicense: projectLicense,
+		Ignore:         pkgIgnore,
+		Licenses:       definitionsFile,
+		NPM:            config.NPM{DevDependencies: npmDevDeps, Registry: npmAPIURL},
+		Go:             config.Go{ScanHeaders: goScanHeaders},
+		GitHub:         config.GitHub{APIURL: githubAPIURL},
+		Overrides:      overrides,
+		Exceptions:     exceptions,
+		Outputs:        outputs,
+	}
+}
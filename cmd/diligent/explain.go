@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/policy"
+	"github.com/spf13/cobra"
+)
+
+// explainCmd represents the explain command
+var explainCmd = &cobra.Command{
+	Use:   "explain [package] [path]",
+	Short: "Explains how the license of a dependency was determined",
+	Long: `Calling explain will find the named dependency within the manifests held in path, which defaults to the current
+directory, and print how its license was determined. Each source consulted is listed along with the location of the
+license, the confidence with which it was identified and an extract of the text from which it was determined. When a
+distribution model is provided, the obligation each license of the dependency triggers under it is also described.`,
+	Args:        cobra.RangeArgs(1, 2),
+	Annotations: map[string]string{pathArgAnnotation: "1"},
+	Run: func(cmd *cobra.Command, args []string) {
+		path := "."
+		if len(args) > 1 {
+			path = args[1]
+		}
+		deps, warnings, _ := collectDependencies([]string{path})
+
+		found := false
+		for _, d := range deps {
+			if d.Name != args[0] {
+				continue
+			}
+			found = true
+			explain(d)
+		}
+		for _, w := range warnings {
+			if w.Dependency() == args[0] {
+				found = true
+				warning(w.Warning())
+			}
+		}
+		if !found {
+			fatal(67, fmt.Sprintf("dependency '%s' was not found", args[0]))
+		}
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(explainCmd)
+	applyResolutionFlags(explainCmd)
+	applyDistributionFlag(explainCmd)
+}
+
+func explain(d diligent.Dep) {
+	w := tabwriter.NewWriter(os.Stdout, 5, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Name:\t%s\n", d.Name)
+	fmt.Fprintf(w, "Version:\t%s\n", d.Version)
+	fmt.Fprintf(w, "Ecosystem:\t%s\n", d.Ecosystem)
+	fmt.Fprintf(w, "Manifest:\t%s\n", d.Manifest)
+	fmt.Fprintf(w, "License:\t%s\n", d.LicenseExpression())
+	if distribution != "" {
+		for _, l := range d.Licenses() {
+			fmt.Fprintf(w, "Obligation (%s):\t%s: %s\n", distribution, l.Identifier, policy.Obligation(policy.Distribution(distribution), l))
+		}
+	}
+	w.Flush()
+
+	if len(d.Provenance) == 0 {
+		fmt.Println("\nNo record of how the license was determined is available")
+		return
+	}
+	for _, p := range d.Provenance {
+		fmt.Println()
+		w = tabwriter.NewWriter(os.Stdout, 5, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "Source:\t%s\n", p.Source)
+		fmt.Fprintf(w, "License:\t%s\n", p.License)
+		fmt.Fprintf(w, "Location:\t%s\n", p.Location)
+		fmt.Fprintf(w, "Confidence:\t%s\n", confidence(p.Confidence))
+		if p.Snippet != "" {
+			fmt.Fprintf(w, "Snippet:\t%s\n", p.Snippet)
+		}
+		w.Flush()
+	}
+	fmt.Println()
+}
+
+func confidence(c float64) string {
+	if c == 0 {
+		return "not reported"
+	}
+	return fmt.Sprintf("%.0f%%", c*100)
+}
@@ -13,21 +13,37 @@ import (
 	"github.com/senseyeio/diligent/npm"
 )
 
+const (
+	defaultGithubAPIURL = "https://api.github.com"
+	defaultNPMAPIURL    = "https://registry.npmjs.org"
+)
+
 var (
-	gh        = github.New("https://api.github.com")
-	goLG      = _go.NewLicenseGetter(gh)
-	npmAPIURL = "https://registry.npmjs.org"
+	githubAPIURL = defaultGithubAPIURL
+	npmAPIURL    = defaultNPMAPIURL
+	depers       []diligent.Deper
 )
 
-var depers = []diligent.Deper{
-	npm.New(npmAPIURL),
-	govendor.New(goLG),
-	dep.New(goLG),
+// getDepers returns the Depers, configured using the command line flags
+func getDepers() []diligent.Deper {
+	if depers == nil {
+		goLG := _go.NewLicenseGetterWithOptions(github.New(githubAPIURL), _go.Config{
+			ScanHeaders: goScanHeaders,
+		})
+		depers = []diligent.Deper{
+			npm.NewWithOptions(npmAPIURL, npm.Config{
+				DevDependencies: npmDevDeps,
+			}),
+			govendor.New(goLG),
+			dep.New(goLG),
+		}
+	}
+	return depers
 }
 
 func getDeper(path string) (diligent.Deper, error) {
 	filename := filepath.Base(path)
-	for _, deper := range depers {
+	for _, deper := range getDepers() {
 		if deper.IsCompatible(filename) {
 			return deper, nil
 		}
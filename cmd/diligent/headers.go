@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/senseyeio/diligent/header"
+	"github.com/spf13/cobra"
+)
+
+var headerFiles bool
+
+// headersCmd represents the headers command
+var headersCmd = &cobra.Command{
+	Use:   "headers [path]",
+	Short: "Lists the licenses declared by SPDX-License-Identifier headers in source files",
+	Long: `Calling headers will search the source files within a directory, such as the source of a vendored
+dependency, for SPDX-License-Identifier headers. A summary of the licenses declared is printed, along with the license
+expression which covers the directory as a whole. Use --files to list the license declared by each file.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		result, err := header.New().Scan(args[0])
+		if err != nil {
+			fatal(66, err.Error())
+		}
+		for _, f := range result.Invalid() {
+			warning(fmt.Sprintf("%s: %v", f.Path, f.Err))
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 5, 0, 2, ' ', 0)
+		if headerFiles {
+			for _, f := range result.Files {
+				if f.Err == nil {
+					fmt.Fprintf(w, "%s\t%s\n", f.Path, f.Expression)
+				}
+			}
+		} else {
+			counts := result.Expressions()
+			expressions := make([]string, 0, len(counts))
+			for e := range counts {
+				expressions = append(expressions, e)
+			}
+			sort.Strings(expressions)
+			for _, e := range expressions {
+				fmt.Fprintf(w, "%s\t%d file(s)\n", e, counts[e])
+			}
+		}
+		w.Flush()
+
+		if expression := result.Expression(); expression != "" {
+			fmt.Printf("\nCombined license expression: %s\n", expression)
+		} else {
+			warning("no SPDX-License-Identifier headers found")
+		}
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(headersCmd)
+	headersCmd.Flags().BoolVarP(&headerFiles, "files", "", false, "List the license declared by each file rather than a summary")
+}
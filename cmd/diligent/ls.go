@@ -7,12 +7,17 @@ import (
 
 // lsCmd represents the ls command
 var lsCmd = &cobra.Command{
-	Use:   "ls [path]",
-	Short: "Lists the licenses associated with your dependencies",
-	Long:  `Calling ls will list the licenses associated with your dependencies.`,
-	Args:  cobra.ExactArgs(1),
+	Use:         "ls [path]",
+	Short:       "Lists the licenses associated with your dependencies",
+	Long:        `Calling ls will list the licenses associated with your dependencies.`,
+	Args:        cobra.ExactArgs(1),
+	Annotations: map[string]string{pathArgAnnotation: "0"},
 	Run: func(cmd *cobra.Command, args []string) {
 		licenseWhitelist = diligent.GetLicenseIdentifiers()
+		licenseDenylist = nil
+		policyRules = nil
+		distribution = ""
+		projectLicense = ""
 		run(args)
 	},
 }
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/notice"
+	"github.com/spf13/cobra"
+)
+
+var noticesFormat string
+
+// noticesCmd represents the notices command
+var noticesCmd = &cobra.Command{
+	Use:   "notices [path]",
+	Short: "Generates a third party notices file for your dependencies",
+	Long: `Calling notices will write a third party notices file reproducing the license text and copyright notices of each
+of your dependencies. License texts are taken from the license and NOTICE files found whilst determining licenses,
+falling back to the canonical text of the license. Dependencies sharing an identical license text are grouped.`,
+	Args:        cobra.ExactArgs(1),
+	Annotations: map[string]string{pathArgAnnotation: "0"},
+	Run: func(cmd *cobra.Command, args []string) {
+		if !isNoticesFormat(noticesFormat) {
+			fatal(73, fmt.Sprintf("unknown notices format '%s', expected one of: %s", noticesFormat, strings.Join(notice.Formats, ", ")))
+		}
+		deps, warnings, _ := collectDependencies(args)
+		for _, w := range warnings {
+			warning(w.Warning())
+		}
+		if len(deps) == 0 {
+			fatal(67, "did not successfully process any dependencies - see warnings above for details")
+		}
+		groups := notice.Gather(diligent.Deps(deps).Dedupe())
+
+		err := withOutputWriter(outputFilename, func(w io.Writer) error {
+			return notice.Write(w, noticesFormat, groups)
+		})
+		if err != nil {
+			fatal(65, err.Error())
+		}
+		if len(warnings) > 0 {
+			os.Exit(64)
+		}
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(noticesCmd)
+	applyResolutionFlags(noticesCmd)
+	noticesCmd.Flags().StringVarP(&noticesFormat, "format", "f", "text", fmt.Sprintf("Format of the notices file, one of: %s", strings.Join(notice.Formats, ", ")))
+	noticesCmd.Flags().StringVarP(&outputFilename, "out", "o", "", "Filename to which the notices should be written. By default or when blank stdout is used")
+	noticesCmd.Flags().StringSliceVarP(&pkgIgnore, "ignore", "i", nil, "Ignore certain packages. Ignored packages will not be included in the notices file. Regular expressions can be used.")
+}
+
+func isNoticesFormat(format string) bool {
+	for _, f := range notice.Formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/obligation"
+	"github.com/spf13/cobra"
+)
+
+var obligationsFormat string
+
+// obligationsCmd represents the obligations command
+var obligationsCmd = &cobra.Command{
+	Use:   "obligations [path]",
+	Short: "Lists what must be done to meet the licenses of your dependencies",
+	Long: `Calling obligations will write a checklist of the obligations the licenses of your dependencies place upon you,
+such as including copyright notices, documenting changes or providing source code, along with the dependencies which
+trigger each of them. Dependencies whose obligations are not known are listed so their licenses can be reviewed.`,
+	Args:        cobra.ExactArgs(1),
+	Annotations: map[string]string{pathArgAnnotation: "0"},
+	Run: func(cmd *cobra.Command, args []string) {
+		if !isObligationsFormat(obligationsFormat) {
+			fatal(73, fmt.Sprintf("unknown obligations format '%s', expected one of: %s", obligationsFormat, strings.Join(obligation.Formats, ", ")))
+		}
+		deps, warnings, _ := collectDependencies(args)
+		for _, w := range warnings {
+			warning(w.Warning())
+		}
+		if len(deps) == 0 {
+			fatal(67, "did not successfully process any dependencies - see warnings above for details")
+		}
+		list := obligation.Gather(diligent.Deps(deps).Dedupe())
+
+		err := withOutputWriter(outputFilename, func(w io.Writer) error {
+			return obligation.Write(w, obligationsFormat, list)
+		})
+		if err != nil {
+			fatal(65, err.Error())
+		}
+		if len(warnings) > 0 {
+			os.Exit(64)
+		}
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(obligationsCmd)
+	applyResolutionFlags(obligationsCmd)
+	obligationsCmd.Flags().StringVarP(&obligationsFormat, "format", "f", "text", fmt.Sprintf("Format of the obligations, one of: %s", strings.Join(obligation.Formats, ", ")))
+	obligationsCmd.Flags().StringVarP(&outputFilename, "out", "o", "", "Filename to which the obligations should be written. By default or when blank stdout is used")
+	obligationsCmd.Flags().StringSliceVarP(&pkgIgnore, "ignore", "i", nil, "Ignore certain packages. Ignored packages will not be included in the obligations. Regular expressions can be used.")
+}
+
+func isObligationsFormat(format string) bool {
+	for _, f := range obligation.Formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
@@ -8,29 +8,93 @@ import (
 
 	"fmt"
 	"io"
+	"strings"
+	"time"
 
 	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/config"
 	"github.com/senseyeio/diligent/csv"
+	"github.com/senseyeio/diligent/cyclonedx"
+	"github.com/senseyeio/diligent/html"
+	"github.com/senseyeio/diligent/json"
+	"github.com/senseyeio/diligent/junit"
+	"github.com/senseyeio/diligent/markdown"
 	"github.com/senseyeio/diligent/pretty"
+	"github.com/senseyeio/diligent/sarif"
+	"github.com/senseyeio/diligent/spdx"
+	"github.com/senseyeio/diligent/template"
 )
 
 type toSortInterfacer func(deps []diligent.Dep) sort.Interface
 
-func getReporter() diligent.Reporter {
-	if csvOutput {
-		return csv.NewReporter()
+// reporters holds the constructor of the Reporter for each output format
+var reporters = map[string]func(o config.Output) (diligent.Reporter, error){
+	"pretty":         withoutOptions(pretty.NewReporter),
+	"csv":            withoutOptions(csv.NewReporter),
+	"json":           withoutOptions(json.NewReporter),
+	"spdx":           withoutOptions(spdx.NewTagValueReporter),
+	"spdx-json":      withoutOptions(spdx.NewJSONReporter),
+	"cyclonedx-json": withoutOptions(cyclonedx.NewJSONReporter),
+	"cyclonedx-xml":  withoutOptions(cyclonedx.NewXMLReporter),
+	"html":           withoutOptions(html.NewReporter),
+	"junit":          withoutOptions(junit.NewReporter),
+	"sarif":          withoutOptions(sarif.NewReporter),
+	"markdown": withoutOptions(func() diligent.Reporter {
+		return markdown.NewReporterWithOptions(markdown.Config{MaxLength: markdownMaxLength})
+	}),
+	"template": func(o config.Output) (diligent.Reporter, error) {
+		r, err := template.NewReporter(o.Template)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load template '%s': %v", o.Template, err)
+		}
+		return r, nil
+	},
+}
+
+// withoutOptions adapts the constructor of a Reporter which is not configured by its output
+func withoutOptions(newReporter func() diligent.Reporter) func(o config.Output) (diligent.Reporter, error) {
+	return func(config.Output) (diligent.Reporter, error) {
+		return newReporter(), nil
 	}
+}
 
-	return pretty.NewReporter()
+// formats returns the names of the supported output formats
+func formats() []string {
+	out := make([]string, 0, len(reporters))
+	for f := range reporters {
+		out = append(out, f)
+	}
+	sort.Strings(out)
+	return out
 }
 
-func withOutputWriter(todo func(w io.Writer) error) error {
+// getReporters returns the Reporter of each output
+func getReporters() ([]diligent.Reporter, error) {
+	rr := make([]diligent.Reporter, len(outputs))
+	for i, o := range outputs {
+		newReporter, ok := reporters[o.Format]
+		if !ok {
+			return nil, fmt.Errorf("unknown output format '%s', expected one of: %s", o.Format, strings.Join(formats(), ", "))
+		}
+		if o.Format == "template" && o.Template == "" {
+			return nil, fmt.Errorf("the template format requires a template file, provided using --template")
+		}
+		r, err := newReporter(o)
+		if err != nil {
+			return nil, err
+		}
+		rr[i] = r
+	}
+	return rr, nil
+}
+
+func withOutputWriter(filename string, todo func(w io.Writer) error) error {
 	var w io.Writer
 
-	if outputFilename != "" {
-		file, err := os.Create(outputFilename)
+	if filename != "" {
+		file, err := os.Create(filename)
 		if err != nil {
-			return fmt.Errorf("unable to open file '%s' for writing. %v", outputFilename, err)
+			return fmt.Errorf("unable to open file '%s' for writing. %v", filename, err)
 		}
 		defer file.Close()
 		w = file
@@ -59,25 +123,56 @@ func getFiles(args []string) []string {
 	return files
 }
 
-func run(args []string) {
+// collectDependencies returns the dependencies, and any warnings, of the manifests found within the path held in args,
+// along with the path of each manifest processed. Overrides are applied before ignored packages are removed.
+func collectDependencies(args []string) ([]diligent.Dep, []diligent.Warning, []string) {
 	files := getFiles(args)
 
 	deps := make([]diligent.Dep, 0)
 	warnings := make([]diligent.Warning, 0)
+	manifests := make([]string, 0)
 	for _, f := range files {
 		deper, err := getDeper(f)
 		if err != nil {
 			continue
 		}
+		manifests = append(manifests, f)
 		fileBytes := mustReadFile(f)
-		d, w, err := deper.Dependencies(fileBytes)
+		var d []diligent.Dep
+		var w []diligent.Warning
+		if md, ok := deper.(diligent.ManifestDeper); ok {
+			d, w, err = md.ManifestDependencies(f, fileBytes)
+		} else {
+			d, w, err = deper.Dependencies(fileBytes)
+		}
 		if err != nil {
 			fatal(67, err.Error())
 		}
+		for i := range d {
+			d[i].Manifest = f
+		}
+		d, w = diligent.ApplyOverrides(overrides, configSource, f, d, w)
 		deps = append(deps, d...)
 		warnings = append(warnings, w...)
 	}
 	deps, warnings = ignorePackages(deps, warnings)
+	return deps, warnings, manifests
+}
+
+func run(args []string) {
+	rr, err := getReporters()
+	if err != nil {
+		fatal(73, err.Error())
+	}
+	p, err := newPolicy()
+	if err != nil {
+		fatal(70, err.Error())
+	}
+	outbound, err := resolveProjectLicense(args[0])
+	if err != nil {
+		fatal(70, err.Error())
+	}
+	deps, warnings, manifests := collectDependencies(args)
 
 	for _, w := range warnings {
 		warning(w.Warning())
@@ -87,25 +182,44 @@ func run(args []string) {
 	}
 
 	deps = diligent.Deps(deps).Dedupe()
+	addObligations(deps)
 
 	sorter := getSort(sortByLicense)
 	sort.Sort(sorter(deps))
-	reporter := getReporter()
-
-	err := withOutputWriter(func(w io.Writer) error {
-		return reporter.Report(w, deps)
-	})
-
-	if err != nil {
-		fatal(65, err.Error())
+	sort.Sort(diligent.Warnings(warnings))
+	now := time.Now().UTC()
+	violations, applied := validateDependencies(p, outbound, deps, now)
+	for _, e := range applied {
+		if e.ExpiresWithin(now, diligent.ExpiryNotice) {
+			warning(fmt.Sprintf("exception %s permitting dependency '%s' expires on %s", e.Ticket, e.Package, e.Expires))
+		}
+	}
+	report := diligent.Report{
+		Deps:         deps,
+		Warnings:     warnings,
+		Violations:   violations,
+		Exceptions:   activeExceptions(applied, now),
+		Manifests:    manifests,
+		Root:         args[0],
+		Distribution: distribution,
+		ToolVersion:  version,
+		Timestamp:    now,
+	}
+	for i, r := range rr {
+		err := withOutputWriter(outputs[i].File, func(w io.Writer) error {
+			return r.Report(w, report)
+		})
+		if err != nil {
+			fatal(65, err.Error())
+		}
 	}
 
-	if errs := validateDependencies(deps); len(errs) > 0 {
-		if len(errs) == 1 {
-			fatal(68, errs[0].Error())
+	if vv := report.Violations; len(vv) > 0 {
+		if len(vv) == 1 {
+			fatal(68, vv[0].Error())
 		}
-		for _, e := range errs {
-			warning(e.Error())
+		for _, v := range vv {
+			warning(v.Error())
 		}
 		fatal(68, "multiple dependencies are not compliant with your whitelist")
 	}
@@ -1,30 +1,59 @@
 package main
 
 import (
+	"fmt"
+	"io/ioutil"
 	"regexp"
+	"strings"
 
 	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/markdown"
 	"github.com/spf13/cobra"
 )
 
 var (
-	licenseWhitelist []string
-	pkgIgnore        []string
-	ignoreRegex      []*regexp.Regexp
-	npmDevDeps       bool
-	sortByLicense    bool
-	csvOutput        bool
-	outputFilename   string
+	licenseWhitelist  []string
+	licenseDenylist   []string
+	pkgIgnore         []string
+	ignoreRegex       []*regexp.Regexp
+	npmDevDeps        bool
+	goScanHeaders     bool
+	sortByLicense     bool
+	csvOutput         bool
+	outputFormat      string
+	markdownMaxLength int
+	templateFilename  string
+	outputFilename    string
+	definitionsFile   string
+	configFile        string
+	distribution      string
+	projectLicense    string
 )
 
 var RootCmd = &cobra.Command{
 	Short: "Get the licenses associated with your software dependencies",
 	Long:  `Diligent is a CLI tool which determines the licenses associated with your software dependencies`,
 	PersistentPreRun: func(cmd *cobra.Command, args []string) {
-		licenseWhitelist = diligent.ReplaceCategoriesWithIdentifiers(licenseWhitelist)
+		if err := loadConfig(cmd, args); err != nil {
+			fatal(74, err.Error())
+		}
+		if definitionsFile != "" {
+			if err := registerLicenseDefinitions(definitionsFile); err != nil {
+				fatal(72, err.Error())
+			}
+		}
+		if err := checkConfig(); err != nil {
+			fatal(74, err.Error())
+		}
+		if csvOutput {
+			outputFormat = "csv"
+		}
+		setOutputs(cmd)
+		effectiveConfig = currentConfig()
 		if err := checkWhitelist(); err != nil {
 			fatal(70, err.Error())
 		}
+		licenseWhitelist = removeDenied(licenseWhitelist, licenseDenylist)
 		ignoreRegex = make([]*regexp.Regexp, len(pkgIgnore))
 		for idx, i := range pkgIgnore {
 			r, err := regexp.Compile(i)
@@ -38,16 +67,49 @@ var RootCmd = &cobra.Command{
 
 func init() {
 	cobra.OnInitialize()
+	RootCmd.PersistentFlags().StringVarP(&configFile, "config", "", "", "Configuration file to use. By default .diligent.yml, .diligent.yaml or .diligent.toml is searched for from the scanned path upward. See the readme for more details.")
+	RootCmd.PersistentFlags().StringVarP(&definitionsFile, "licenses", "", "", "TOML file defining additional licenses and overriding the categories of known licenses. See the readme for more details.")
 }
 
-func applyCommonFlags(cmd *cobra.Command) {
+func registerLicenseDefinitions(path string) error {
+	fileBytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	defs, err := diligent.ParseLicenseDefinitions(fileBytes)
+	if err != nil {
+		return fmt.Errorf("unable to parse license definitions '%s': %v", path, err)
+	}
+	return defs.Register()
+}
+
+// applyResolutionFlags adds the flags altering how the licenses of dependencies are determined
+func applyResolutionFlags(cmd *cobra.Command) {
 	cmd.Flags().BoolVarP(&npmDevDeps, "npm-dev-deps", "", false, "[NPM] Include developer dependencies")
+	cmd.Flags().StringVarP(&npmAPIURL, "npm-registry", "", defaultNPMAPIURL, "[NPM] URL of the NPM registry")
+	cmd.Flags().BoolVarP(&goScanHeaders, "go-scan-headers", "", false, "[Go] Download the source of each dependency and include the licenses declared by SPDX-License-Identifier headers in its files")
+	cmd.Flags().StringVarP(&githubAPIURL, "github-api-url", "", defaultGithubAPIURL, "[Go] URL of the GitHub API")
+}
+
+func applyCommonFlags(cmd *cobra.Command) {
+	applyResolutionFlags(cmd)
+	cmd.Flags().StringVarP(&outputFormat, "format", "f", "pretty", fmt.Sprintf("Format of the output, one of: %s. See the readme for more details.", strings.Join(formats(), ", ")))
+	cmd.Flags().StringVarP(&templateFilename, "template", "", "", "[Template] Go template file used to render the output when the format is template. See the readme for more details.")
+	cmd.Flags().IntVarP(&markdownMaxLength, "markdown-max-length", "", markdown.DefaultMaxLength, "[Markdown] Maximum length of the output in bytes. Less important sections are cut short to fit.")
 	cmd.Flags().BoolVarP(&csvOutput, "csv", "", false, "Writes the output as comma separated values")
+	cmd.Flags().MarkDeprecated("csv", "use --format csv instead")
 	cmd.Flags().BoolVarP(&sortByLicense, "license", "l", false, "Sorts output by license")
 	cmd.Flags().StringVarP(&outputFilename, "out", "o", "", "Filename to which output should be written. By default or when blank stdout is used")
 	cmd.Flags().StringSliceVarP(&pkgIgnore, "ignore", "i", nil, "Ignore certain packages. Ignored packages will not be reported on or validated against your whitelist. Regular expressions can be used.")
 }
 
 func applyWhitelistFlag(cmd *cobra.Command) {
-	cmd.Flags().StringSliceVarP(&licenseWhitelist, "whitelist", "w", nil, "Specify licenses compatible with your software. If licenses are found which are not in your whitelist, the command will return with a non zero exit code. Whitelisting license identifiers or categories of licenses is possible, the following categories are supported: 'all', 'permissive', 'copyleft', 'copyleft-limited', 'free-restricted', 'proprietary-free', 'public-domain'. See the readme for more details.")
+	cmd.Flags().StringSliceVarP(&licenseWhitelist, "whitelist", "w", nil, "Specify licenses compatible with your software. If licenses are found which are not in your whitelist, the command will return with a non zero exit code. Whitelisting license identifiers, categories of licenses, families of licenses such as GPL, and glob patterns such as BSD-* is possible, and entries starting with ! remove licenses from the whitelist. The following categories are supported: 'all', 'permissive', 'copyleft', 'copyleft-limited', 'free-restricted', 'proprietary-free', 'public-domain'. See the readme for more details.")
+	cmd.Flags().StringSliceVarP(&licenseDenylist, "denylist", "", nil, "Specify licenses which are not permitted, even when whitelisted. License identifiers, categories, families and patterns can be used, for example -w permissive --denylist JSON.")
+	cmd.Flags().StringVarP(&projectLicense, "project-license", "", "", fmt.Sprintf("License under which your software is published, or '%s' to identify it from the license files of the scanned path. Dependencies whose licenses are incompatible with it are reported as violations.", autoProjectLicense))
+	applyDistributionFlag(cmd)
+}
+
+func applyDistributionFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&distribution, "distribution", "", "", fmt.Sprintf("How your software is distributed, one of: %s. Permits the licenses whose obligations suit the distribution model and explains the obligations each dependency triggers. See the readme for more details.", strings.Join(distributions(), ", ")))
 }
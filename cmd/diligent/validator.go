@@ -2,30 +2,108 @@ package main
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/senseyeio/diligent"
-	warnpkg "github.com/senseyeio/diligent/warning"
+	"github.com/senseyeio/diligent/classifier"
+	"github.com/senseyeio/diligent/policy"
 )
 
+// autoProjectLicense requests the project license be identified from the license files of the scanned path
+const autoProjectLicense = "auto"
+
 func isInWhitelist(l diligent.License) bool {
+	return isIdentifierInWhitelist(l.Identifier)
+}
+
+func isIdentifierInWhitelist(identifier string) bool {
 	for _, w := range licenseWhitelist {
-		if w == l.Identifier {
+		if w == identifier {
 			return true
 		}
 	}
 	return false
 }
 
+// checkWhitelist expands the patterns held in the whitelist and denylist into license identifiers, returning an error
+// if a pattern is invalid, as well as validating the distribution model and project license
 func checkWhitelist() error {
-	for _, w := range licenseWhitelist {
-		_, err := diligent.GetLicenseFromIdentifier(w)
-		if err != nil {
-			return fmt.Errorf("whitelisted license '%s' is not a known license identifier", w)
+	var err error
+	if licenseWhitelist, err = diligent.ExpandLicensePatterns(licenseWhitelist); err != nil {
+		return fmt.Errorf("invalid whitelist: %v", err)
+	}
+	if licenseDenylist, err = diligent.ExpandLicensePatterns(licenseDenylist); err != nil {
+		return fmt.Errorf("invalid denylist: %v", err)
+	}
+	if distribution != "" {
+		if _, err := policy.Preset(policy.Distribution(distribution)); err != nil {
+			return err
+		}
+	}
+	if projectLicense != "" && projectLicense != autoProjectLicense {
+		if _, err := diligent.GetLicenseFromIdentifier(projectLicense); err != nil {
+			return fmt.Errorf("project license '%s' is not a known license identifier", projectLicense)
 		}
 	}
 	return nil
 }
 
+// hasPolicy returns true if licenses are permitted by a whitelist, denylist, rules or distribution model, rather than
+// only by their compatibility with the project license
+func hasPolicy() bool {
+	return len(licenseWhitelist) > 0 || len(licenseDenylist) > 0 || len(policyRules) > 0 || distribution != ""
+}
+
+// resolveProjectLicense returns the license under which the software is published, identifying it from the license
+// files of path when requested, or nil if compatibility is not being checked
+func resolveProjectLicense(path string) (*diligent.License, error) {
+	if projectLicense == "" {
+		return nil, nil
+	}
+	identifier := projectLicense
+	if identifier == autoProjectLicense {
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			path = filepath.Dir(path)
+		}
+		matches, err := classifier.New().ClassifyDir(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to identify the project license from '%s': %v", path, err)
+		}
+		identifier = classifier.Expression(matches)
+	}
+	l, err := diligent.GetLicenseFromIdentifier(identifier)
+	if err != nil {
+		return nil, fmt.Errorf("project license '%s' is not a single known license identifier", identifier)
+	}
+	return &l, nil
+}
+
+func distributions() []string {
+	out := make([]string, len(policy.Distributions))
+	for i, d := range policy.Distributions {
+		out[i] = string(d)
+	}
+	return out
+}
+
+// removeDenied returns the whitelisted license identifiers which are not denied
+func removeDenied(whitelist, denylist []string) []string {
+	denied := map[string]bool{}
+	for _, d := range denylist {
+		denied[d] = true
+	}
+	out := make([]string, 0, len(whitelist))
+	for _, w := range whitelist {
+		if !denied[w] {
+			out = append(out, w)
+		}
+	}
+	return out
+}
+
 func isIgnored(pkgName string) bool {
 	for _, i := range ignoreRegex {
 		if i.MatchString(pkgName) {
@@ -44,24 +122,139 @@ func ignorePackages(dd []diligent.Dep, ww []diligent.Warning) ([]diligent.Dep, [
 	}
 	wwOut := make([]diligent.Warning, 0, len(ww))
 	for _, w := range ww {
-		warn, ok := w.(*warnpkg.Warn)
-		if !ok {
-			wwOut = append(wwOut, w)
-			continue
-		}
-		if !isIgnored(warn.Dep) {
+		if !isIgnored(w.Dependency()) {
 			wwOut = append(wwOut, w)
 		}
 	}
 	return ddOut, wwOut
 }
 
-func validateDependencies(deps []diligent.Dep) []error {
-	ee := make([]error, 0, len(deps))
+// findException returns the exception covering the dependency, if any
+func findException(d diligent.Dep) (diligent.Exception, bool) {
+	for _, e := range exceptions {
+		if e.Covers(d) {
+			return e, true
+		}
+	}
+	return diligent.Exception{}, false
+}
+
+// newPolicy returns the policy combining the whitelist and denylist with the rules held in the configuration and the
+// preset for the distribution model, if any
+func newPolicy() (*policy.Policy, error) {
+	rules := make([]policy.Rule, 0, len(policyRules)+2)
+	if len(licenseWhitelist) > 0 {
+		rules = append(rules, policy.Rule{Name: "whitelist", Action: policy.Allow, Licenses: licenseWhitelist})
+	}
+	if len(licenseDenylist) > 0 {
+		rules = append(rules, policy.Rule{Name: "denylist", Action: policy.Deny, Licenses: licenseDenylist})
+	}
+	rules = append(rules, policyRules...)
+	if distribution != "" {
+		preset, err := policy.Preset(policy.Distribution(distribution))
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, preset...)
+	}
+	return policy.New(rules)
+}
+
+// validateDependencies returns a violation for each dependency whose license is not permitted by the policy, or is
+// incompatible with the outbound project license when provided, unless an exception covering the dependency has not
+// expired at now. The exceptions permitting dependencies are also returned.
+func validateDependencies(p *policy.Policy, outbound *diligent.License, deps []diligent.Dep, now time.Time) ([]diligent.Violation, []diligent.Exception) {
+	vv := make([]diligent.Violation, 0)
+	ee := make([]diligent.Exception, 0)
+	applied := map[diligent.Exception]bool{}
 	for _, d := range deps {
-		if isInWhitelist(d.License) == false {
-			ee = append(ee, fmt.Errorf("dependency '%s' has license '%s' which is not in your license whitelist", d.Name, d.License.Identifier))
+		reasons := make([]string, 0, 2)
+		if hasPolicy() || outbound == nil {
+			if reason, ok := evaluatePolicy(p, d); !ok {
+				reasons = append(reasons, reason)
+			}
+		}
+		if outbound != nil {
+			if ok, reason := isCompatible(d, *outbound); !ok {
+				reasons = append(reasons, fmt.Sprintf("dependency '%s' has license '%s' which is incompatible with the project license '%s': %s", d.Name, d.LicenseExpression(), outbound.Identifier, reason))
+			}
+		}
+		if len(reasons) == 0 {
+			continue
+		}
+		message := strings.Join(reasons, "; ")
+		if e, ok := findException(d); ok {
+			if !e.HasExpired(now) {
+				if !applied[e] {
+					applied[e] = true
+					ee = append(ee, e)
+				}
+				continue
+			}
+			message += fmt.Sprintf(" and exception %s expired on %s", e.Ticket, e.Expires)
+		}
+		vv = append(vv, diligent.Violation{Dep: d, Message: message})
+	}
+	return vv, ee
+}
+
+// activeExceptions returns the configured exceptions which have not expired at now, marking those which permitted a
+// dependency as applied
+func activeExceptions(applied []diligent.Exception, now time.Time) []diligent.Exception {
+	used := map[diligent.Exception]bool{}
+	for _, e := range applied {
+		used[e] = true
+	}
+	out := make([]diligent.Exception, 0, len(exceptions))
+	for _, e := range exceptions {
+		if e.HasExpired(now) {
+			continue
+		}
+		e.Applied = used[e]
+		out = append(out, e)
+	}
+	return out
+}
+
+// addObligations describes the obligation each license of the dependencies triggers under the distribution model, if
+// one was provided
+func addObligations(deps []diligent.Dep) {
+	if distribution == "" {
+		return
+	}
+	for i, d := range deps {
+		for _, l := range d.Licenses() {
+			deps[i].DistributionObligations = append(deps[i].DistributionObligations, diligent.LicenseObligation{
+				License:     l.Identifier,
+				Description: policy.Obligation(policy.Distribution(distribution), l),
+			})
 		}
 	}
-	return ee
+}
+
+// evaluatePolicy returns a message describing why the license of the dependency is not permitted by the policy, if it
+// is not
+func evaluatePolicy(p *policy.Policy, d diligent.Dep) (string, bool) {
+	decision := p.Evaluate(d)
+	if decision.Permitted {
+		return "", true
+	}
+	message := fmt.Sprintf("dependency '%s' has license '%s' which is not permitted: %s", d.Name, d.LicenseExpression(), decision.Reason)
+	if len(policyRules) == 0 && distribution == "" && decision.Rule == "" {
+		message = fmt.Sprintf("dependency '%s' has license '%s' which is not in your license whitelist", d.Name, d.LicenseExpression())
+	}
+	if l, err := diligent.GetLicenseFromIdentifier(decision.License); err == nil && distribution != "" {
+		message += fmt.Sprintf("; obligation when distributed as %s: %s", distribution, policy.Obligation(policy.Distribution(distribution), l))
+	}
+	return message, false
+}
+
+// isCompatible returns true if the license of the dependency is compatible with the outbound license, or the reason it
+// is not otherwise
+func isCompatible(d diligent.Dep, outbound diligent.License) (bool, string) {
+	e, err := diligent.ParseExpression(d.LicenseExpression())
+	if err != nil {
+		return false, fmt.Sprintf("its license expression is invalid: %v", err)
+	}
+	return diligent.IsCompatible(e, outbound)
 }
@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/senseyeio/diligent"
 	"github.com/spf13/cobra"
@@ -11,14 +12,17 @@ import (
 var whitelistCmd = &cobra.Command{
 	Use:   "whitelist",
 	Short: "Details the licenses allowed by the current whitelist settings",
-	Long: `Calling whitelist will detail what licenses are permitted with the provided flags. This can be used to
-validate your are whitelisting just the licenses you are interested in`,
+	Long: `Calling whitelist will detail what licenses are permitted with the provided flags. Each whitelist and denylist
+entry is listed along with the licenses it expands to, followed by the licenses permitted. This can be used to validate
+you are whitelisting just the licenses you are interested in`,
 	Args: cobra.ExactArgs(0),
 	Run: func(cmd *cobra.Command, args []string) {
-		all := diligent.GetLicenses()
-		for _, l := range all {
+		describePatterns("whitelist", effectiveConfig.Whitelist)
+		describePatterns("denylist", effectiveConfig.Denylist)
+		fmt.Println("Permitted licenses:")
+		for _, l := range diligent.GetLicenses() {
 			if isInWhitelist(l) {
-				fmt.Println(l.Identifier)
+				fmt.Printf("  %s\n", l.Identifier)
 			}
 		}
 	},
@@ -28,3 +32,25 @@ func init() {
 	RootCmd.AddCommand(whitelistCmd)
 	applyWhitelistFlag(whitelistCmd)
 }
+
+// describePatterns prints the licenses each pattern expands to
+func describePatterns(list string, patterns []string) {
+	for _, p := range patterns {
+		identifiers, err := diligent.ExpandLicensePattern(p)
+		if err != nil {
+			fatal(70, err.Error())
+		}
+		effect := "adds"
+		if list == "denylist" {
+			effect = "denies"
+		}
+		if strings.HasPrefix(p, "!") {
+			effect = "excludes"
+		}
+		fmt.Printf("%s entry '%s' %s %d licenses:\n", list, p, effect, len(identifiers))
+		for _, i := range identifiers {
+			fmt.Printf("  %s\n", i)
+		}
+		fmt.Println()
+	}
+}
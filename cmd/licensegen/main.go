@@ -0,0 +1,385 @@
+// Command licensegen generates diligent's license database from a local copy of the SPDX license list.
+//
+// The SPDX license list provides the identifiers, names and OSI / FSF status of each license. Information diligent
+// needs which SPDX does not track, such as the license category and owner, is read from an overlay file keyed by
+// license identifier. Every license must be described by the overlay file, so that no license is left without a
+// category. Licenses which only exist in the overlay file are also included in the output. The permissions,
+// conditions and limitations of licenses are read from a terms file, keyed by license identifier, holding the data
+// published by choosealicense.com. The terms of a license also apply to its -only, -or-later and + variants.
+//
+// Each license is placed in a family named after its identifier without the version and any variant, so that
+// GPL-2.0-only and GPL-3.0-or-later belong to the GPL family and BSD-2-Clause and BSD-3-Clause to the BSD family. The
+// overlay file may assign a license to a different family.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+type spdxLicense struct {
+	Identifier    string `json:"licenseId"`
+	Name          string `json:"name"`
+	Reference     string `json:"reference"`
+	IsOSIApproved bool   `json:"isOsiApproved"`
+	IsFSFLibre    bool   `json:"isFsfLibre"`
+	IsDeprecated  bool   `json:"isDeprecatedLicenseId"`
+}
+
+type spdxLicenseList struct {
+	Version  string        `json:"licenseListVersion"`
+	Licenses []spdxLicense `json:"licenses"`
+}
+
+type spdxException struct {
+	Identifier   string `json:"licenseExceptionId"`
+	Name         string `json:"name"`
+	Reference    string `json:"reference"`
+	IsDeprecated bool   `json:"isDeprecatedLicenseId"`
+}
+
+type spdxExceptionList struct {
+	Version    string          `json:"licenseListVersion"`
+	Exceptions []spdxException `json:"exceptions"`
+}
+
+// overlay contains the information diligent holds about a license in addition to the SPDX license list.
+// Name and URL are only required when the license is not part of the SPDX license list, otherwise they override the
+// values provided by SPDX.
+type overlay struct {
+	Name      string `json:"name"`
+	URL       string `json:"url"`
+	ShortName string `json:"shortName"`
+	Category  string `json:"category"`
+	Type      string `json:"type"`
+	Owner     string `json:"owner"`
+	OwnerURL  string `json:"ownerURL"`
+	OwnerType string `json:"ownerType"`
+	Family    string `json:"family"`
+}
+
+// terms holds the permissions, conditions and limitations of a license as described by choosealicense.com
+type terms struct {
+	Permissions []string `json:"permissions"`
+	Conditions  []string `json:"conditions"`
+	Limitations []string `json:"limitations"`
+}
+
+var variantRegexp = regexp.MustCompile(`(-only|-or-later|\+)$`)
+
+// constants map the values found within the overlay file to the names of the constants declared by diligent
+var constants = map[string]map[string]string{
+	"category": {
+		"permissive":       "Permissive",
+		"copyleft":         "CopyLeft",
+		"copyleft-limited": "CopyLeftLimited",
+		"free-restricted":  "FreeRestricted",
+		"proprietary-free": "ProprietaryFree",
+		"public-domain":    "PublicDomain",
+	},
+	"type": {
+		"open source": "OpenSource",
+		"proprietary": "Proprietary",
+	},
+	"ownerType": {
+		"organization": "Organization",
+		"person":       "Person",
+		"project":      "Project",
+	},
+	"permission": {
+		"commercial-use": "CommercialUse",
+		"modifications":  "Modification",
+		"distribution":   "Distribution",
+		"private-use":    "PrivateUse",
+		"patent-use":     "PatentUse",
+	},
+	"condition": {
+		"include-copyright":         "IncludeCopyright",
+		"include-copyright--source": "IncludeCopyrightSource",
+		"document-changes":          "DocumentChanges",
+		"disclose-source":           "DiscloseSource",
+		"network-use-disclose":      "NetworkUseDisclose",
+		"same-license":              "SameLicense",
+		"same-license--file":        "SameLicenseFile",
+		"same-license--library":     "SameLicenseLibrary",
+	},
+	"limitation": {
+		"trademark-use": "NoTrademarkUse",
+		"liability":     "NoLiability",
+		"patent-use":    "NoPatentUse",
+		"warranty":      "NoWarranty",
+	},
+}
+
+type license struct {
+	Identifier    string
+	Family        string
+	Name          string
+	ShortName     string
+	Category      string
+	Type          string
+	Owner         string
+	OwnerURL      string
+	OwnerType     string
+	URL           string
+	IsOSIApproved bool
+	IsFSFLibre    bool
+	IsDeprecated  bool
+	IsSPDXListed  bool
+	Permissions   []string
+	Obligations   []string
+	Limitations   []string
+}
+
+type exception struct {
+	Identifier   string
+	Name         string
+	URL          string
+	IsDeprecated bool
+}
+
+var output = template.Must(template.New("output").Funcs(template.FuncMap{"quote": strconv.Quote, "join": strings.Join}).Parse(
+	`// Code generated by licensegen from the SPDX license list {{ .Version }}. DO NOT EDIT.
+
+package diligent
+
+// LicenseListVersion is the version of the SPDX license list used to generate the license database
+const LicenseListVersion = {{ quote .Version }}
+
+var lookup = map[string]License{
+{{- range .Licenses }}
+	{{ quote .Identifier }}: {Identifier: {{ quote .Identifier }}, Family: {{ quote .Family }}, Name: {{ quote .Name }}, ShortName: {{ quote .ShortName }}, Category: {{ .Category }}, Type: {{ .Type }}, URL: {{ quote .URL }}, Owner: {{ quote .Owner }}, OwnerURL: {{ quote .OwnerURL }}, OwnerType: {{ .OwnerType }}, IsOSIApproved: {{ .IsOSIApproved }}, IsFSFLibre: {{ .IsFSFLibre }}, IsDeprecated: {{ .IsDeprecated }}, IsSPDXListed: {{ .IsSPDXListed }}
+		{{- if .Permissions }}, Permissions: []Permission{ {{- join .Permissions ", " -}} }{{ end }}
+		{{- if .Obligations }}, Obligations: []Obligation{ {{- join .Obligations ", " -}} }{{ end }}
+		{{- if .Limitations }}, Limitations: []Limitation{ {{- join .Limitations ", " -}} }{{ end -}}
+	},
+{{- end }}
+}
+
+var exceptionLookup = map[string]LicenseException{
+{{- range .Exceptions }}
+	{{ quote .Identifier }}: {Identifier: {{ quote .Identifier }}, Name: {{ quote .Name }}, URL: {{ quote .URL }}, IsDeprecated: {{ .IsDeprecated }}},
+{{- end }}
+}
+`))
+
+func main() {
+	licensesPath := flag.String("licenses", "", "path to the SPDX licenses.json file")
+	exceptionsPath := flag.String("exceptions", "", "path to the SPDX exceptions.json file")
+	overlayPath := flag.String("overlay", "", "path to the overlay file containing categories and owners of licenses")
+	termsPath := flag.String("terms", "", "path to the file containing the permissions, conditions and limitations of licenses")
+	outPath := flag.String("out", "", "path of the go file to generate")
+	flag.Parse()
+
+	if *licensesPath == "" || *exceptionsPath == "" || *overlayPath == "" || *termsPath == "" || *outPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := generate(*licensesPath, *exceptionsPath, *overlayPath, *termsPath, *outPath); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func readJSON(path string, v interface{}) error {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("unable to parse '%s': %v", path, err)
+	}
+	return nil
+}
+
+func generate(licensesPath, exceptionsPath, overlayPath, termsPath, outPath string) error {
+	var licenseList spdxLicenseList
+	if err := readJSON(licensesPath, &licenseList); err != nil {
+		return err
+	}
+	var exceptionList spdxExceptionList
+	if err := readJSON(exceptionsPath, &exceptionList); err != nil {
+		return err
+	}
+	overlays := map[string]overlay{}
+	if err := readJSON(overlayPath, &overlays); err != nil {
+		return err
+	}
+
+	licenseTerms := map[string]terms{}
+	if err := readJSON(termsPath, &licenseTerms); err != nil {
+		return err
+	}
+
+	licenses, err := mergeLicenses(licenseList.Licenses, overlays)
+	if err != nil {
+		return err
+	}
+	for i := range licenses {
+		t, ok := licenseTerms[licenses[i].Identifier]
+		if !ok {
+			t, ok = licenseTerms[variantRegexp.ReplaceAllString(licenses[i].Identifier, "")]
+		}
+		if !ok {
+			continue
+		}
+		if err := applyTerms(&licenses[i], t); err != nil {
+			return err
+		}
+	}
+	exceptions := make([]exception, 0, len(exceptionList.Exceptions))
+	for _, e := range exceptionList.Exceptions {
+		exceptions = append(exceptions, exception{
+			Identifier:   e.Identifier,
+			Name:         e.Name,
+			URL:          e.Reference,
+			IsDeprecated: e.IsDeprecated,
+		})
+	}
+	sort.Slice(exceptions, func(i, j int) bool {
+		return exceptions[i].Identifier < exceptions[j].Identifier
+	})
+
+	var buf bytes.Buffer
+	err = output.Execute(&buf, struct {
+		Version    string
+		Licenses   []license
+		Exceptions []exception
+	}{licenseList.Version, licenses, exceptions})
+	if err != nil {
+		return err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(outPath, src, 0644)
+}
+
+func mergeLicenses(spdx []spdxLicense, overlays map[string]overlay) ([]license, error) {
+	out := make([]license, 0, len(spdx)+len(overlays))
+	found := map[string]bool{}
+	for _, s := range spdx {
+		l := license{
+			Identifier:    s.Identifier,
+			Name:          s.Name,
+			URL:           s.Reference,
+			IsOSIApproved: s.IsOSIApproved,
+			IsFSFLibre:    s.IsFSFLibre,
+			IsDeprecated:  s.IsDeprecated,
+			IsSPDXListed:  true,
+		}
+		o, ok := overlays[s.Identifier]
+		if !ok {
+			return nil, fmt.Errorf("license '%s' is not in the overlay file so has no category, type or owner", s.Identifier)
+		}
+		if err := applyOverlay(&l, o); err != nil {
+			return nil, err
+		}
+		found[s.Identifier] = true
+		out = append(out, l)
+	}
+	for id, o := range overlays {
+		if found[id] {
+			continue
+		}
+		if o.Name == "" {
+			return nil, fmt.Errorf("license '%s' is not in the SPDX license list so requires a name", id)
+		}
+		l := license{Identifier: id}
+		if err := applyOverlay(&l, o); err != nil {
+			return nil, err
+		}
+		out = append(out, l)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Identifier < out[j].Identifier
+	})
+	return out, nil
+}
+
+func applyOverlay(l *license, o overlay) error {
+	if o.Category == "" || o.Type == "" || o.Owner == "" {
+		return fmt.Errorf("license '%s' requires a category, type and owner in the overlay file", l.Identifier)
+	}
+	if o.Name != "" {
+		l.Name = o.Name
+	}
+	if o.URL != "" {
+		l.URL = o.URL
+	}
+	l.ShortName = o.ShortName
+	l.Family = o.Family
+	if l.Family == "" {
+		l.Family = family(l.Identifier)
+	}
+	l.Owner = o.Owner
+	l.OwnerURL = o.OwnerURL
+
+	var err error
+	if l.Category, err = toConstant("category", o.Category, l.Identifier); err != nil {
+		return err
+	}
+	if l.Type, err = toConstant("type", o.Type, l.Identifier); err != nil {
+		return err
+	}
+	l.OwnerType, err = toConstant("ownerType", o.OwnerType, l.Identifier)
+	return err
+}
+
+// toConstant returns the go expression representing an overlay value. Unset values are represented as empty strings.
+func toConstant(field, value, identifier string) (string, error) {
+	if value == "" {
+		return `""`, nil
+	}
+	c, ok := constants[field][value]
+	if !ok {
+		return "", fmt.Errorf("license '%s' has an unknown %s '%s'", identifier, field, value)
+	}
+	return c, nil
+}
+
+func applyTerms(l *license, t terms) error {
+	var err error
+	if l.Permissions, err = toConstants("permission", t.Permissions, l.Identifier); err != nil {
+		return err
+	}
+	if l.Obligations, err = toConstants("condition", t.Conditions, l.Identifier); err != nil {
+		return err
+	}
+	l.Limitations, err = toConstants("limitation", t.Limitations, l.Identifier)
+	return err
+}
+
+func toConstants(field string, values []string, identifier string) ([]string, error) {
+	out := make([]string, len(values))
+	for i, v := range values {
+		c, err := toConstant(field, v, identifier)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = c
+	}
+	return out, nil
+}
+
+// family returns the identifier up to the first part which starts with a digit, such as the version of the license
+func family(identifier string) string {
+	parts := strings.Split(identifier, "-")
+	for i, p := range parts {
+		if i > 0 && p != "" && p[0] >= '0' && p[0] <= '9' {
+			return strings.Join(parts[:i], "-")
+		}
+	}
+	return identifier
+}
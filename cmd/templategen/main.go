@@ -0,0 +1,64 @@
+// Command templategen embeds the SPDX license templates used by the classifier package into a go file.
+//
+// Each template is read from a file named after the SPDX identifier of the license it describes, for example MIT.txt.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	dir := flag.String("dir", "", "directory containing the license templates")
+	pkg := flag.String("package", "classifier", "name of the package the generated file belongs to")
+	outPath := flag.String("out", "", "path of the go file to generate")
+	flag.Parse()
+
+	if *dir == "" || *outPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := generate(*dir, *pkg, *outPath); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func generate(dir, pkg, outPath string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.txt"))
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no templates found in '%s'", dir)
+	}
+	sort.Strings(files)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by templategen from %s. DO NOT EDIT.\n\n", filepath.ToSlash(dir))
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	fmt.Fprintln(&buf, "var templateText = map[string]string{")
+	for _, f := range files {
+		text, err := ioutil.ReadFile(f)
+		if err != nil {
+			return err
+		}
+		identifier := strings.TrimSuffix(filepath.Base(f), ".txt")
+		fmt.Fprintf(&buf, "%s: %s,\n", strconv.Quote(identifier), strconv.Quote(string(text)))
+	}
+	fmt.Fprintln(&buf, "}")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(outPath, src, 0644)
+}
@@ -0,0 +1,223 @@
+package diligent
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var gnuRegexp = regexp.MustCompile(`^(A|L)?GPL-(\d\.\d)(\+|-only|-or-later)?$`)
+
+// gnuVersions holds the versions of each family of GNU licenses, in order
+var gnuVersions = map[string][]string{
+	"GPL":  {"1.0", "2.0", "3.0"},
+	"LGPL": {"2.0", "2.1", "3.0"},
+	"AGPL": {"1.0", "3.0"},
+}
+
+// gplIncompatible holds permissive and weak copyleft licenses whose terms, such as advertising or patent clauses,
+// conflict with every version of the GNU licenses
+var gplIncompatible = map[string]bool{
+	"Apache-1.0": true, "Apache-1.1": true, "BSD-4-Clause": true, "BSD-4-Clause-UC": true, "CDDL-1.0": true,
+	"CDDL-1.1": true, "CPL-1.0": true, "EPL-1.0": true, "EPL-2.0": true, "IPL-1.0": true, "MPL-1.0": true,
+	"MPL-1.1": true, "MPL-2.0-no-copyleft-exception": true, "OpenSSL": true, "PHP-3.0": true, "PHP-3.01": true,
+	"ZPL-1.1": true, "ZPL-2.0": true,
+}
+
+// gpl3Only holds licenses which are compatible with version 3 of the GNU licenses, but not earlier versions
+var gpl3Only = map[string]bool{"Apache-2.0": true}
+
+// gplCompatible holds weak copyleft licenses, other than the GNU licenses, which permit their code to be combined with
+// code licensed under the GNU licenses
+var gplCompatible = map[string]bool{"MPL-2.0": true}
+
+// linkingExceptions holds license exceptions which permit software linking with the licensed code to be released under
+// any license
+var linkingExceptions = map[string]bool{
+	"Classpath-exception-2.0": true, "GCC-exception-2.0": true, "GCC-exception-3.1": true, "LLVM-exception": true,
+	"Universal-FOSS-exception-1.0": true, "eCos-exception-2.0": true,
+}
+
+// gnuLicense is a version of a GNU license, such as GPL-2.0-or-later
+type gnuLicense struct {
+	identifier string
+	family     string
+	version    string
+	orLater    bool
+}
+
+func parseGNU(identifier string) (gnuLicense, bool) {
+	m := gnuRegexp.FindStringSubmatch(identifier)
+	if m == nil {
+		return gnuLicense{}, false
+	}
+	return gnuLicense{identifier: identifier, family: m[1] + "GPL", version: m[2], orLater: m[3] == "+" || m[3] == "-or-later"}, true
+}
+
+// versions returns the versions of the license family under which the license permits the work to be used
+func (g gnuLicense) versions() []string {
+	if !g.orLater {
+		return []string{g.version}
+	}
+	out := make([]string, 0)
+	for _, v := range gnuVersions[g.family] {
+		if v >= g.version {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// gplVersions returns the versions of the GPL under which the license permits the work to be used. Version 2 of the
+// LGPL permits conversion to version 2 or later of the GPL, whereas version 3 is written as permissions additional to
+// version 3 of the GPL.
+func (g gnuLicense) gplVersions() []string {
+	switch g.family {
+	case "GPL":
+		return g.versions()
+	case "LGPL":
+		if g.version == "3.0" {
+			return []string{"3.0"}
+		}
+		return []string{"2.0", "3.0"}
+	}
+	return nil
+}
+
+// IsCompatible reports whether a dependency under the inbound license expression may be included within a project
+// published under the outbound license. Every license combined using AND must be compatible, whereas only one of the
+// licenses combined using OR need be. When the licenses are incompatible, the reason given by the first incompatible
+// license is returned.
+func IsCompatible(inbound Expression, outbound License) (bool, string) {
+	switch inbound.Operator {
+	case And, Or:
+		first := ""
+		for _, o := range inbound.Operands {
+			ok, reason := IsCompatible(o, outbound)
+			if ok && inbound.Operator == Or {
+				return true, ""
+			}
+			if !ok && inbound.Operator == And {
+				return false, reason
+			}
+			if !ok && first == "" {
+				first = reason
+			}
+		}
+		return inbound.Operator == And, first
+	}
+	if linkingExceptions[inbound.Exception] {
+		return true, ""
+	}
+	l, err := GetLicenseFromIdentifier(inbound.Identifier)
+	if err != nil {
+		l, err = GetLicenseFromIdentifier(strings.TrimSuffix(inbound.Identifier, "+"))
+	}
+	if err != nil {
+		return false, fmt.Sprintf("the compatibility of '%s' is not known", inbound.Identifier)
+	}
+	return Compatible(l, outbound)
+}
+
+// Compatible reports whether code under the inbound license may be included within a project published under the
+// outbound license, and explains why when it may not. Permissive licenses are compatible with any outbound license,
+// other than those with terms which conflict with the GNU licenses. Licenses with limited copyleft are compatible with
+// permissive outbound licenses, as their obligations are confined to the licensed files or library. Copyleft licenses
+// are only compatible with outbound licenses whose terms they permit the whole work to be released under.
+func Compatible(inbound, outbound License) (bool, string) {
+	if inbound.Identifier == outbound.Identifier {
+		return true, ""
+	}
+	in, inGNU := parseGNU(inbound.Identifier)
+	out, outGNU := parseGNU(outbound.Identifier)
+	switch {
+	case inGNU && outGNU:
+		return gnuCompatible(in, out)
+	case outGNU:
+		return compatibleWithGNU(inbound, out)
+	}
+
+	switch inbound.Category {
+	case Permissive, PublicDomain, CopyLeftLimited:
+		return true, ""
+	case CopyLeft:
+		return false, fmt.Sprintf("'%s' requires works including it to be released under the same license, which '%s' is not", inbound.Identifier, outbound.Identifier)
+	case FreeRestricted, ProprietaryFree:
+		if outbound.Type != OpenSource {
+			return true, ""
+		}
+		return false, restricted(inbound.Identifier, outbound.Identifier)
+	}
+	return false, fmt.Sprintf("the compatibility of '%s' is not known", inbound.Identifier)
+}
+
+func gnuCompatible(in, out gnuLicense) (bool, string) {
+	switch {
+	case in.family == out.family:
+		if overlaps(in.versions(), out.versions()) {
+			return true, ""
+		}
+		return false, fmt.Sprintf("'%s' does not permit the work to be released under version %s of the %s", in.identifier, out.version, out.family)
+	case out.family == "LGPL":
+		return false, fmt.Sprintf("'%s' requires the whole work to be released under the %s, which '%s' does not", in.identifier, in.family, out.identifier)
+	case in.family == "AGPL":
+		// version 3 of the GPL and AGPL permit their code to be combined
+		if contains(out.versions(), "3.0") && contains(in.versions(), "3.0") {
+			return true, ""
+		}
+		return false, fmt.Sprintf("'%s' may only be combined with version 3.0 of the GPL", in.identifier)
+	case out.family == "AGPL":
+		if contains(out.versions(), "3.0") && contains(in.gplVersions(), "3.0") {
+			return true, ""
+		}
+		return false, fmt.Sprintf("'%s' does not permit the work to be combined with version %s of the AGPL", in.identifier, out.version)
+	default:
+		if overlaps(in.gplVersions(), out.versions()) {
+			return true, ""
+		}
+		return false, fmt.Sprintf("'%s' does not permit the work to be released under version %s of the GPL", in.identifier, out.version)
+	}
+}
+
+func compatibleWithGNU(inbound License, out gnuLicense) (bool, string) {
+	switch {
+	case gplIncompatible[inbound.Identifier]:
+		return false, fmt.Sprintf("'%s' has terms, such as patent or advertising clauses, which conflict with the %s", inbound.Identifier, out.family)
+	case gpl3Only[inbound.Identifier] && !contains(out.versions(), "3.0"):
+		return false, fmt.Sprintf("'%s' is only compatible with version 3.0 of the %s, not version %s", inbound.Identifier, out.family, out.version)
+	}
+	switch inbound.Category {
+	case Permissive, PublicDomain:
+		return true, ""
+	case CopyLeftLimited, CopyLeft:
+		if gplCompatible[inbound.Identifier] {
+			return true, ""
+		}
+		return false, fmt.Sprintf("the copyleft terms of '%s' conflict with those of '%s'", inbound.Identifier, out.identifier)
+	case FreeRestricted, ProprietaryFree:
+		return false, restricted(inbound.Identifier, out.identifier)
+	}
+	return false, fmt.Sprintf("the compatibility of '%s' is not known", inbound.Identifier)
+}
+
+func restricted(inbound, outbound string) string {
+	return fmt.Sprintf("'%s' restricts the use or distribution of the software, which conflicts with the freedoms '%s' grants", inbound, outbound)
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func overlaps(a, b []string) bool {
+	for _, s := range a {
+		if contains(b, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package diligent_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+)
+
+func TestCompatible(t *testing.T) {
+	cases := []struct {
+		d        string
+		inbound  string
+		outbound string
+		out      bool
+		reason   string
+	}{
+		{"same license", "GPL-2.0-only", "GPL-2.0-only", true, ""},
+		{"permissive into permissive", "MIT", "Apache-2.0", true, ""},
+		{"permissive into copyleft", "BSD-3-Clause", "GPL-2.0-only", true, ""},
+		{"copyleft into permissive", "GPL-2.0-only", "Apache-2.0", false, "'GPL-2.0-only' requires works including it to be released under the same license, which 'Apache-2.0' is not"},
+		{"limited copyleft into permissive", "LGPL-2.1", "MIT", true, ""},
+		{"restricted into open source", "CC-BY-NC-4.0", "MIT", false, "restricts the use or distribution"},
+		{"apache into GPL 2", "Apache-2.0", "GPL-2.0-only", false, "only compatible with version 3.0 of the GPL"},
+		{"apache into GPL 2 or later", "Apache-2.0", "GPL-2.0-or-later", true, ""},
+		{"apache into GPL 3", "Apache-2.0", "GPL-3.0", true, ""},
+		{"advertising clause into GPL", "BSD-4-Clause", "GPL-3.0", false, "conflict with the GPL"},
+		{"MPL into GPL", "MPL-2.0", "GPL-3.0-or-later", true, ""},
+		{"EPL into GPL", "EPL-1.0", "GPL-2.0-only", false, "conflict with the GPL"},
+		{"GPL 2 only into GPL 3", "GPL-2.0-only", "GPL-3.0-only", false, "does not permit the work to be released under version 3.0 of the GPL"},
+		{"GPL 2 or later into GPL 3", "GPL-2.0+", "GPL-3.0-only", true, ""},
+		{"GPL 3 into GPL 2 or later", "GPL-3.0-only", "GPL-2.0-or-later", true, ""},
+		{"LGPL 2.1 into GPL 2", "LGPL-2.1-only", "GPL-2.0-only", true, ""},
+		{"LGPL 3 into GPL 2", "LGPL-3.0-only", "GPL-2.0-only", false, "version 2.0 of the GPL"},
+		{"GPL into LGPL", "GPL-3.0", "LGPL-3.0", false, "requires the whole work to be released under the GPL"},
+		{"GPL into AGPL", "GPL-3.0-or-later", "AGPL-3.0-only", true, ""},
+		{"GPL 2 into AGPL", "GPL-2.0-only", "AGPL-3.0", false, "version 3.0 of the AGPL"},
+		{"AGPL into GPL 3", "AGPL-3.0", "GPL-3.0", true, ""},
+		{"AGPL into GPL 2", "AGPL-3.0", "GPL-2.0", false, "may only be combined with version 3.0 of the GPL"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			in, err := diligent.GetLicenseFromIdentifier(c.inbound)
+			if err != nil {
+				t.Fatal(err)
+			}
+			out, err := diligent.GetLicenseFromIdentifier(c.outbound)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ok, reason := diligent.Compatible(in, out)
+			if ok != c.out || !strings.Contains(reason, c.reason) || (c.out && reason != "") {
+				t.Errorf("expected %v %q, got %v %q", c.out, c.reason, ok, reason)
+			}
+		})
+	}
+}
+
+func TestIsCompatible(t *testing.T) {
+	apache, err := diligent.GetLicenseFromIdentifier("Apache-2.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		d       string
+		inbound string
+		out     bool
+		reason  string
+	}{
+		{"OR needs one compatible license", "GPL-2.0-only OR MIT", true, ""},
+		{"AND needs every license compatible", "MIT AND GPL-2.0-only", false, "'GPL-2.0-only' requires works"},
+		{"OR of incompatible licenses", "GPL-2.0-only OR GPL-3.0-only", false, "'GPL-2.0-only' requires works"},
+		{"linking exception", "GPL-2.0-only WITH Classpath-exception-2.0", true, ""},
+		{"unknown license", "woowoo", false, "the compatibility of 'woowoo' is not known"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			e, err := diligent.ParseExpression(c.inbound)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ok, reason := diligent.IsCompatible(e, apache)
+			if ok != c.out || !strings.Contains(reason, c.reason) {
+				t.Errorf("expected %v %q, got %v %q", c.out, c.reason, ok, reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+// Package config loads diligent configuration files, which hold the options otherwise provided on the command line.
+//
+// Configuration files are named .diligent.yml, .diligent.yaml or .diligent.toml and are discovered by searching from
+// the path being scanned up through its parent directories. For example:
+//
+//	whitelist: [permissive, GPL-3.0]
+//	denylist: [JSON]
+//	ignore: ["^github.com/acme/"]
+//	npm:
+//	  dev-dependencies: true
+//	  registry: https://registry.npmjs.org
+//	go:
+//	  scan-headers: true
+//	github:
+//	  api-url: https://api.github.com
+//	distribution: saas
+//	project-license: Apache-2.0
+//	rules:
+//	  - name: no-agpl
+//	    action: deny
+//	    licenses: [AGPL-3.0]
+//	overrides:
+//	  - package: github.com/acme/fork
+//	    version: 1.2.x
+//	    license: MIT
+//	    justification: private fork of an MIT licensed project
+//	exceptions:
+//	  - package: readline
+//	    license: GPL-3.0
+//	    approver: legal@example.com
+//	    ticket: LEGAL-42
+//	    expires: 2024-06-30
+//	outputs:
+//	  - format: junit
+//	    file: licenses.xml
+//	  - format: html
+//	    file: licenses.html
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/policy"
+	"gopkg.in/yaml.v2"
+)
+
+// unquotedExpiryRegexp matches an expiry date written as a TOML date, which the TOML parser does not support
+var unquotedExpiryRegexp = regexp.MustCompile(`(?m)^\s*expires\s*=\s*[0-9]`)
+
+// Filenames lists the names of configuration files, in order of precedence
+var Filenames = []string{".diligent.yml", ".diligent.yaml", ".diligent.toml"}
+
+// Config holds the options of a diligent run
+type Config struct {
+	// Whitelist holds the license identifiers and categories permitted
+	Whitelist []string `yaml:"whitelist,omitempty" toml:"whitelist"`
+	// Denylist holds license identifiers and categories which are not permitted, even when whitelisted
+	Denylist []string `yaml:"denylist,omitempty" toml:"denylist"`
+	// Rules allow and deny licenses in addition to the whitelist and denylist
+	Rules []policy.Rule `yaml:"rules,omitempty" toml:"rules"`
+	// Distribution selects the rules preset for the way the software is distributed, such as saas or on-prem
+	Distribution policy.Distribution `yaml:"distribution,omitempty" toml:"distribution"`
+	// ProjectLicense is the license under which the software is published, against which the compatibility of the
+	// licenses of dependencies is checked, or auto to identify it from the license files of the scanned path
+	ProjectLicense string `yaml:"project-license,omitempty" toml:"project-license"`
+	// Ignore holds regular expressions matching the names of packages which are not reported on or validated
+	Ignore []string `yaml:"ignore,omitempty" toml:"ignore"`
+	// Licenses is the path of a license definitions file, relative to the configuration file
+	Licenses string `yaml:"licenses,omitempty" toml:"licenses"`
+	NPM      NPM    `yaml:"npm,omitempty" toml:"npm"`
+	Go       Go     `yaml:"go,omitempty" toml:"go"`
+	GitHub   GitHub `yaml:"github,omitempty" toml:"github"`
+	// Overrides assign licenses to dependencies whose licenses cannot be determined automatically
+	Overrides []diligent.Override `yaml:"overrides,omitempty" toml:"overrides"`
+	// Exceptions permit dependencies whose licenses are not whitelisted until their expiry dates
+	Exceptions []diligent.Exception `yaml:"exceptions,omitempty" toml:"exceptions"`
+	Outputs    []Output             `yaml:"outputs,omitempty" toml:"outputs"`
+}
+
+// NPM holds the options of the NPM Deper
+type NPM struct {
+	DevDependencies bool   `yaml:"dev-dependencies,omitempty" toml:"dev-dependencies"`
+	Registry        string `yaml:"registry,omitempty" toml:"registry"`
+}
+
+// Go holds the options used when determining the licenses of Go dependencies
+type Go struct {
+	ScanHeaders bool `yaml:"scan-headers,omitempty" toml:"scan-headers"`
+}
+
+// GitHub holds the options of the GitHub API client
+type GitHub struct {
+	APIURL string `yaml:"api-url,omitempty" toml:"api-url"`
+}
+
+// Output is a report written in a format to a file, or stdout when File is blank
+type Output struct {
+	Format string `yaml:"format" toml:"format"`
+	File   string `yaml:"file,omitempty" toml:"file"`
+	// Template is the path of the template used by the template format
+	Template string `yaml:"template,omitempty" toml:"template"`
+}
+
+// Find returns the path of the configuration file within dir, or the nearest of its parents, or an empty string if
+// there is none
+func Find(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	if info, err := os.Stat(dir); err == nil && !info.IsDir() {
+		dir = filepath.Dir(dir)
+	}
+	for {
+		for _, name := range Filenames {
+			path := filepath.Join(dir, name)
+			if info, err := os.Stat(path); err == nil && info.Mode().IsRegular() {
+				return path, nil
+			}
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+// Load reads the configuration file at path. Relative paths held within the file are resolved against the directory
+// holding it.
+func Load(path string) (Config, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+	c, err := Parse(filepath.Base(path), b)
+	if err != nil {
+		return Config{}, fmt.Errorf("unable to parse configuration '%s': %v", path, err)
+	}
+	dir := filepath.Dir(path)
+	c.Licenses = resolve(dir, c.Licenses)
+	for i := range c.Outputs {
+		c.Outputs[i].File = resolve(dir, c.Outputs[i].File)
+		c.Outputs[i].Template = resolve(dir, c.Outputs[i].Template)
+	}
+	return c, nil
+}
+
+// Parse parses the content of a configuration file, as TOML if filename ends in .toml and as YAML otherwise. Unknown
+// keys are rejected in either format. Expiry dates must be quoted strings in TOML.
+func Parse(filename string, b []byte) (Config, error) {
+	var c Config
+	if strings.ToLower(filepath.Ext(filename)) == ".toml" {
+		tree, err := toml.LoadBytes(b)
+		if err != nil {
+			if unquotedExpiryRegexp.Match(b) {
+				err = fmt.Errorf("%v: expiry dates must be quoted, for example expires = \"2024-06-30\"", err)
+			}
+			return c, err
+		}
+		if unknown := unknownKeys(tree, reflect.TypeOf(c), ""); len(unknown) > 0 {
+			return c, fmt.Errorf("unknown keys: %s", strings.Join(unknown, ", "))
+		}
+		err = tree.Unmarshal(&c)
+		return c, err
+	}
+	err := yaml.UnmarshalStrict(b, &c)
+	return c, err
+}
+
+// unknownKeys returns the keys of the TOML tree, and of the tables nested within it, which do not correspond to a
+// field of the struct type t. Each is described by its path, prefixed by prefix, and position. Fields are matched in
+// the same way as the TOML decoder, which does not report the keys it ignores.
+func unknownKeys(tree *toml.Tree, t reflect.Type, prefix string) []string {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.TrimSpace(strings.Split(f.Tag.Get("toml"), ",")[0])
+		if f.PkgPath != "" || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		for _, key := range []string{name, strings.ToLower(name), strings.ToTitle(name)} {
+			fields[key] = f.Type
+		}
+	}
+
+	keys := tree.Keys()
+	sort.Strings(keys)
+	out := make([]string, 0)
+	for _, key := range keys {
+		path := prefix + key
+		ft, ok := fields[key]
+		if !ok {
+			out = append(out, fmt.Sprintf("%s %s", path, tree.GetPosition(key)))
+			continue
+		}
+		switch v := tree.GetPath([]string{key}).(type) {
+		case *toml.Tree:
+			if ft.Kind() == reflect.Struct {
+				out = append(out, unknownKeys(v, ft, path+".")...)
+			}
+		case []*toml.Tree:
+			if ft.Kind() == reflect.Slice && ft.Elem().Kind() == reflect.Struct {
+				for i, e := range v {
+					out = append(out, unknownKeys(e, ft.Elem(), fmt.Sprintf("%s[%d].", path, i))...)
+				}
+			}
+		}
+	}
+	return out
+}
+
+// YAML returns the configuration in the YAML format
+func (c Config) YAML() ([]byte, error) {
+	return yaml.Marshal(c)
+}
+
+// resolve returns path relative to dir, unless it is blank or absolute
+func resolve(dir, path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
@@ -0,0 +1,251 @@
+package config_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/config"
+	"github.com/senseyeio/diligent/policy"
+)
+
+const yamlConfig = `whitelist: [permissive, GPL-3.0]
+denylist: [JSON]
+rules:
+  - name: copyleft-limited-dev
+    action: allow
+    licenses: [copyleft-limited]
+    osi-approved: true
+    scopes: [development]
+ignore: ["^github.com/acme/"]
+licenses: licenses.toml
+npm:
+  dev-dependencies: true
+  registry: https://npm.example.com
+go:
+  scan-headers: true
+github:
+  api-url: https://github.example.com/api/v3
+overrides:
+  - package: github.com/acme/fork
+    version: 1.2.x
+    license: MIT
+    justification: private fork of an MIT licensed project
+exceptions:
+  - package: readline
+    license: GPL-3.0
+    approver: legal@example.com
+    ticket: LEGAL-42
+    expires: 2024-06-30
+outputs:
+  - format: junit
+    file: licenses.xml
+  - format: pretty
+`
+
+const tomlConfig = `whitelist = ["permissive", "GPL-3.0"]
+denylist = ["JSON"]
+ignore = ["^github.com/acme/"]
+licenses = "licenses.toml"
+
+[[rules]]
+name = "copyleft-limited-dev"
+action = "allow"
+licenses = ["copyleft-limited"]
+osi-approved = true
+scopes = ["development"]
+
+[npm]
+dev-dependencies = true
+registry = "https://npm.example.com"
+
+[go]
+scan-headers = true
+
+[github]
+api-url = "https://github.example.com/api/v3"
+
+[[overrides]]
+package = "github.com/acme/fork"
+version = "1.2.x"
+license = "MIT"
+justification = "private fork of an MIT licensed project"
+
+[[exceptions]]
+package = "readline"
+license = "GPL-3.0"
+approver = "legal@example.com"
+ticket = "LEGAL-42"
+expires = "2024-06-30"
+
+[[outputs]]
+format = "junit"
+file = "licenses.xml"
+
+[[outputs]]
+format = "pretty"
+`
+
+var osiApproved = true
+
+var expected = config.Config{
+	Whitelist: []string{"permissive", "GPL-3.0"},
+	Denylist:  []string{"JSON"},
+	Rules: []policy.Rule{{
+		Name:        "copyleft-limited-dev",
+		Action:      policy.Allow,
+		Licenses:    []string{"copyleft-limited"},
+		OSIApproved: &osiApproved,
+		Scopes:      []diligent.Scope{diligent.Development},
+	}},
+	Ignore:   []string{"^github.com/acme/"},
+	Licenses: "licenses.toml",
+	NPM:      config.NPM{DevDependencies: true, Registry: "https://npm.example.com"},
+	Go:       config.Go{ScanHeaders: true},
+	GitHub:   config.GitHub{APIURL: "https://github.example.com/api/v3"},
+	Overrides: []diligent.Override{{
+		Package:       "github.com/acme/fork",
+		Version:       "1.2.x",
+		License:       "MIT",
+		Justification: "private fork of an MIT licensed project",
+	}},
+	Exceptions: []diligent.Exception{{
+		Package:  "readline",
+		License:  "GPL-3.0",
+		Approver: "legal@example.com",
+		Ticket:   "LEGAL-42",
+		Expires:  "2024-06-30",
+	}},
+	Outputs: []config.Output{{Format: "junit", File: "licenses.xml"}, {Format: "pretty"}},
+}
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		d        string
+		filename string
+		content  string
+		expected config.Config
+		errors   bool
+	}{
+		{"yaml", ".diligent.yml", yamlConfig, expected, false},
+		{"toml", ".diligent.toml", tomlConfig, expected, false},
+		{"empty", ".diligent.yml", "", config.Config{}, false},
+		{"unknown yaml keys are rejected", ".diligent.yml", "whitelists: [MIT]", config.Config{}, true},
+		{"unknown toml keys are rejected", ".diligent.toml", "whitelsit = [\"MIT\"]", config.Config{}, true},
+		{"unknown nested toml keys are rejected", ".diligent.toml", "[npm]\nregistri = \"https://example.com\"\n", config.Config{}, true},
+		{"unknown toml keys within arrays of tables are rejected", ".diligent.toml", "[[exceptions]]\npackage = \"readline\"\nexpiry = \"2024-06-30\"\n", config.Config{}, true},
+		{"invalid toml", ".diligent.toml", "whitelist = [", config.Config{}, true},
+		{"yaml dates", ".diligent.yml", "exceptions:\n  - expires: 2024-06-30\n", config.Config{Exceptions: []diligent.Exception{{Expires: "2024-06-30"}}}, false},
+		{"quoted toml dates", ".diligent.toml", "[[exceptions]]\nexpires = \"2024-06-30\"\n", config.Config{Exceptions: []diligent.Exception{{Expires: "2024-06-30"}}}, false},
+		{"unquoted toml dates are rejected", ".diligent.toml", "[[exceptions]]\nexpires = 2024-06-30\n", config.Config{}, true},
+	}
+	for _, tt := range cases {
+		t.Run(tt.d, func(t *testing.T) {
+			c, err := config.Parse(tt.filename, []byte(tt.content))
+			if tt.errors {
+				if err == nil {
+					t.Error("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(c, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, c)
+			}
+		})
+	}
+}
+
+func TestParseUnquotedExpiry(t *testing.T) {
+	_, err := config.Parse(".diligent.toml", []byte("[[exceptions]]\nexpires = 2024-06-30\n"))
+	if err == nil || !strings.Contains(err.Error(), `expiry dates must be quoted, for example expires = "2024-06-30"`) {
+		t.Errorf("expected an error explaining expiry dates must be quoted, got %v", err)
+	}
+}
+
+func TestParseUnknownTOMLKeys(t *testing.T) {
+	_, err := config.Parse(".diligent.toml", []byte("whitelsit = [\"MIT\"]\n[[outputs]]\nformat = \"json\"\npath = \"licenses.json\"\n"))
+	if err == nil || err.Error() != "unknown keys: outputs[0].path (4, 1), whitelsit (1, 1)" {
+		t.Errorf("expected an error naming the unknown keys, got %v", err)
+	}
+}
+
+func TestYAML(t *testing.T) {
+	b, err := expected.YAML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := config.Parse(".diligent.yml", b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(c, expected) {
+		t.Errorf("expected %+v, got %+v", expected, c)
+	}
+}
+
+func TestFindAndLoad(t *testing.T) {
+	root, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	nested := filepath.Join(root, "a", "b")
+	if err := os.MkdirAll(nested, 0755); err != nil {
+		t.Fatal(err)
+	}
+	manifest := filepath.Join(nested, "package.json")
+	if err := ioutil.WriteFile(manifest, []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(root, "a", ".diligent.yml")
+	if err := ioutil.WriteFile(path, []byte(yamlConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		d        string
+		from     string
+		expected string
+	}{
+		{"in the directory", filepath.Join(root, "a"), path},
+		{"in a parent directory", nested, path},
+		{"from a file", manifest, path},
+		{"not found", root, ""},
+	}
+	for _, tt := range cases {
+		t.Run(tt.d, func(t *testing.T) {
+			found, err := config.Find(tt.from)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// a configuration file may exist above the temporary directory
+			if tt.expected == "" && strings.HasPrefix(found, root) {
+				t.Errorf("expected no configuration, got %s", found)
+			}
+			if tt.expected != "" && found != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, found)
+			}
+		})
+	}
+
+	c, err := config.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := filepath.Join(root, "a", "licenses.toml"); c.Licenses != exp {
+		t.Errorf("expected licenses %s, got %s", exp, c.Licenses)
+	}
+	if exp := filepath.Join(root, "a", "licenses.xml"); c.Outputs[0].File != exp {
+		t.Errorf("expected output file %s, got %s", exp, c.Outputs[0].File)
+	}
+	if c.Outputs[1].File != "" {
+		t.Errorf("expected output to stdout, got %s", c.Outputs[1].File)
+	}
+}
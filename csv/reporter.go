@@ -2,7 +2,9 @@ package csv
 
 import (
 	encCSV "encoding/csv"
+	"fmt"
 	"io"
+	"strings"
 
 	"github.com/senseyeio/diligent"
 )
@@ -15,19 +17,59 @@ func NewReporter() diligent.Reporter {
 	return &csv{}
 }
 
-// Report outputs the dependencies and their licenses to a CSV file
-func (c *csv) Report(w io.Writer, deps []diligent.Dep) error {
+// Report outputs the dependencies and their licenses to a CSV file. Dependencies which are not permitted have their
+// violation recorded, as do those permitted by an exception, and each dependency whose license could not be determined
+// is output with its warning.
+func (c *csv) Report(w io.Writer, r diligent.Report) error {
 	writer := encCSV.NewWriter(w)
 
-	if err := writer.Write([]string{"Name", "License ID", "License Name", "License URL"}); err != nil {
+	if err := writer.Write([]string{"Name", "License ID", "License Name", "License URL", "Version", "Ecosystem", "Manifest", "Relationship", "Violation", "Warning", "Exception"}); err != nil {
 		return err
 	}
-	for _, d := range deps {
-		if err := writer.Write([]string{d.Name, d.License.Identifier, d.License.Name, d.License.URL}); err != nil {
+	violations := violationMessages(r.Violations)
+	for _, d := range r.Deps {
+		names, urls := licenseNamesAndURLs(d)
+		exception := ""
+		if e, ok := r.Exception(d); ok {
+			exception = fmt.Sprintf("%s approved by %s until %s", e.Ticket, e.Approver, e.Expires)
+		}
+		record := []string{d.Name, d.LicenseExpression(), names, urls, d.Version, d.Ecosystem, d.Manifest, string(d.Relationship), violations[depKey(d)], "", exception}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	for _, wa := range r.Warnings {
+		record := []string{wa.Dependency(), "", "", "", "", wa.Ecosystem(), "", "", "", string(wa.Reason()) + ": " + wa.Err().Error(), ""}
+		if err := writer.Write(record); err != nil {
 			return err
 		}
 	}
 	writer.Flush()
 
-	return nil
+	return writer.Error()
+}
+
+func depKey(d diligent.Dep) string {
+	return d.Name + "@" + d.Version
+}
+
+// violationMessages indexes the message of each violation by its dependency
+func violationMessages(vv []diligent.Violation) map[string]string {
+	out := make(map[string]string, len(vv))
+	for _, v := range vv {
+		out[depKey(v.Dep)] = v.Message
+	}
+	return out
+}
+
+// licenseNamesAndURLs joins the names and URLs of each license covering a dependency
+func licenseNamesAndURLs(d diligent.Dep) (string, string) {
+	ll := d.Licenses()
+	names := make([]string, len(ll))
+	urls := make([]string, len(ll))
+	for i, l := range ll {
+		names[i] = l.Name
+		urls[i] = l.URL
+	}
+	return strings.Join(names, "; "), strings.Join(urls, " ")
 }
@@ -0,0 +1,228 @@
+// Package cyclonedx outputs diligent reports as CycloneDX 1.5 software bill of materials documents, in either the
+// JSON or the XML format.
+//
+// Each dependency is described by a library component, including its package URL, and each manifest processed by a
+// file component. The dependency graph records the dependencies each manifest declares directly. Components are
+// ordered and identified independently of the order in which dependencies were found, so that documents describing
+// the same dependencies differ only in their serial number and timestamp.
+package cyclonedx
+
+import (
+	"crypto/rand"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/senseyeio/diligent"
+)
+
+const (
+	// SpecVersion is the version of the CycloneDX specification to which documents conform
+	SpecVersion = "1.5"
+
+	bomFormat = "CycloneDX"
+	namespace = "http://cyclonedx.org/schema/bom/1.5"
+)
+
+var invalidIDChars = regexp.MustCompile(`[^A-Za-z0-9.-]+`)
+
+type bom struct {
+	serialNumber string
+	timestamp    time.Time
+	toolVersion  string
+	components   []component
+	dependencies []dependency
+}
+
+type component struct {
+	kind       string
+	ref        string
+	name       string
+	version    string
+	licenses   []license
+	expression string
+	purl       string
+	properties []property
+}
+
+// license is either an SPDX license identifier or, for licenses which are not on the SPDX license list, a name
+type license struct {
+	id   string
+	name string
+	url  string
+}
+
+type property struct {
+	name  string
+	value string
+}
+
+type dependency struct {
+	ref       string
+	dependsOn []string
+}
+
+// newBOM describes the report as a CycloneDX BOM
+func newBOM(r diligent.Report) (bom, error) {
+	serialNumber, err := newSerialNumber()
+	if err != nil {
+		return bom{}, err
+	}
+	b := bom{
+		serialNumber: serialNumber,
+		timestamp:    r.Timestamp.UTC(),
+		toolVersion:  r.ToolVersion,
+	}
+	// a dependency may be declared by several manifests, or fail to be processed by each of them
+	seen := map[string]bool{}
+	graph := map[string][]string{}
+
+	manifestRefs := map[string]string{}
+	for _, m := range r.Manifests {
+		ref := "manifest:" + m
+		manifestRefs[m] = ref
+		graph[ref] = []string{}
+		b.components = append(b.components, component{kind: "file", ref: ref, name: m})
+	}
+
+	for _, d := range r.Deps {
+		ref := fmt.Sprintf("%s:%s@%s", d.Ecosystem, d.Name, d.Version)
+		if manifestRef, ok := manifestRefs[d.Manifest]; ok && d.Relationship != diligent.Transitive {
+			graph[manifestRef] = append(graph[manifestRef], ref)
+		}
+		if seen[ref] {
+			continue
+		}
+		seen[ref] = true
+		graph[ref] = []string{}
+		c := component{kind: "library", ref: ref, name: d.Name, version: d.Version, purl: packageURL(d.Ecosystem, d.Name, d.Version)}
+		if d.Expression != "" {
+			c.expression = licenseExpression(d.Expression)
+		} else {
+			c.licenses = []license{toLicense(d.License)}
+		}
+		if d.Relationship != "" {
+			c.properties = append(c.properties, property{"diligent:relationship", string(d.Relationship)})
+		}
+		if e, ok := r.Exception(d); ok {
+			c.properties = append(c.properties,
+				property{"diligent:exception:ticket", e.Ticket},
+				property{"diligent:exception:approver", e.Approver},
+				property{"diligent:exception:expires", e.Expires},
+			)
+		}
+		b.components = append(b.components, c)
+	}
+
+	for _, w := range r.Warnings {
+		ref := fmt.Sprintf("%s:%s", w.Ecosystem(), w.Dependency())
+		if seen[ref] {
+			continue
+		}
+		seen[ref] = true
+		graph[ref] = []string{}
+		b.components = append(b.components, component{
+			kind: "library",
+			ref:  ref,
+			name: w.Dependency(),
+			purl: packageURL(w.Ecosystem(), w.Dependency(), ""),
+			properties: []property{
+				{"diligent:warning:reason", string(w.Reason())},
+				{"diligent:warning:message", w.Err().Error()},
+			},
+		})
+	}
+
+	sort.Slice(b.components, func(i, j int) bool {
+		return b.components[i].ref < b.components[j].ref
+	})
+	for ref, dependsOn := range graph {
+		sort.Strings(dependsOn)
+		b.dependencies = append(b.dependencies, dependency{ref, dedupe(dependsOn)})
+	}
+	sort.Slice(b.dependencies, func(i, j int) bool {
+		return b.dependencies[i].ref < b.dependencies[j].ref
+	})
+	return b, nil
+}
+
+// toLicense refers to licenses on the SPDX license list by identifier and to any other license, whether only known to
+// diligent or user defined, by name
+func toLicense(l diligent.License) license {
+	if !l.IsSPDXListed {
+		return license{name: l.Name, url: l.URL}
+	}
+	return license{id: l.Identifier}
+}
+
+// licenseExpression returns the expression using canonical SPDX license identifiers. Licenses which are not on the SPDX
+// license list are referred to using LicenseRef identifiers.
+func licenseExpression(expression string) string {
+	e, err := diligent.ParseExpression(expression)
+	if err != nil {
+		return expression
+	}
+	return canonicalise(e).String()
+}
+
+func canonicalise(e diligent.Expression) diligent.Expression {
+	if e.Operator != "" {
+		operands := make([]diligent.Expression, len(e.Operands))
+		for i, o := range e.Operands {
+			operands[i] = canonicalise(o)
+		}
+		e.Operands = operands
+		return e
+	}
+	suffix := ""
+	identifier := e.Identifier
+	if strings.HasSuffix(identifier, "+") {
+		suffix = "+"
+		identifier = strings.TrimSuffix(identifier, "+")
+	}
+	// the "+" is dropped from licenses referred to using a LicenseRef, which it may not follow
+	l, err := diligent.GetLicenseFromIdentifier(identifier)
+	if err != nil {
+		e.Identifier = licenseRef(identifier)
+		return e
+	}
+	if !l.IsSPDXListed {
+		e.Identifier = licenseRef(l.Identifier)
+		return e
+	}
+	e.Identifier = l.Identifier + suffix
+	return e
+}
+
+// licenseRef returns a LicenseRef identifier for a license which is not on the SPDX license list
+func licenseRef(identifier string) string {
+	if strings.HasPrefix(identifier, "LicenseRef-") {
+		return identifier
+	}
+	return "LicenseRef-" + strings.Trim(invalidIDChars.ReplaceAllString(identifier, "-"), "-")
+}
+
+// dedupe removes repeated values from a sorted slice
+func dedupe(ss []string) []string {
+	out := make([]string, 0, len(ss))
+	for i, s := range ss {
+		if i == 0 || s != ss[i-1] {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// newSerialNumber returns a URN holding a random, version 4, UUID. CycloneDX expects every BOM to have a unique
+// serial number, even when its content is unchanged.
+func newSerialNumber() (string, error) {
+	u := make([]byte, 16)
+	if _, err := rand.Read(u); err != nil {
+		return "", err
+	}
+	u[6] = (u[6] & 0x0f) | 0x40
+	u[8] = (u[8] & 0x3f) | 0x80
+	return fmt.Sprintf("urn:uuid:%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:16]), nil
+}
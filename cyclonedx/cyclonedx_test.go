@@ -0,0 +1,281 @@
+package cyclonedx_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"regexp"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/cyclonedx"
+	"github.com/senseyeio/diligent/internal/reporttest"
+)
+
+var serialNumberRegexp = regexp.MustCompile(`^urn:uuid:[0-9a-f]{8}-[0-9a-f]{4}-[1-5][0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+// testReport returns the shared report with d3 replaced by the scoped package @types/d3
+func testReport(t *testing.T) diligent.Report {
+	r := reporttest.Report(t)
+	r.Deps[0].Name = "@types/d3"
+	return r
+}
+
+type licenseChoice struct {
+	License *struct {
+		ID   string `json:"id" xml:"id"`
+		Name string `json:"name" xml:"name"`
+	} `json:"license"`
+	Expression string `json:"expression"`
+}
+
+type component struct {
+	Type       string          `json:"type" xml:"type,attr"`
+	BOMRef     string          `json:"bom-ref" xml:"bom-ref,attr"`
+	Name       string          `json:"name" xml:"name"`
+	Version    string          `json:"version" xml:"version"`
+	Licenses   []licenseChoice `json:"licenses"`
+	PURL       string          `json:"purl" xml:"purl"`
+	Properties []property      `json:"properties" xml:"properties>property"`
+}
+
+type property struct {
+	Name  string `json:"name" xml:"name,attr"`
+	Value string `json:"value" xml:",chardata"`
+}
+
+type dependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn"`
+}
+
+// checkBOM checks the constraints the CycloneDX 1.5 schema places on the parts of the BOM diligent produces
+func checkBOM(t *testing.T, serialNumber string, components []component, dependencies []dependency) {
+	if !serialNumberRegexp.MatchString(serialNumber) {
+		t.Errorf("invalid serial number %s", serialNumber)
+	}
+	refs := map[string]component{}
+	for _, c := range components {
+		if c.Type != "library" && c.Type != "file" {
+			t.Errorf("unexpected component type %s", c.Type)
+		}
+		if c.Name == "" {
+			t.Errorf("component %s has no name", c.BOMRef)
+		}
+		if _, ok := refs[c.BOMRef]; ok || c.BOMRef == "" {
+			t.Errorf("bom-ref %q is not unique", c.BOMRef)
+		}
+		refs[c.BOMRef] = c
+		for _, l := range c.Licenses {
+			if (l.License == nil) == (l.Expression == "") {
+				t.Errorf("component %s must have either a license or an expression, got %+v", c.BOMRef, l)
+			}
+			if l.License != nil && (l.License.ID == "") == (l.License.Name == "") {
+				t.Errorf("license of %s must have either an id or a name, got %+v", c.BOMRef, l.License)
+			}
+		}
+	}
+	if len(components) != 8 {
+		t.Errorf("expected 8 components, got %d", len(components))
+	}
+
+	graph := map[string][]string{}
+	for _, d := range dependencies {
+		if _, ok := refs[d.Ref]; !ok {
+			t.Errorf("dependency refers to unknown component %s", d.Ref)
+		}
+		for _, ref := range d.DependsOn {
+			if _, ok := refs[ref]; !ok {
+				t.Errorf("dependency refers to unknown component %s", ref)
+			}
+		}
+		graph[d.Ref] = d.DependsOn
+	}
+	if deps := graph["manifest:Gopkg.lock"]; len(deps) != 1 || deps[0] != "dep:github.com/pkg/errors@v0.8.0" {
+		t.Errorf("expected Gopkg.lock to depend directly on github.com/pkg/errors only, got %v", deps)
+	}
+	if deps := graph["manifest:package.json"]; len(deps) != 3 {
+		t.Errorf("expected package.json to depend on its three dependencies, got %v", deps)
+	}
+
+	d3 := refs["npm:@types/d3@5.0.0"]
+	if d3.PURL != "pkg:npm/%40types/d3@5.0.0" || len(d3.Licenses) != 1 || d3.Licenses[0].License == nil || d3.Licenses[0].License.ID != "MIT" {
+		t.Errorf("unexpected component %+v", d3)
+	}
+	errs := refs["dep:github.com/pkg/errors@v0.8.0"]
+	if errs.PURL != "pkg:golang/github.com/pkg/errors@v0.8.0" || len(errs.Licenses) != 1 || errs.Licenses[0].Expression != "BSD-2-Clause OR MIT" {
+		t.Errorf("unexpected component %+v", errs)
+	}
+	readline := refs["npm:readline@1.3.0"]
+	exception := map[string]string{}
+	for _, p := range readline.Properties {
+		exception[p.Name] = p.Value
+	}
+	if exception["diligent:exception:ticket"] != "LEGAL-42" || exception["diligent:exception:expires"] != "2018-06-30" {
+		t.Errorf("expected the exception to be recorded, got %+v", readline.Properties)
+	}
+	if leftPad := refs["npm:left-pad"]; leftPad.PURL != "pkg:npm/left-pad" || len(leftPad.Licenses) != 0 {
+		t.Errorf("unexpected component %+v", leftPad)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := cyclonedx.NewJSONReporter().Report(&buf, testReport(t)); err != nil {
+		t.Fatalf("did not expect an error, got %v", err)
+	}
+	var doc struct {
+		BOMFormat    string `json:"bomFormat"`
+		SpecVersion  string `json:"specVersion"`
+		SerialNumber string `json:"serialNumber"`
+		Version      int    `json:"version"`
+		Metadata     struct {
+			Timestamp string `json:"timestamp"`
+			Tools     struct {
+				Components []component `json:"components"`
+			} `json:"tools"`
+		} `json:"metadata"`
+		Components   []component  `json:"components"`
+		Dependencies []dependency `json:"dependencies"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("expected valid JSON, got %v", err)
+	}
+	if doc.BOMFormat != "CycloneDX" || doc.SpecVersion != "1.5" || doc.Version != 1 {
+		t.Errorf("unexpected document %+v", doc)
+	}
+	if doc.Metadata.Timestamp != "2018-05-01T12:00:00Z" {
+		t.Errorf("unexpected timestamp %s", doc.Metadata.Timestamp)
+	}
+	if tools := doc.Metadata.Tools.Components; len(tools) != 1 || tools[0].Name != "diligent" || tools[0].Version != "1.2.3" {
+		t.Errorf("unexpected tools %+v", tools)
+	}
+	checkBOM(t, doc.SerialNumber, doc.Components, doc.Dependencies)
+}
+
+func TestXML(t *testing.T) {
+	var buf bytes.Buffer
+	if err := cyclonedx.NewXMLReporter().Report(&buf, testReport(t)); err != nil {
+		t.Fatalf("did not expect an error, got %v", err)
+	}
+	var doc struct {
+		XMLName      xml.Name
+		SerialNumber string `xml:"serialNumber,attr"`
+		Version      int    `xml:"version,attr"`
+		Timestamp    string `xml:"metadata>timestamp"`
+		Components   []struct {
+			component
+			Licenses struct {
+				Licenses []struct {
+					ID   string `xml:"id"`
+					Name string `xml:"name"`
+				} `xml:"license"`
+				Expression string `xml:"expression"`
+			} `xml:"licenses"`
+		} `xml:"components>component"`
+		Dependencies []struct {
+			Ref       string `xml:"ref,attr"`
+			DependsOn []struct {
+				Ref string `xml:"ref,attr"`
+			} `xml:"dependency"`
+		} `xml:"dependencies>dependency"`
+	}
+	if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("expected valid XML, got %v", err)
+	}
+	if doc.XMLName.Space != "http://cyclonedx.org/schema/bom/1.5" || doc.XMLName.Local != "bom" || doc.Version != 1 {
+		t.Errorf("unexpected document %+v", doc.XMLName)
+	}
+	if doc.Timestamp != "2018-05-01T12:00:00Z" {
+		t.Errorf("unexpected timestamp %s", doc.Timestamp)
+	}
+
+	components := make([]component, len(doc.Components))
+	for i, c := range doc.Components {
+		components[i] = c.component
+		for _, l := range c.Licenses.Licenses {
+			lc := licenseChoice{}
+			lc.License = &struct {
+				ID   string `json:"id" xml:"id"`
+				Name string `json:"name" xml:"name"`
+			}{l.ID, l.Name}
+			components[i].Licenses = append(components[i].Licenses, lc)
+		}
+		if c.Licenses.Expression != "" {
+			components[i].Licenses = append(components[i].Licenses, licenseChoice{Expression: c.Licenses.Expression})
+		}
+	}
+	dependencies := make([]dependency, len(doc.Dependencies))
+	for i, d := range doc.Dependencies {
+		dependencies[i].Ref = d.Ref
+		for _, on := range d.DependsOn {
+			dependencies[i].DependsOn = append(dependencies[i].DependsOn, on.Ref)
+		}
+	}
+	checkBOM(t, doc.SerialNumber, components, dependencies)
+}
+
+func TestLicenses(t *testing.T) {
+	if !diligent.IsUserDefined("Acme-Proprietary") {
+		if err := diligent.RegisterLicense(diligent.License{Identifier: "Acme-Proprietary", Name: "Acme Proprietary License"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cases := []struct {
+		d          string
+		expression string
+		id         string
+		name       string
+		exp        string
+	}{
+		{"license on the SPDX license list", "D-FSL-1.0", "D-FSL-1.0", "", ""},
+		{"license only known to diligent", "WXwindows", "", "wxWindows Library Licence 3.1", ""},
+		{"user defined license", "Acme-Proprietary", "", "Acme Proprietary License", ""},
+		{"expression", "MIT OR D-FSL-1.0-DE", "", "", "MIT OR LicenseRef-D-FSL-1.0-DE"},
+		{"expression with a user defined license", "Apache-2.0 AND Acme-Proprietary", "", "", "Apache-2.0 AND LicenseRef-Acme-Proprietary"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.d, func(t *testing.T) {
+			d, err := diligent.NewDep("dep", tt.expression)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d.Ecosystem = "npm"
+			var buf bytes.Buffer
+			if err := cyclonedx.NewJSONReporter().Report(&buf, diligent.Report{Deps: []diligent.Dep{d}}); err != nil {
+				t.Fatal(err)
+			}
+			var doc struct {
+				Components []component `json:"components"`
+			}
+			if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+				t.Fatal(err)
+			}
+			if len(doc.Components) != 1 || len(doc.Components[0].Licenses) != 1 {
+				t.Fatalf("expected a single license, got %+v", doc.Components)
+			}
+			l := doc.Components[0].Licenses[0]
+			if l.Expression != tt.exp {
+				t.Errorf("expected the expression %q, got %q", tt.exp, l.Expression)
+			}
+			if tt.exp == "" && (l.License == nil || l.License.ID != tt.id || l.License.Name != tt.name) {
+				t.Errorf("expected the license id %q and name %q, got %+v", tt.id, tt.name, l.License)
+			}
+		})
+	}
+}
+
+func TestSerialNumbersAreUnique(t *testing.T) {
+	var first, second bytes.Buffer
+	reporter := cyclonedx.NewJSONReporter()
+	if err := reporter.Report(&first, testReport(t)); err != nil {
+		t.Fatal(err)
+	}
+	if err := reporter.Report(&second, testReport(t)); err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(first.Bytes(), second.Bytes()) {
+		t.Error("expected each BOM to have a unique serial number")
+	}
+}
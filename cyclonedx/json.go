@@ -0,0 +1,114 @@
+package cyclonedx
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/senseyeio/diligent"
+)
+
+type jsonBOM struct {
+	BOMFormat    string           `json:"bomFormat"`
+	SpecVersion  string           `json:"specVersion"`
+	SerialNumber string           `json:"serialNumber"`
+	Version      int              `json:"version"`
+	Metadata     jsonMetadata     `json:"metadata"`
+	Components   []jsonComponent  `json:"components"`
+	Dependencies []jsonDependency `json:"dependencies"`
+}
+
+type jsonMetadata struct {
+	Timestamp string    `json:"timestamp"`
+	Tools     jsonTools `json:"tools"`
+}
+
+type jsonTools struct {
+	Components []jsonComponent `json:"components"`
+}
+
+type jsonComponent struct {
+	Type       string              `json:"type"`
+	BOMRef     string              `json:"bom-ref,omitempty"`
+	Name       string              `json:"name"`
+	Version    string              `json:"version,omitempty"`
+	Licenses   []jsonLicenseChoice `json:"licenses,omitempty"`
+	PURL       string              `json:"purl,omitempty"`
+	Properties []jsonProperty      `json:"properties,omitempty"`
+}
+
+type jsonLicenseChoice struct {
+	License    *jsonLicense `json:"license,omitempty"`
+	Expression string       `json:"expression,omitempty"`
+}
+
+type jsonLicense struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
+type jsonProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type jsonDependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn"`
+}
+
+type jsonReporter struct{}
+
+// NewJSONReporter returns a Reporter which outputs a CycloneDX BOM in the JSON format
+func NewJSONReporter() diligent.Reporter {
+	return &jsonReporter{}
+}
+
+// Report outputs the report as a CycloneDX BOM in the JSON format
+func (j *jsonReporter) Report(w io.Writer, r diligent.Report) error {
+	b, err := newBOM(r)
+	if err != nil {
+		return err
+	}
+	out := jsonBOM{
+		BOMFormat:    bomFormat,
+		SpecVersion:  SpecVersion,
+		SerialNumber: b.serialNumber,
+		Version:      1,
+		Metadata: jsonMetadata{
+			Timestamp: b.timestamp.Format(time.RFC3339),
+			Tools: jsonTools{
+				Components: []jsonComponent{{Type: "application", Name: "diligent", Version: b.toolVersion}},
+			},
+		},
+		Components:   make([]jsonComponent, len(b.components)),
+		Dependencies: make([]jsonDependency, len(b.dependencies)),
+	}
+	for i, c := range b.components {
+		jc := jsonComponent{
+			Type:    c.kind,
+			BOMRef:  c.ref,
+			Name:    c.name,
+			Version: c.version,
+			PURL:    c.purl,
+		}
+		if c.expression != "" {
+			jc.Licenses = []jsonLicenseChoice{{Expression: c.expression}}
+		}
+		for _, l := range c.licenses {
+			jc.Licenses = append(jc.Licenses, jsonLicenseChoice{License: &jsonLicense{ID: l.id, Name: l.name, URL: l.url}})
+		}
+		for _, p := range c.properties {
+			jc.Properties = append(jc.Properties, jsonProperty{p.name, p.value})
+		}
+		out.Components[i] = jc
+	}
+	for i, d := range b.dependencies {
+		out.Dependencies[i] = jsonDependency{d.ref, d.dependsOn}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
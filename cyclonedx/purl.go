@@ -0,0 +1,40 @@
+package cyclonedx
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// exactVersionRegexp matches npm versions, as opposed to version ranges such as ^5.0.0
+var exactVersionRegexp = regexp.MustCompile(`^v?\d+\.\d+\.\d+(-[0-9A-Za-z.-]+)?(\+[0-9A-Za-z.-]+)?$`)
+
+// purlTypes maps the name of each Deper to the package URL type of its dependencies
+var purlTypes = map[string]string{
+	"npm":      "npm",
+	"dep":      "golang",
+	"govendor": "golang",
+}
+
+// packageURL returns the package URL of a dependency, or an empty string if the ecosystem has no package URL type.
+// Version ranges are not versions, so are omitted.
+func packageURL(ecosystem, name, version string) string {
+	purlType, ok := purlTypes[ecosystem]
+	if !ok || name == "" {
+		return ""
+	}
+	segments := strings.Split(name, "/")
+	for i, s := range segments {
+		segments[i] = escape(s)
+	}
+	purl := "pkg:" + purlType + "/" + strings.Join(segments, "/")
+	if version != "" && (purlType != "npm" || exactVersionRegexp.MatchString(version)) {
+		purl += "@" + escape(version)
+	}
+	return purl
+}
+
+// escape percent-encodes a package URL component
+func escape(s string) string {
+	return strings.Replace(url.PathEscape(s), "@", "%40", -1)
+}
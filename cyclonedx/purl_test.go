@@ -0,0 +1,29 @@
+package cyclonedx
+
+import "testing"
+
+func TestPackageURL(t *testing.T) {
+	cases := []struct {
+		d         string
+		ecosystem string
+		name      string
+		version   string
+		out       string
+	}{
+		{"npm", "npm", "d3", "5.0.0", "pkg:npm/d3@5.0.0"},
+		{"npm scoped package", "npm", "@types/d3", "5.0.0", "pkg:npm/%40types/d3@5.0.0"},
+		{"npm version range", "npm", "d3", "^5.0.0", "pkg:npm/d3"},
+		{"dep", "dep", "github.com/pkg/errors", "v0.8.0", "pkg:golang/github.com/pkg/errors@v0.8.0"},
+		{"govendor revision", "govendor", "gopkg.in/yaml.v2", "5420a8b6744d3b0345ab293f6fcba19c978f1183", "pkg:golang/gopkg.in/yaml.v2@5420a8b6744d3b0345ab293f6fcba19c978f1183"},
+		{"no version", "dep", "github.com/pkg/errors", "", "pkg:golang/github.com/pkg/errors"},
+		{"unknown ecosystem", "maven", "junit", "4.12", ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			if out := packageURL(c.ecosystem, c.name, c.version); out != c.out {
+				t.Errorf("expected %s, got %s", c.out, out)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package cyclonedx_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/senseyeio/diligent/cyclonedx"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// schemaDir holds the official CycloneDX 1.5 schemas, along with the SPDX and JSF schemas they reference. They are
+// committed to the repository and updated using `make cyclonedx-schemas`.
+const schemaDir = "testdata/schema"
+
+// referencedSchemas maps the identifiers of the schemas referenced by bom-1.5.schema.json to their files, so they are
+// not fetched whilst testing
+var referencedSchemas = map[string]string{
+	"http://cyclonedx.org/schema/spdx.schema.json":     "spdx.schema.json",
+	"http://cyclonedx.org/schema/jsf-0.82.schema.json": "jsf-0.82.schema.json",
+}
+
+func readSchemas(t *testing.T, files ...string) map[string][]byte {
+	out := map[string][]byte{}
+	for _, f := range files {
+		b, err := ioutil.ReadFile(filepath.Join(schemaDir, f))
+		if err != nil {
+			t.Fatalf("unable to read the official schema %s, run make cyclonedx-schemas and commit it: %v", f, err)
+		}
+		out[f] = b
+	}
+	return out
+}
+
+func TestJSONSchema(t *testing.T) {
+	schemas := readSchemas(t, "bom-1.5.schema.json", "spdx.schema.json", "jsf-0.82.schema.json")
+	sl := gojsonschema.NewSchemaLoader()
+	for id, f := range referencedSchemas {
+		if err := sl.AddSchema(id, gojsonschema.NewBytesLoader(schemas[f])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	schema, err := sl.Compile(gojsonschema.NewBytesLoader(schemas["bom-1.5.schema.json"]))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := cyclonedx.NewJSONReporter().Report(&buf, testReport(t)); err != nil {
+		t.Fatalf("did not expect an error, got %v", err)
+	}
+	result, err := schema.Validate(gojsonschema.NewBytesLoader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range result.Errors() {
+		t.Errorf("invalid BOM: %s", e)
+	}
+}
+
+func TestXMLSchema(t *testing.T) {
+	readSchemas(t, "bom-1.5.xsd", "spdx.xsd")
+	xmllint, err := exec.LookPath("xmllint")
+	if err != nil {
+		t.Skip("xmllint is required to validate the BOM against the XML schema")
+	}
+
+	f, err := ioutil.TempFile("", "bom*.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	err = cyclonedx.NewXMLReporter().Report(f, testReport(t))
+	f.Close()
+	if err != nil {
+		t.Fatalf("did not expect an error, got %v", err)
+	}
+
+	catalog, err := filepath.Abs(filepath.Join(schemaDir, "catalog.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(xmllint, "--noout", "--nonet", "--schema", filepath.Join(schemaDir, "bom-1.5.xsd"), f.Name())
+	cmd.Env = append(os.Environ(), "XML_CATALOG_FILES="+catalog)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Errorf("invalid BOM: %v\n%s", err, out)
+	}
+}
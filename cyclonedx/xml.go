@@ -0,0 +1,128 @@
+package cyclonedx
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+
+	"github.com/senseyeio/diligent"
+)
+
+type xmlBOM struct {
+	XMLName      xml.Name        `xml:"bom"`
+	Namespace    string          `xml:"xmlns,attr"`
+	SerialNumber string          `xml:"serialNumber,attr"`
+	Version      int             `xml:"version,attr"`
+	Metadata     xmlMetadata     `xml:"metadata"`
+	Components   []xmlComponent  `xml:"components>component"`
+	Dependencies []xmlDependency `xml:"dependencies>dependency"`
+}
+
+type xmlMetadata struct {
+	Timestamp string         `xml:"timestamp"`
+	Tools     []xmlComponent `xml:"tools>components>component"`
+}
+
+// xmlComponent fields are ordered as required by the CycloneDX XML schema
+type xmlComponent struct {
+	Type       string         `xml:"type,attr"`
+	BOMRef     string         `xml:"bom-ref,attr,omitempty"`
+	Name       string         `xml:"name"`
+	Version    string         `xml:"version,omitempty"`
+	Licenses   *xmlLicenses   `xml:"licenses,omitempty"`
+	PURL       string         `xml:"purl,omitempty"`
+	Properties *xmlProperties `xml:"properties,omitempty"`
+}
+
+type xmlProperties struct {
+	Properties []xmlProperty `xml:"property"`
+}
+
+type xmlLicenses struct {
+	Licenses   []xmlLicense `xml:"license,omitempty"`
+	Expression string       `xml:"expression,omitempty"`
+}
+
+type xmlLicense struct {
+	ID   string `xml:"id,omitempty"`
+	Name string `xml:"name,omitempty"`
+	URL  string `xml:"url,omitempty"`
+}
+
+type xmlProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:",chardata"`
+}
+
+type xmlDependency struct {
+	Ref       string          `xml:"ref,attr"`
+	DependsOn []xmlDependency `xml:"dependency,omitempty"`
+}
+
+type xmlReporter struct{}
+
+// NewXMLReporter returns a Reporter which outputs a CycloneDX BOM in the XML format
+func NewXMLReporter() diligent.Reporter {
+	return &xmlReporter{}
+}
+
+// Report outputs the report as a CycloneDX BOM in the XML format
+func (x *xmlReporter) Report(w io.Writer, r diligent.Report) error {
+	b, err := newBOM(r)
+	if err != nil {
+		return err
+	}
+	out := xmlBOM{
+		Namespace:    namespace,
+		SerialNumber: b.serialNumber,
+		Version:      1,
+		Metadata: xmlMetadata{
+			Timestamp: b.timestamp.Format(time.RFC3339),
+			Tools:     []xmlComponent{{Type: "application", Name: "diligent", Version: b.toolVersion}},
+		},
+		Components:   make([]xmlComponent, len(b.components)),
+		Dependencies: make([]xmlDependency, len(b.dependencies)),
+	}
+	for i, c := range b.components {
+		xc := xmlComponent{
+			Type:    c.kind,
+			BOMRef:  c.ref,
+			Name:    c.name,
+			Version: c.version,
+			PURL:    c.purl,
+		}
+		if c.expression != "" {
+			xc.Licenses = &xmlLicenses{Expression: c.expression}
+		} else if len(c.licenses) > 0 {
+			xc.Licenses = &xmlLicenses{}
+			for _, l := range c.licenses {
+				xc.Licenses.Licenses = append(xc.Licenses.Licenses, xmlLicense{ID: l.id, Name: l.name, URL: l.url})
+			}
+		}
+		if len(c.properties) > 0 {
+			xc.Properties = &xmlProperties{}
+			for _, p := range c.properties {
+				xc.Properties.Properties = append(xc.Properties.Properties, xmlProperty{p.name, p.value})
+			}
+		}
+		out.Components[i] = xc
+	}
+	for i, d := range b.dependencies {
+		xd := xmlDependency{Ref: d.ref}
+		for _, ref := range d.dependsOn {
+			xd.DependsOn = append(xd.DependsOn, xmlDependency{Ref: ref})
+		}
+		out.Dependencies[i] = xd
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(out); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,150 @@
+package diligent
+
+import (
+	"fmt"
+
+	"github.com/pelletier/go-toml"
+)
+
+var (
+	customLicenses    = map[string]License{}
+	categoryOverrides = map[string]Category{}
+)
+
+// LicenseDefinition describes a license which is not known by diligent, for example an internal proprietary license
+type LicenseDefinition struct {
+	Identifier string    `toml:"identifier"`
+	Name       string    `toml:"name"`
+	ShortName  string    `toml:"short-name"`
+	Category   Category  `toml:"category"`
+	Type       Type      `toml:"type"`
+	Owner      string    `toml:"owner"`
+	OwnerURL   string    `toml:"owner-url"`
+	OwnerType  OwnerType `toml:"owner-type"`
+	URL        string    `toml:"url"`
+	// Obligations lists what the license requires of those using or distributing the software, such as
+	// include-copyright or disclose-source
+	Obligations []Obligation `toml:"obligations"`
+}
+
+// LicenseDefinitions contains user defined licenses along with category overrides, keyed by license identifier, which
+// replace the categories diligent assigns to known licenses
+type LicenseDefinitions struct {
+	Licenses   []LicenseDefinition `toml:"licenses"`
+	Categories map[string]Category `toml:"categories"`
+}
+
+// ParseLicenseDefinitions parses a TOML file containing license definitions. For example:
+//
+//	[[licenses]]
+//	identifier = "LicenseRef-Acme-Internal"
+//	name = "Acme Internal Use"
+//	category = "proprietary-free"
+//	type = "proprietary"
+//
+//	[categories]
+//	"JSON" = "permissive"
+func ParseLicenseDefinitions(file []byte) (LicenseDefinitions, error) {
+	var defs LicenseDefinitions
+	if err := toml.Unmarshal(file, &defs); err != nil {
+		return LicenseDefinitions{}, err
+	}
+	return defs, nil
+}
+
+// Register makes the definitions available to the functions within this package, such as GetLicenseFromIdentifier.
+// Licenses are registered before category overrides are applied, so overrides may refer to user defined licenses.
+func (d LicenseDefinitions) Register() error {
+	for _, def := range d.Licenses {
+		l := License{
+			Identifier:  def.Identifier,
+			Name:        def.Name,
+			ShortName:   def.ShortName,
+			Category:    def.Category,
+			Type:        def.Type,
+			Owner:       def.Owner,
+			OwnerURL:    def.OwnerURL,
+			OwnerType:   def.OwnerType,
+			URL:         def.URL,
+			Obligations: def.Obligations,
+		}
+		if err := RegisterLicense(l); err != nil {
+			return err
+		}
+	}
+	for identifier, cat := range d.Categories {
+		if err := OverrideCategory(identifier, cat); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isValidCategory(cat Category) bool {
+	c := getCategoryFromString(string(cat))
+	return c != nil && *c != All
+}
+
+// RegisterLicense adds a license to those known by diligent. The identifier must not clash with a known license.
+func RegisterLicense(l License) error {
+	if l.Identifier == "" {
+		return fmt.Errorf("license '%s' must have an identifier", l.Name)
+	}
+	if l.Name == "" {
+		return fmt.Errorf("license '%s' must have a name", l.Identifier)
+	}
+	if _, ok := findLicense(l.Identifier); ok {
+		return fmt.Errorf("license identifier %s is already known to diligent", l.Identifier)
+	}
+	if l.Category != "" && !isValidCategory(l.Category) {
+		return fmt.Errorf("license '%s' has an unknown category '%s'", l.Identifier, l.Category)
+	}
+	if l.Type != "" && l.Type != OpenSource && l.Type != Proprietary {
+		return fmt.Errorf("license '%s' has an unknown type '%s'", l.Identifier, l.Type)
+	}
+	for _, o := range l.Obligations {
+		if obligationIndex(o) == len(Obligations) {
+			return fmt.Errorf("license '%s' has an unknown obligation '%s'", l.Identifier, o)
+		}
+	}
+	if l.ShortName == "" {
+		l.ShortName = l.Name
+	}
+	l.IsSPDXListed = false
+	customLicenses[l.Identifier] = l
+	return nil
+}
+
+// IsUserDefined returns true if the license identifier was registered using RegisterLicense rather than being one of
+// the licenses known to diligent
+func IsUserDefined(identifier string) bool {
+	_, ok := customLicenses[identifier]
+	return ok
+}
+
+// OverrideCategory changes the category of a known license
+func OverrideCategory(identifier string, cat Category) error {
+	if _, ok := findLicense(identifier); !ok {
+		return fmt.Errorf("license identifier %s is not known to diligent", identifier)
+	}
+	if !isValidCategory(cat) {
+		return fmt.Errorf("cannot assign unknown category '%s' to license '%s'", cat, identifier)
+	}
+	categoryOverrides[identifier] = cat
+	return nil
+}
+
+// findLicense looks up a license by identifier, taking user defined licenses and category overrides into account
+func findLicense(identifier string) (License, bool) {
+	l, ok := customLicenses[identifier]
+	if !ok {
+		l, ok = lookup[identifier]
+	}
+	if !ok {
+		return License{}, false
+	}
+	if cat, ok := categoryOverrides[identifier]; ok {
+		l.Category = cat
+	}
+	return l, true
+}
@@ -0,0 +1,113 @@
+package diligent
+
+import "testing"
+
+func resetDefinitions() {
+	customLicenses = map[string]License{}
+	categoryOverrides = map[string]Category{}
+}
+
+func TestParseLicenseDefinitions(t *testing.T) {
+	defer resetDefinitions()
+	defs, err := ParseLicenseDefinitions([]byte(`
+[[licenses]]
+identifier = "LicenseRef-Acme-Internal"
+name = "Acme Internal Use"
+category = "proprietary-free"
+type = "proprietary"
+owner = "Acme"
+owner-type = "organization"
+
+[categories]
+"JSON" = "permissive"
+"LicenseRef-Acme-Internal" = "free-restricted"
+`))
+	if err != nil {
+		t.Fatalf("did not expect an error, got %v", err)
+	}
+	if err := defs.Register(); err != nil {
+		t.Fatalf("did not expect an error, got %v", err)
+	}
+
+	l, err := GetLicenseFromIdentifier("LicenseRef-Acme-Internal")
+	if err != nil {
+		t.Errorf("did not expect an error, got %v", err)
+	}
+	if l.Name != "Acme Internal Use" || l.ShortName != "Acme Internal Use" || l.Type != Proprietary || l.Owner != "Acme" {
+		t.Errorf("unexpected license %+v", l)
+	}
+	if l.Category != FreeRestricted {
+		t.Errorf("expected category override to apply to custom license, got %s", l.Category)
+	}
+
+	l, _ = GetLicenseFromIdentifier("JSON")
+	if l.Category != Permissive {
+		t.Errorf("expected JSON to be permissive, got %s", l.Category)
+	}
+
+	found := map[string]bool{}
+	for _, id := range ReplaceCategoriesWithIdentifiers([]string{"permissive", "free-restricted"}) {
+		found[id] = true
+	}
+	if !found["JSON"] || !found["LicenseRef-Acme-Internal"] {
+		t.Error("expected categories to include custom and overridden licenses")
+	}
+}
+
+func TestRegisterLicense(t *testing.T) {
+	cases := []struct {
+		d          string
+		in         License
+		expFailure bool
+	}{
+		{"valid license", License{Identifier: "LicenseRef-Test", Name: "Test"}, false},
+		{"missing identifier", License{Name: "Test"}, true},
+		{"missing name", License{Identifier: "LicenseRef-Test"}, true},
+		{"clashes with known license", License{Identifier: "MIT", Name: "Test"}, true},
+		{"unknown category", License{Identifier: "LicenseRef-Test", Name: "Test", Category: "woowoo"}, true},
+		{"category all", License{Identifier: "LicenseRef-Test", Name: "Test", Category: All}, true},
+		{"unknown type", License{Identifier: "LicenseRef-Test", Name: "Test", Type: "woowoo"}, true},
+		{"unknown obligation", License{Identifier: "LicenseRef-Test", Name: "Test", Obligations: []Obligation{"woowoo"}}, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			defer resetDefinitions()
+			err := RegisterLicense(c.in)
+			if (err != nil) != c.expFailure {
+				t.Errorf("expecting error: %t, got %v", c.expFailure, err)
+			}
+		})
+	}
+}
+
+func TestIsUserDefined(t *testing.T) {
+	defer resetDefinitions()
+	if err := RegisterLicense(License{Identifier: "LicenseRef-Test", Name: "Test"}); err != nil {
+		t.Fatal(err)
+	}
+	if !IsUserDefined("LicenseRef-Test") {
+		t.Error("expected LicenseRef-Test to be user defined")
+	}
+	if IsUserDefined("MIT") {
+		t.Error("did not expect MIT to be user defined")
+	}
+}
+
+func TestOverrideCategory(t *testing.T) {
+	defer resetDefinitions()
+	if err := OverrideCategory("woowoo", Permissive); err == nil {
+		t.Error("expected an error for an unknown license")
+	}
+	if err := OverrideCategory("MIT", "woowoo"); err == nil {
+		t.Error("expected an error for an unknown category")
+	}
+	if err := OverrideCategory("MIT", CopyLeft); err != nil {
+		t.Errorf("did not expect an error, got %v", err)
+	}
+	for _, l := range GetCategoryLicenses(Permissive) {
+		if l.Identifier == "MIT" {
+			t.Error("did not expect MIT to be permissive")
+		}
+	}
+}
@@ -1,17 +1,133 @@
 package diligent
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
+
+// Relationship describes how a dependency is related to the project which uses it
+type Relationship string
+
+const (
+	// Direct dependencies are used by the project itself
+	Direct Relationship = "direct"
+	// Transitive dependencies are used by other dependencies of the project
+	Transitive Relationship = "transitive"
+)
+
+// Scope describes when a dependency is needed by the project which uses it
+type Scope string
+
+const (
+	// Runtime dependencies are needed whenever the project is used
+	Runtime Scope = "runtime"
+	// Development dependencies are only needed to develop, build or test the project
+	Development Scope = "development"
+)
 
 // Dep contains a dependency identified by name along with its License information
 type Dep struct {
 	Name    string
 	License License
+	// Expression is an SPDX license expression, such as "MIT OR Apache-2.0", describing a dependency covered by more
+	// than one license. License then holds the first license within the expression. Expression is empty when License
+	// alone applies.
+	Expression string
+	// Version of the dependency, as specified by the manifest. This may be a version range, a branch or a revision
+	// depending on the ecosystem.
+	Version string
+	// Ecosystem is the name of the Deper which found the dependency
+	Ecosystem string
+	// Manifest is the path of the manifest file which declared the dependency
+	Manifest string
+	// Relationship is empty if it is not known whether the dependency is direct or transitive
+	Relationship Relationship
+	// Scope is empty if it is not known when the dependency is needed, in which case it should be treated as a
+	// runtime dependency
+	Scope Scope
+	// Provenance records how the dependency's licenses were determined. There may be more than one record when the
+	// licenses were found in more than one place, for example several license files.
+	Provenance []Provenance
+	// DistributionObligations describes, for each license of the dependency, the obligation it triggers under the
+	// distribution model of the report. It is empty when no distribution model was provided.
+	DistributionObligations []LicenseObligation
+}
+
+// LicenseObligation describes what a license of a dependency requires of the software using it
+type LicenseObligation struct {
+	// License is the identifier of the license
+	License string
+	// Description of the obligation the license triggers
+	Description string
+}
+
+// NewDep returns a Dep given the name of the dependency and an SPDX license expression, which may be a single license
+// identifier. Every license referenced by the expression must be known.
+func NewDep(name, expression string) (Dep, error) {
+	e, err := ParseExpression(expression)
+	if err != nil {
+		return Dep{}, err
+	}
+	ll := make([]License, 0)
+	for _, identifier := range e.Identifiers() {
+		l, err := GetLicenseFromIdentifier(identifier)
+		if err != nil {
+			return Dep{}, err
+		}
+		ll = append(ll, l)
+	}
+	d := Dep{
+		Name:    name,
+		License: ll[0],
+	}
+	if e.Operator != "" || e.Exception != "" || strings.HasSuffix(e.Identifier, "+") {
+		d.Expression = e.String()
+	}
+	return d, nil
+}
+
+// LicenseExpression returns an SPDX license expression describing the licenses of the dependency
+func (d Dep) LicenseExpression() string {
+	if d.Expression != "" {
+		return d.Expression
+	}
+	return d.License.Identifier
+}
+
+// Licenses returns each of the licenses referenced by the dependency's license expression
+func (d Dep) Licenses() []License {
+	if d.Expression == "" {
+		return []License{d.License}
+	}
+	e, err := ParseExpression(d.Expression)
+	if err != nil {
+		return []License{d.License}
+	}
+	ll := make([]License, 0)
+	for _, identifier := range e.Identifiers() {
+		if l, err := GetLicenseFromIdentifier(identifier); err == nil {
+			ll = append(ll, l)
+		}
+	}
+	return ll
 }
 
 // Warning represents an error whilst processing a dependency
 // Warnings are not fatal, like an error, but does mean the license associated with a dependency was not found
 type Warning interface {
+	// Warning returns a human readable description of the warning
 	Warning() string
+	// Dependency returns the name of the dependency which could not be processed
+	Dependency() string
+	// Version returns the version of the dependency, as specified by the manifest, or an empty string if it is not
+	// known
+	Version() string
+	// Ecosystem returns the name of the Deper which processed the dependency
+	Ecosystem() string
+	// Reason returns a machine readable code describing why the license could not be determined
+	Reason() Reason
+	// Err returns the underlying error
+	Err() error
 }
 
 // Deper is the interface for extracting licenses from manifest files.
@@ -27,11 +143,24 @@ type Deper interface {
 	IsCompatible(filename string) bool
 }
 
+// ManifestDeper is implemented by Depers which also read files found alongside the manifest file, such as the vendor
+// directory of a Go project
+type ManifestDeper interface {
+	Deper
+	// ManifestDependencies is identical to Dependencies, path being the location of the manifest file
+	ManifestDependencies(path string, file []byte) ([]Dep, []Warning, error)
+}
+
 type DepsByName []Dep
 
-func (d DepsByName) Len() int           { return len(d) }
-func (d DepsByName) Swap(i, j int)      { d[i], d[j] = d[j], d[i] }
-func (d DepsByName) Less(i, j int) bool { return d[i].Name < d[j].Name }
+func (d DepsByName) Len() int      { return len(d) }
+func (d DepsByName) Swap(i, j int) { d[i], d[j] = d[j], d[i] }
+func (d DepsByName) Less(i, j int) bool {
+	if d[i].Name == d[j].Name {
+		return d[i].Version < d[j].Version
+	}
+	return d[i].Name < d[j].Name
+}
 
 type Warnings []Warning
 
@@ -53,12 +182,13 @@ func (d DepsByLicense) Less(i, j int) bool {
 
 type Deps []Dep
 
-// Dedupe removes duplicate dependencies in place
+// Dedupe removes duplicate dependencies in place. Dependencies are duplicates if they share a name, version and
+// licenses, even if they were declared by different manifests.
 func (dd Deps) Dedupe() Deps {
 	out := make([]Dep, 0, len(dd))
 	found := map[string]bool{}
 	for _, d := range dd {
-		key := fmt.Sprintf("%s-%s", d.Name, d.License.Identifier)
+		key := fmt.Sprintf("%s-%s-%s", d.Name, d.Version, d.LicenseExpression())
 		if _, ok := found[key]; !ok {
 			out = append(out, d)
 			found[key] = true
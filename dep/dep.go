@@ -1,25 +1,61 @@
 package dep
 
 import (
+	"path/filepath"
+	"strings"
+
 	"github.com/pelletier/go-toml"
 	"github.com/senseyeio/diligent"
 	"github.com/senseyeio/diligent/warning"
 )
 
 type lockedProject struct {
-	Name string `toml:"name"`
+	Name     string `toml:"name"`
+	Version  string `toml:"version"`
+	Revision string `toml:"revision"`
+}
+
+// version returns the tagged version of the project, or its revision if it is not locked to a tag
+func (p lockedProject) version() string {
+	if p.Version != "" {
+		return p.Version
+	}
+	return p.Revision
+}
+
+type solveMeta struct {
+	InputImports []string `toml:"input-imports"`
 }
 
 type lock struct {
-	Projects []lockedProject `toml:"projects"`
+	Projects  []lockedProject `toml:"projects"`
+	SolveMeta solveMeta       `toml:"solve-meta"`
+}
+
+// relationship uses the packages imported by the project, recorded in the solve-meta section by recent versions of
+// dep, to determine whether a project is a direct dependency. Older lock files do not record imports, in which case
+// the relationship is unknown.
+func (l lock) relationship(name string) diligent.Relationship {
+	if len(l.SolveMeta.InputImports) == 0 {
+		return ""
+	}
+	for _, i := range l.SolveMeta.InputImports {
+		if i == name || strings.HasPrefix(i, name+"/") {
+			return diligent.Direct
+		}
+	}
+	return diligent.Transitive
 }
 
 type dep struct {
 	lg GoLicenseGetter
 }
 
+// GoLicenseGetter retrieves the licenses associated with go packages
 type GoLicenseGetter interface {
-	GetLicense(packagePath string) (diligent.License, error)
+	GetDep(packagePath string) (diligent.Dep, error)
+	// GetVendoredDep is identical to GetDep but first looks for the source of the package within vendorDir
+	GetVendoredDep(packagePath, vendorDir string) (diligent.Dep, error)
 }
 
 // New returns a Deper capable of handling dep manifest files
@@ -34,6 +70,19 @@ func (d *dep) Name() string {
 
 // Dependencies returns the licenses of the go packages defined within the dep manifest
 func (d *dep) Dependencies(file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	return d.dependencies(file, d.lg.GetDep)
+}
+
+// ManifestDependencies is identical to Dependencies, but identifies the licenses of packages found within the vendor
+// directory alongside the manifest from their source
+func (d *dep) ManifestDependencies(path string, file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	vendorDir := filepath.Join(filepath.Dir(path), "vendor")
+	return d.dependencies(file, func(packagePath string) (diligent.Dep, error) {
+		return d.lg.GetVendoredDep(packagePath, vendorDir)
+	})
+}
+
+func (d *dep) dependencies(file []byte, getDep func(packagePath string) (diligent.Dep, error)) ([]diligent.Dep, []diligent.Warning, error) {
 	var l lock
 	err := toml.Unmarshal(file, &l)
 	if err != nil {
@@ -43,14 +92,14 @@ func (d *dep) Dependencies(file []byte) ([]diligent.Dep, []diligent.Warning, err
 	deps := make([]diligent.Dep, 0, len(l.Projects))
 	warns := make([]diligent.Warning, 0, len(l.Projects))
 	for _, pkg := range l.Projects {
-		l, err := d.lg.GetLicense(pkg.Name)
+		pkgDep, err := getDep(pkg.Name)
 		if err != nil {
-			warns = append(warns, warning.New(pkg.Name, err.Error()))
+			warns = append(warns, warning.NewWithVersion(pkg.Name, pkg.version(), d.Name(), err))
 		} else {
-			deps = append(deps, diligent.Dep{
-				Name:    pkg.Name,
-				License: l,
-			})
+			pkgDep.Version = pkg.version()
+			pkgDep.Ecosystem = d.Name()
+			pkgDep.Relationship = l.relationship(pkg.Name)
+			deps = append(deps, pkgDep)
 		}
 	}
 	return deps, warns, nil
@@ -2,6 +2,7 @@ package dep_test
 
 import (
 	"errors"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -18,6 +19,7 @@ type licenseGetterResponse struct {
 type mockLicenseGetter struct {
 	responses map[string]licenseGetterResponse
 	t         *testing.T
+	vendorDir string
 }
 
 func newMockLicenseGetter(t *testing.T, responses map[string]licenseGetterResponse) *mockLicenseGetter {
@@ -27,12 +29,17 @@ func newMockLicenseGetter(t *testing.T, responses map[string]licenseGetterRespon
 	}
 }
 
-func (mlg *mockLicenseGetter) GetLicense(packagePath string) (diligent.License, error) {
+func (mlg *mockLicenseGetter) GetDep(packagePath string) (diligent.Dep, error) {
 	resp, ok := mlg.responses[packagePath]
 	if !ok {
 		mlg.t.Errorf("mock not expecting %s", packagePath)
 	}
-	return resp.license, resp.err
+	return diligent.Dep{Name: packagePath, License: resp.license}, resp.err
+}
+
+func (mlg *mockLicenseGetter) GetVendoredDep(packagePath, vendorDir string) (diligent.Dep, error) {
+	mlg.vendorDir = vendorDir
+	return mlg.GetDep(packagePath)
 }
 
 func TestName(t *testing.T) {
@@ -91,8 +98,57 @@ var depTests = []struct {
 		},
 	},
 	[]diligent.Dep{{
-		Name:    "github.com/inconshreveable/mousetrap",
-		License: diligent.License{Identifier: "MIT"},
+		Name:      "github.com/inconshreveable/mousetrap",
+		License:   diligent.License{Identifier: "MIT"},
+		Version:   "v1.0",
+		Ecosystem: "dep",
+	}},
+	[]diligent.Warning{},
+	false,
+}, {
+	"direct and transitive dependencies",
+	[]byte(`
+[[projects]]
+  branch = "master"
+  name = "github.com/inconshreveable/mousetrap"
+  packages = ["."]
+  revision = "76626ae9c91c4f2a10f34cad8ce83ea42c93bb75"
+
+[[projects]]
+  name = "github.com/pelletier/go-toml"
+  packages = ["."]
+  revision = "acdc4509485b587f5e675510c4f2c63e90ff68a8"
+  version = "v1.1.0"
+
+[solve-meta]
+  analyzer-name = "dep"
+  analyzer-version = 1
+  input-imports = ["github.com/pelletier/go-toml/query"]
+  solver-name = "gps-cdcl"
+  solver-version = 1
+`),
+	map[string]licenseGetterResponse{
+		"github.com/inconshreveable/mousetrap": {
+			err:     nil,
+			license: diligent.License{Identifier: "MIT"},
+		},
+		"github.com/pelletier/go-toml": {
+			err:     nil,
+			license: diligent.License{Identifier: "DOC"},
+		},
+	},
+	[]diligent.Dep{{
+		Name:         "github.com/inconshreveable/mousetrap",
+		License:      diligent.License{Identifier: "MIT"},
+		Version:      "76626ae9c91c4f2a10f34cad8ce83ea42c93bb75",
+		Ecosystem:    "dep",
+		Relationship: diligent.Transitive,
+	}, {
+		Name:         "github.com/pelletier/go-toml",
+		License:      diligent.License{Identifier: "DOC"},
+		Version:      "v1.1.0",
+		Ecosystem:    "dep",
+		Relationship: diligent.Direct,
 	}},
 	[]diligent.Warning{},
 	false,
@@ -122,11 +178,15 @@ var depTests = []struct {
 		},
 	},
 	[]diligent.Dep{{
-		Name:    "github.com/inconshreveable/mousetrap",
-		License: diligent.License{Identifier: "MIT"},
+		Name:      "github.com/inconshreveable/mousetrap",
+		License:   diligent.License{Identifier: "MIT"},
+		Version:   "v1.0",
+		Ecosystem: "dep",
 	}, {
-		Name:    "github.com/pelletier/go-toml",
-		License: diligent.License{Identifier: "DOC"},
+		Name:      "github.com/pelletier/go-toml",
+		License:   diligent.License{Identifier: "DOC"},
+		Version:   "v1.1.0",
+		Ecosystem: "dep",
 	}},
 	[]diligent.Warning{},
 	false,
@@ -156,11 +216,13 @@ var depTests = []struct {
 		},
 	},
 	[]diligent.Dep{{
-		Name:    "github.com/inconshreveable/mousetrap",
-		License: diligent.License{Identifier: "MIT"},
+		Name:      "github.com/inconshreveable/mousetrap",
+		License:   diligent.License{Identifier: "MIT"},
+		Version:   "v1.0",
+		Ecosystem: "dep",
 	}},
 	[]diligent.Warning{
-		warning.New("github.com/pelletier/go-toml", "error"),
+		warning.NewWithVersion("github.com/pelletier/go-toml", "v1.1.0", "dep", errors.New("error")),
 	},
 	false,
 }, {
@@ -190,8 +252,8 @@ var depTests = []struct {
 	},
 	[]diligent.Dep{},
 	[]diligent.Warning{
-		warning.New("github.com/inconshreveable/mousetrap", "eeek"),
-		warning.New("github.com/pelletier/go-toml", "error"),
+		warning.NewWithVersion("github.com/inconshreveable/mousetrap", "v1.0", "dep", errors.New("eeek")),
+		warning.NewWithVersion("github.com/pelletier/go-toml", "v1.1.0", "dep", errors.New("error")),
 	},
 	false,
 }, {
@@ -226,3 +288,24 @@ func TestDependencies(t *testing.T) {
 		})
 	}
 }
+
+func TestManifestDependencies(t *testing.T) {
+	mockLG := newMockLicenseGetter(t, map[string]licenseGetterResponse{
+		"github.com/pkg/errors": {license: diligent.License{Identifier: "BSD-2-Clause"}},
+	})
+	target := dep.New(mockLG).(diligent.ManifestDeper)
+	d, _, err := target.ManifestDependencies(filepath.Join("project", "Gopkg.lock"), []byte(`
+[[projects]]
+  name = "github.com/pkg/errors"
+  version = "v0.8.0"
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d) != 1 || d[0].License.Identifier != "BSD-2-Clause" {
+		t.Errorf("expected the vendored dependency, got %v", d)
+	}
+	if want := filepath.Join("project", "vendor"); mockLG.vendorDir != want {
+		t.Errorf("expected vendor directory %s, got %s", want, mockLG.vendorDir)
+	}
+}
@@ -0,0 +1,57 @@
+package diligent_test
+
+import (
+	"testing"
+
+	"github.com/senseyeio/diligent"
+)
+
+func TestNewDep(t *testing.T) {
+	cases := []struct {
+		d             string
+		in            string
+		outIdentifier string
+		outExpression string
+		outLicenses   int
+		expFailure    bool
+	}{
+		{"single identifier", "MIT", "MIT", "", 1, false},
+		{"non standard identifier", "NewBSD", "BSD-3-Clause", "", 1, false},
+		{"expression", "(MIT OR Apache-2.0)", "MIT", "MIT OR Apache-2.0", 2, false},
+		{"exception", "GPL-2.0-only WITH Classpath-exception-2.0", "GPL-2.0-only", "GPL-2.0-only WITH Classpath-exception-2.0", 1, false},
+		{"unknown identifier", "MIT OR woowoo", "", "", 0, true},
+		{"unknown exception", "MIT WITH Bogus-exception", "", "", 0, true},
+		{"invalid expression", "MIT OR", "", "", 0, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			out, err := diligent.NewDep("dep", c.in)
+			if (err != nil) != c.expFailure {
+				t.Errorf("expecting error: %t, got %v", c.expFailure, err)
+			}
+			if c.expFailure {
+				return
+			}
+			if out.Name != "dep" || out.License.Identifier != c.outIdentifier || out.Expression != c.outExpression {
+				t.Errorf("unexpected dep, got %+v", out)
+			}
+			if len(out.Licenses()) != c.outLicenses {
+				t.Errorf("expecting %d licenses, got %+v", c.outLicenses, out.Licenses())
+			}
+		})
+	}
+}
+
+func TestDedupe(t *testing.T) {
+	mit, _ := diligent.NewDep("a", "MIT")
+	dual, _ := diligent.NewDep("a", "MIT OR Apache-2.0")
+	newer := mit
+	newer.Version = "2.0.0"
+	fromOtherManifest := mit
+	fromOtherManifest.Manifest = "other/package.json"
+	out := diligent.Deps{mit, dual, mit, newer, fromOtherManifest}.Dedupe()
+	if len(out) != 3 {
+		t.Errorf("expected three dependencies, got %+v", out)
+	}
+}
@@ -0,0 +1,91 @@
+package diligent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ExpiryDateFormat is the format of the expiry date of an Exception
+const ExpiryDateFormat = "2006-01-02"
+
+// ExpiryNotice is the period before an Exception expires during which diligent warns of its expiry
+const ExpiryNotice = 30 * 24 * time.Hour
+
+// Exception permits a dependency whose license is not whitelisted until its expiry date, for example while a
+// replacement is found
+type Exception struct {
+	// Package is the name of the dependency
+	Package string `toml:"package" yaml:"package"`
+	// License is the license identifier or SPDX license expression permitted. The exception does not apply if the
+	// license of the dependency changes.
+	License string `toml:"license" yaml:"license"`
+	// Approver records who granted the exception
+	Approver string `toml:"approver" yaml:"approver"`
+	// Ticket is a reference to the approval, such as an issue number
+	Ticket string `toml:"ticket" yaml:"ticket"`
+	// Expires is the last date, formatted as YYYY-MM-DD, on which the exception applies. It is a quoted string in TOML
+	// configuration, which does not support TOML dates.
+	Expires string `toml:"expires" yaml:"expires"`
+	// Applied is true if the exception permitted a dependency in the run which produced a report. It is not read from
+	// configuration.
+	Applied bool `toml:"-" yaml:"-"`
+}
+
+// Validate returns an error if the exception does not name a package, approver and ticket, its license is not a valid
+// expression of known licenses or its expiry date is invalid
+func (e Exception) Validate() error {
+	if e.Package == "" {
+		return fmt.Errorf("exception for license '%s' does not name a package", e.License)
+	}
+	if _, err := NewDep(e.Package, e.License); err != nil {
+		return fmt.Errorf("exception for package '%s' has an invalid license: %v", e.Package, err)
+	}
+	if strings.TrimSpace(e.Approver) == "" {
+		return fmt.Errorf("exception for package '%s' has no approver", e.Package)
+	}
+	if strings.TrimSpace(e.Ticket) == "" {
+		return fmt.Errorf("exception for package '%s' has no ticket", e.Package)
+	}
+	if _, err := time.Parse(ExpiryDateFormat, e.Expires); err != nil {
+		return fmt.Errorf("exception for package '%s' has an invalid expiry date '%s', expected YYYY-MM-DD", e.Package, e.Expires)
+	}
+	return nil
+}
+
+// Covers returns true if the exception applies to the dependency, which must have the license of the exception
+func (e Exception) Covers(d Dep) bool {
+	if e.Package != d.Name {
+		return false
+	}
+	ee, err := ParseExpression(e.License)
+	if err != nil {
+		return false
+	}
+	de, err := ParseExpression(d.LicenseExpression())
+	return err == nil && ee.String() == de.String()
+}
+
+// ExpiresAt returns the time at which the exception expires, the end of its expiry date in UTC
+func (e Exception) ExpiresAt() time.Time {
+	t, err := time.Parse(ExpiryDateFormat, e.Expires)
+	if err != nil {
+		return time.Time{}
+	}
+	return t.Add(24 * time.Hour)
+}
+
+// HasExpired returns true if the exception no longer applies at t
+func (e Exception) HasExpired(t time.Time) bool {
+	return !t.Before(e.ExpiresAt())
+}
+
+// ExpiresWithin returns true if the exception applies at t but expires within the period d
+func (e Exception) ExpiresWithin(t time.Time, d time.Duration) bool {
+	return !e.HasExpired(t) && e.HasExpired(t.Add(d))
+}
+
+// String describes the exception
+func (e Exception) String() string {
+	return fmt.Sprintf("%s (%s) permitted until %s by %s, %s", e.Package, e.License, e.Expires, e.Approver, e.Ticket)
+}
@@ -0,0 +1,120 @@
+package diligent_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/senseyeio/diligent"
+)
+
+var gplException = diligent.Exception{
+	Package:  "readline",
+	License:  "GPL-3.0",
+	Approver: "legal@example.com",
+	Ticket:   "LEGAL-42",
+	Expires:  "2024-06-30",
+}
+
+func TestExceptionValidate(t *testing.T) {
+	cases := []struct {
+		d     string
+		in    func(e diligent.Exception) diligent.Exception
+		isErr bool
+	}{
+		{"valid", func(e diligent.Exception) diligent.Exception { return e }, false},
+		{"no package", func(e diligent.Exception) diligent.Exception { e.Package = ""; return e }, true},
+		{"unknown license", func(e diligent.Exception) diligent.Exception { e.License = "woowoo"; return e }, true},
+		{"no approver", func(e diligent.Exception) diligent.Exception { e.Approver = ""; return e }, true},
+		{"no ticket", func(e diligent.Exception) diligent.Exception { e.Ticket = " "; return e }, true},
+		{"invalid expiry", func(e diligent.Exception) diligent.Exception { e.Expires = "30/06/2024"; return e }, true},
+		{"no expiry", func(e diligent.Exception) diligent.Exception { e.Expires = ""; return e }, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			err := c.in(gplException).Validate()
+			if (err != nil) != c.isErr {
+				t.Errorf("expected error %v, got %v", c.isErr, err)
+			}
+		})
+	}
+}
+
+func TestExceptionCovers(t *testing.T) {
+	gpl, _ := diligent.NewDep("readline", "GPL-3.0")
+	mit, _ := diligent.NewDep("readline", "MIT")
+	other, _ := diligent.NewDep("other", "GPL-3.0")
+	dual, _ := diligent.NewDep("readline", "GPL-3.0 OR MIT")
+	dualException := gplException
+	dualException.License = "(GPL-3.0 OR MIT)"
+
+	cases := []struct {
+		d   string
+		e   diligent.Exception
+		in  diligent.Dep
+		out bool
+	}{
+		{"matching", gplException, gpl, true},
+		{"different license", gplException, mit, false},
+		{"different package", gplException, other, false},
+		{"expression", dualException, dual, true},
+		{"expression and identifier", dualException, gpl, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			if out := c.e.Covers(c.in); out != c.out {
+				t.Errorf("expected %v, got %v", c.out, out)
+			}
+		})
+	}
+}
+
+func TestExceptionExpiry(t *testing.T) {
+	cases := []struct {
+		d        string
+		at       time.Time
+		expired  bool
+		expiring bool
+	}{
+		{"well before", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), false, false},
+		{"within notice", time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC), false, true},
+		{"on expiry date", time.Date(2024, 6, 30, 23, 59, 0, 0, time.UTC), false, true},
+		{"after expiry date", time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), true, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			if expired := gplException.HasExpired(c.at); expired != c.expired {
+				t.Errorf("expired: expected %v, got %v", c.expired, expired)
+			}
+			if expiring := gplException.ExpiresWithin(c.at, diligent.ExpiryNotice); expiring != c.expiring {
+				t.Errorf("expiring: expected %v, got %v", c.expiring, expiring)
+			}
+		})
+	}
+}
+
+func TestReportException(t *testing.T) {
+	gpl, _ := diligent.NewDep("readline", "GPL-3.0")
+	applied := gplException
+	applied.Applied = true
+
+	cases := []struct {
+		d   string
+		in  []diligent.Exception
+		out bool
+	}{
+		{"applied exception", []diligent.Exception{applied}, true},
+		{"exception which was not applied", []diligent.Exception{gplException}, false},
+		{"no exceptions", nil, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			if _, ok := (diligent.Report{Exceptions: c.in}).Exception(gpl); ok != c.out {
+				t.Errorf("expected %v, got %v", c.out, ok)
+			}
+		})
+	}
+}
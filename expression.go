@@ -0,0 +1,252 @@
+package diligent
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Operator combines the parts of a compound license expression
+type Operator string
+
+const (
+	// And requires the terms of every license to be met
+	And Operator = "AND"
+	// Or allows a choice between licenses
+	Or Operator = "OR"
+)
+
+// Expression is a parsed SPDX license expression, for example "MIT OR Apache-2.0" or
+// "GPL-2.0-only WITH Classpath-exception-2.0". A simple expression refers to a single license, whereas a compound
+// expression combines other expressions using an Operator.
+type Expression struct {
+	// Identifier is the license identifier of a simple expression. It may end with "+", meaning "or any later version".
+	Identifier string
+	// Exception is the identifier of a license exception applied to the license using the WITH operator
+	Exception string
+	// Operator is set for compound expressions, combining each of the Operands
+	Operator Operator
+	Operands []Expression
+}
+
+// NewExpression combines license identifiers using the provided operator. A single identifier results in a simple
+// expression.
+func NewExpression(op Operator, identifiers ...string) Expression {
+	if len(identifiers) == 1 {
+		return Expression{Identifier: identifiers[0]}
+	}
+	operands := make([]Expression, len(identifiers))
+	for i, identifier := range identifiers {
+		operands[i] = Expression{Identifier: identifier}
+	}
+	return Expression{Operator: op, Operands: operands}
+}
+
+// ParseExpression parses an SPDX license expression. Operators are case insensitive and AND takes precedence over OR.
+// License exceptions must be known to diligent, whereas license identifiers are only checked to be well formed.
+// Errors have the Reason NoLicenseDeclared when s is empty and UnknownIdentifier otherwise, as text which is not an
+// expression does not identify a license.
+func ParseExpression(s string) (Expression, error) {
+	p := &expressionParser{tokens: tokenizeExpression(s)}
+	if len(p.tokens) == 0 {
+		return Expression{}, WithReason(NoLicenseDeclared, errors.New("license expression is empty"))
+	}
+	e, err := p.parseOr()
+	if err != nil {
+		return Expression{}, WithReason(UnknownIdentifier, fmt.Errorf("invalid license expression '%s': %v", s, err))
+	}
+	if p.pos != len(p.tokens) {
+		return Expression{}, WithReason(UnknownIdentifier, fmt.Errorf("invalid license expression '%s': unexpected '%s'", s, p.tokens[p.pos]))
+	}
+	return e, nil
+}
+
+// String returns the expression in SPDX form. Nested compound expressions are parenthesised.
+func (e Expression) String() string {
+	if e.Operator == "" {
+		if e.Exception != "" {
+			return e.Identifier + " WITH " + e.Exception
+		}
+		return e.Identifier
+	}
+	parts := make([]string, len(e.Operands))
+	for i, o := range e.Operands {
+		parts[i] = o.String()
+		if o.Operator != "" && o.Operator != e.Operator {
+			parts[i] = "(" + parts[i] + ")"
+		}
+	}
+	return strings.Join(parts, " "+string(e.Operator)+" ")
+}
+
+// Identifiers returns the distinct license identifiers referenced by the expression, in the order they appear. Any
+// "+" suffix is removed.
+func (e Expression) Identifiers() []string {
+	found := map[string]bool{}
+	out := make([]string, 0)
+	e.walk(func(leaf Expression) {
+		identifier := strings.TrimSuffix(leaf.Identifier, "+")
+		if !found[identifier] {
+			found[identifier] = true
+			out = append(out, identifier)
+		}
+	})
+	return out
+}
+
+func (e Expression) walk(f func(leaf Expression)) {
+	if e.Operator == "" {
+		f(e)
+		return
+	}
+	for _, o := range e.Operands {
+		o.walk(f)
+	}
+}
+
+// IsSatisfiedBy returns true if the expression can be met using only licenses for which allowed returns true. Every
+// license combined using AND must be allowed, whereas only one of the licenses combined using OR need be.
+func (e Expression) IsSatisfiedBy(allowed func(identifier string) bool) bool {
+	switch e.Operator {
+	case And:
+		for _, o := range e.Operands {
+			if !o.IsSatisfiedBy(allowed) {
+				return false
+			}
+		}
+		return true
+	case Or:
+		for _, o := range e.Operands {
+			if o.IsSatisfiedBy(allowed) {
+				return true
+			}
+		}
+		return false
+	default:
+		return allowed(strings.TrimSuffix(e.Identifier, "+"))
+	}
+}
+
+func tokenizeExpression(s string) []string {
+	tokens := make([]string, 0)
+	for _, field := range strings.Fields(s) {
+		start := 0
+		for i, r := range field {
+			if r == '(' || r == ')' {
+				if i > start {
+					tokens = append(tokens, field[start:i])
+				}
+				tokens = append(tokens, string(r))
+				start = i + 1
+			}
+		}
+		if start < len(field) {
+			tokens = append(tokens, field[start:])
+		}
+	}
+	return tokens
+}
+
+type expressionParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *expressionParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *expressionParser) isKeyword(keyword string) bool {
+	return strings.EqualFold(p.peek(), keyword)
+}
+
+func (p *expressionParser) parseOr() (Expression, error) {
+	return p.parseCompound(Or, p.parseAnd)
+}
+
+func (p *expressionParser) parseAnd() (Expression, error) {
+	return p.parseCompound(And, p.parseTerm)
+}
+
+func (p *expressionParser) parseCompound(op Operator, next func() (Expression, error)) (Expression, error) {
+	operands := make([]Expression, 0, 1)
+	for {
+		e, err := next()
+		if err != nil {
+			return Expression{}, err
+		}
+		// flatten nested expressions using the same operator, so that "A AND (B AND C)" becomes "A AND B AND C"
+		if e.Operator == op {
+			operands = append(operands, e.Operands...)
+		} else {
+			operands = append(operands, e)
+		}
+		if !p.isKeyword(string(op)) {
+			break
+		}
+		p.pos++
+	}
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return Expression{Operator: op, Operands: operands}, nil
+}
+
+func (p *expressionParser) parseTerm() (Expression, error) {
+	tok := p.peek()
+	switch {
+	case tok == "":
+		return Expression{}, errors.New("unexpected end of expression")
+	case tok == "(":
+		p.pos++
+		e, err := p.parseOr()
+		if err != nil {
+			return Expression{}, err
+		}
+		if p.peek() != ")" {
+			return Expression{}, errors.New("missing closing parenthesis")
+		}
+		p.pos++
+		return e, nil
+	case tok == ")" || p.isKeyword(string(And)) || p.isKeyword(string(Or)) || p.isKeyword("WITH"):
+		return Expression{}, fmt.Errorf("unexpected '%s'", tok)
+	}
+	if !isValidIdentifier(tok) {
+		return Expression{}, fmt.Errorf("'%s' is not a valid license identifier", tok)
+	}
+	p.pos++
+	e := Expression{Identifier: tok}
+	if p.isKeyword("WITH") {
+		p.pos++
+		exception := p.peek()
+		if exception == "" || !isValidIdentifier(exception) {
+			return Expression{}, errors.New("WITH must be followed by a license exception identifier")
+		}
+		if _, err := GetLicenseExceptionFromIdentifier(exception); err != nil {
+			return Expression{}, err
+		}
+		p.pos++
+		e.Exception = exception
+	}
+	return e, nil
+}
+
+// isValidIdentifier checks an identifier is made up of the characters allowed by SPDX: letters, digits, "-" and ".",
+// with an optional trailing "+". Identifiers may be prefixed with a document reference, as in
+// "DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2".
+func isValidIdentifier(s string) bool {
+	s = strings.TrimSuffix(s, "+")
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '.' && r != ':' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,93 @@
+package diligent_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+)
+
+func TestParseExpression(t *testing.T) {
+	cases := []struct {
+		d           string
+		in          string
+		out         string
+		identifiers []string
+		expFailure  bool
+	}{
+		{"single identifier", "MIT", "MIT", []string{"MIT"}, false},
+		{"choice of licenses", "MIT OR Apache-2.0", "MIT OR Apache-2.0", []string{"MIT", "Apache-2.0"}, false},
+		{"redundant parentheses", "(MIT OR Apache-2.0)", "MIT OR Apache-2.0", []string{"MIT", "Apache-2.0"}, false},
+		{"AND takes precedence", "MIT OR BSD-3-Clause AND ISC", "MIT OR (BSD-3-Clause AND ISC)", []string{"MIT", "BSD-3-Clause", "ISC"}, false},
+		{"parentheses alter precedence", "(MIT OR BSD-3-Clause) AND ISC", "(MIT OR BSD-3-Clause) AND ISC", []string{"MIT", "BSD-3-Clause", "ISC"}, false},
+		{"lower case operators", "mit or isc", "mit OR isc", []string{"mit", "isc"}, false},
+		{"exception", "GPL-2.0-only WITH Classpath-exception-2.0", "GPL-2.0-only WITH Classpath-exception-2.0", []string{"GPL-2.0-only"}, false},
+		{"or later", "GPL-2.0+ AND MIT", "GPL-2.0+ AND MIT", []string{"GPL-2.0", "MIT"}, false},
+		{"duplicate identifiers", "MIT AND (MIT OR ISC)", "MIT AND (MIT OR ISC)", []string{"MIT", "ISC"}, false},
+		{"empty", "", "", nil, true},
+		{"missing operand", "MIT OR", "", nil, true},
+		{"missing parenthesis", "(MIT OR ISC", "", nil, true},
+		{"missing operator", "MIT ISC", "", nil, true},
+		{"invalid identifier", "SEE LICENSE IN LICENSE.txt", "", nil, true},
+		{"missing exception", "GPL-2.0-only WITH", "", nil, true},
+		{"unknown exception", "MIT WITH Bogus-exception", "", nil, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			out, err := diligent.ParseExpression(c.in)
+			if (err != nil) != c.expFailure {
+				t.Errorf("expecting error: %t, got %v", c.expFailure, err)
+			}
+			if c.expFailure {
+				return
+			}
+			if out.String() != c.out {
+				t.Errorf("expecting %s, got %s", c.out, out.String())
+			}
+			if !reflect.DeepEqual(out.Identifiers(), c.identifiers) {
+				t.Errorf("expecting identifiers %v, got %v", c.identifiers, out.Identifiers())
+			}
+		})
+	}
+}
+
+func TestExpressionIsSatisfiedBy(t *testing.T) {
+	allowed := func(identifier string) bool {
+		return identifier == "MIT" || identifier == "ISC" || identifier == "GPL-2.0"
+	}
+	cases := []struct {
+		in  string
+		out bool
+	}{
+		{"MIT", true},
+		{"Apache-2.0", false},
+		{"MIT OR Apache-2.0", true},
+		{"MIT AND Apache-2.0", false},
+		{"MIT AND ISC", true},
+		{"(MIT OR Apache-2.0) AND ISC", true},
+		{"(BSD-3-Clause OR Apache-2.0) AND ISC", false},
+		{"GPL-2.0+", true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			e, err := diligent.ParseExpression(c.in)
+			if err != nil {
+				t.Fatalf("did not expect an error, got %v", err)
+			}
+			if e.IsSatisfiedBy(allowed) != c.out {
+				t.Errorf("expecting %t", c.out)
+			}
+		})
+	}
+}
+
+func TestNewExpression(t *testing.T) {
+	if out := diligent.NewExpression(diligent.Or, "MIT").String(); out != "MIT" {
+		t.Errorf("expected MIT, got %s", out)
+	}
+	if out := diligent.NewExpression(diligent.And, "MIT", "ISC").String(); out != "MIT AND ISC" {
+		t.Errorf("expected MIT AND ISC, got %s", out)
+	}
+}
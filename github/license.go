@@ -1,12 +1,14 @@
 package github
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"regexp"
+	"strings"
 
 	"github.com/senseyeio/diligent"
 )
@@ -24,11 +26,27 @@ func New(apiURL string) *Github {
 var pathComponentsRegex = regexp.MustCompile(`\/([^/]*)`)
 
 type licenseResponse struct {
-	License struct {
+	HTMLURL  string `json:"html_url"`
+	Content  string `json:"content"`
+	Encoding string `json:"encoding"`
+	License  struct {
 		SPDX *string `json:"spdx_id"`
 	} `json:"license"`
 }
 
+// text returns the content of the license file included within the response
+func (r licenseResponse) text() string {
+	if r.Encoding != "base64" {
+		return r.Content
+	}
+	// GitHub wraps base64 content across lines
+	text, err := base64.StdEncoding.DecodeString(strings.Replace(r.Content, "\n", "", -1))
+	if err != nil {
+		return ""
+	}
+	return string(text)
+}
+
 func getOwnerAndRepoFromURL(s string) (owner, repo string, err error) {
 	u, err := url.Parse(s)
 	if err != nil {
@@ -54,31 +72,65 @@ func (g *Github) IsCompatibleURL(s string) bool {
 	return err == nil
 }
 
-// GetLicenseFromURL will attempt to get the license associated with a github repo
-func (g *Github) GetLicenseFromURL(s string) (diligent.License, error) {
+// GetLicenseFromURL will attempt to get the license associated with a github repo, along with its provenance
+func (g *Github) GetLicenseFromURL(s string) (diligent.License, diligent.Provenance, error) {
 	owner, repo, err := getOwnerAndRepoFromURL(s)
 	if err != nil {
-		return diligent.License{}, err
+		return diligent.License{}, diligent.Provenance{}, err
 	}
 	return g.GetLicense(owner, repo)
 }
 
-// GetLicense will attempt to get the license associated with a repository identified by its owner and name
-func (g *Github) GetLicense(owner, repo string) (diligent.License, error) {
+// GetLicense will attempt to get the license associated with a repository identified by its owner and name, along
+// with its provenance. GitHub does not report how confident it is in the license, so the provenance has no confidence.
+func (g *Github) GetLicense(owner, repo string) (diligent.License, diligent.Provenance, error) {
 	url := fmt.Sprintf("%s/repos/%s/%s/license", g.url, url.PathEscape(owner), url.PathEscape(repo))
 	resp, err := http.Get(url)
 	if err != nil {
-		return diligent.License{}, err
+		return diligent.License{}, diligent.Provenance{}, diligent.WithReason(diligent.NetworkError, err)
 	}
 	defer resp.Body.Close()
+	if err := statusError(resp); err != nil {
+		return diligent.License{}, diligent.Provenance{}, err
+	}
 
 	var data licenseResponse
 	err = json.NewDecoder(resp.Body).Decode(&data)
 	if err != nil {
-		return diligent.License{}, err
+		return diligent.License{}, diligent.Provenance{}, diligent.WithReason(diligent.ParseError, err)
 	}
 	if data.License.SPDX == nil {
-		return diligent.License{}, errors.New("no license information available")
+		return diligent.License{}, diligent.Provenance{}, diligent.WithReason(diligent.NoLicenseDeclared, errors.New("no license information available"))
+	}
+	l, err := diligent.GetLicenseFromIdentifier(*data.License.SPDX)
+	if err != nil {
+		return diligent.License{}, diligent.Provenance{}, err
+	}
+	text := data.text()
+	location := data.HTMLURL
+	if location == "" {
+		location = url
+	}
+	return l, diligent.Provenance{
+		Source:   diligent.RepositoryAPI,
+		License:  l.Identifier,
+		Location: location,
+		Snippet:  diligent.Snippet(text),
+		Text:     text,
+	}, nil
+}
+
+// statusError returns an error describing an unsuccessful response. GitHub responds with 404 when a repository does
+// not exist or has no license file, and with 403 or 429 once the rate limit has been exceeded.
+func statusError(resp *http.Response) error {
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return nil
+	case resp.StatusCode == http.StatusNotFound:
+		return diligent.WithReason(diligent.NotFound, errors.New("repository or license not found"))
+	case resp.StatusCode == http.StatusTooManyRequests,
+		resp.StatusCode == http.StatusForbidden && resp.Header.Get("X-RateLimit-Remaining") == "0":
+		return diligent.WithReason(diligent.RateLimited, errors.New("GitHub API rate limit exceeded"))
 	}
-	return diligent.GetLicenseFromIdentifier(*data.License.SPDX)
+	return diligent.WithReason(diligent.NetworkError, fmt.Errorf("request failed with status %v", resp.StatusCode))
 }
@@ -1,6 +1,7 @@
 package github_test
 
 import (
+	"reflect"
 	"testing"
 
 	"net/http"
@@ -44,7 +45,9 @@ func TestGetLicenseFromURL(t *testing.T) {
 		in         string
 		handler    http.HandlerFunc
 		expLID     string
+		expSnippet string
 		expFailure bool
+		expReason  diligent.Reason
 	}{{
 		"should lookup license from github API",
 		"https://github.com/senseyeio/spaniel",
@@ -59,7 +62,25 @@ func TestGetLicenseFromURL(t *testing.T) {
 			w.Write([]byte("{\"license\":{\"spdx_id\":\"MIT\"}}"))
 		}),
 		"MIT",
+		"",
+		false,
+		"",
+	}, {
+		"should record the license file as provenance",
+		"https://github.com/senseyeio/spaniel",
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{
+				"html_url": "https://github.com/senseyeio/spaniel/blob/master/LICENSE",
+				"content": "TUlUIExpY2Vuc2UKCkNvcHlyaWdo\ndCAoYykgMjAxNyBTZW5zZXll\n",
+				"encoding": "base64",
+				"license": {"spdx_id": "MIT"}
+			}`))
+		}),
+		"MIT",
+		"MIT License Copyright (c) 2017 Senseye",
 		false,
+		"",
 	}, {
 		"should fail if not github URL",
 		"https://senseye.io/senseyeio/spaniel",
@@ -67,7 +88,9 @@ func TestGetLicenseFromURL(t *testing.T) {
 			w.WriteHeader(http.StatusBadGateway)
 		}),
 		"",
+		"",
 		true,
+		diligent.UnknownReason,
 	}, {
 		"should fail if github fails",
 		"https://github.com/senseyeio/spaniel",
@@ -75,7 +98,9 @@ func TestGetLicenseFromURL(t *testing.T) {
 			w.WriteHeader(http.StatusInternalServerError)
 		}),
 		"",
+		"",
 		true,
+		diligent.NetworkError,
 	}, {
 		"should fail if github returns unexpected body",
 		"https://github.com/senseyeio/spaniel",
@@ -84,7 +109,9 @@ func TestGetLicenseFromURL(t *testing.T) {
 			w.Write([]byte("{\"license\":{\"noID\":\"it's missing\"}}"))
 		}),
 		"",
+		"",
 		true,
+		diligent.NoLicenseDeclared,
 	}, {
 		"should fail if github returns non json body",
 		"https://github.com/senseyeio/spaniel",
@@ -93,7 +120,9 @@ func TestGetLicenseFromURL(t *testing.T) {
 			w.Write([]byte("{{"))
 		}),
 		"",
+		"",
 		true,
+		diligent.ParseError,
 	}, {
 		"should fail if github returns an unknown license ID",
 		"https://github.com/senseyeio/spaniel",
@@ -102,7 +131,31 @@ func TestGetLicenseFromURL(t *testing.T) {
 			w.Write([]byte("{\"license\":{\"spdx_id\":\"woowoo\"}}"))
 		}),
 		"",
+		"",
 		true,
+		diligent.UnknownIdentifier,
+	}, {
+		"should fail if the repository has no license",
+		"https://github.com/senseyeio/spaniel",
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("{\"message\":\"Not Found\"}"))
+		}),
+		"",
+		"",
+		true,
+		diligent.NotFound,
+	}, {
+		"should fail if rate limited",
+		"https://github.com/senseyeio/spaniel",
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.WriteHeader(http.StatusForbidden)
+		}),
+		"",
+		"",
+		true,
+		diligent.RateLimited,
 	}}
 
 	for _, c := range cases {
@@ -110,15 +163,21 @@ func TestGetLicenseFromURL(t *testing.T) {
 			ts := httptest.NewServer(c.handler)
 			defer ts.Close()
 			target := github.New(ts.URL)
-			l, err := target.GetLicenseFromURL(c.in)
+			l, p, err := target.GetLicenseFromURL(c.in)
 			if (err != nil) != c.expFailure {
 				t.Errorf("expected failure: %t, got %v", c.expFailure, err)
 			}
+			if c.expFailure && diligent.ReasonOf(err) != c.expReason {
+				t.Errorf("expected reason %s, got %s", c.expReason, diligent.ReasonOf(err))
+			}
 			if c.expFailure == false {
 				expL, _ := diligent.GetLicenseFromIdentifier(c.expLID)
-				if expL != l {
+				if !reflect.DeepEqual(expL, l) {
 					t.Errorf("expected license %+v, got %+v", expL, l)
 				}
+				if p.Source != diligent.RepositoryAPI || p.License != c.expLID || p.Location == "" || p.Snippet != c.expSnippet || diligent.Snippet(p.Text) != c.expSnippet {
+					t.Errorf("unexpected provenance %+v", p)
+				}
 			}
 		})
 	}
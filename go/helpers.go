@@ -5,28 +5,47 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 
 	"go/build"
 
-	"github.com/ryanuber/go-license"
 	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/classifier"
+	"github.com/senseyeio/diligent/header"
 )
 
 // LicenseGetter provides methods to retrieve the licenses associated with go packages
 type LicenseGetter struct {
-	webLG WebLicenseGetter
+	config     Config
+	webLG      WebLicenseGetter
+	classifier *classifier.Classifier
+	scanner    *header.Scanner
+}
+
+// Config allows default options to be altered
+type Config struct {
+	// ScanHeaders can be set to true to search every source file of a package for SPDX-License-Identifier headers.
+	// The licenses declared by headers are combined with those found in the package's license files, so packages
+	// mixing licenses file by file are reported correctly. As this requires the package source, the WebLicenseGetter
+	// is not used.
+	ScanHeaders bool
 }
 
 // NewLicenseGetter returns a new instance of LicenseGetter using the provided WebLicenseGetter where possible
 func NewLicenseGetter(webLG WebLicenseGetter) *LicenseGetter {
-	return &LicenseGetter{webLG}
+	return NewLicenseGetterWithOptions(webLG, Config{})
+}
+
+// NewLicenseGetterWithOptions is identical to NewLicenseGetter but allows the default options to be overridden
+func NewLicenseGetterWithOptions(webLG WebLicenseGetter, c Config) *LicenseGetter {
+	return &LicenseGetter{c, webLG, classifier.New(), header.New()}
 }
 
 // WebLicenseGetter retrieves license information from an online source
 type WebLicenseGetter interface {
 	IsCompatibleURL(s string) bool
-	GetLicenseFromURL(s string) (diligent.License, error)
+	GetLicenseFromURL(s string) (diligent.License, diligent.Provenance, error)
 }
 
 func goPath() string {
@@ -37,50 +56,153 @@ func goPath() string {
 	return gopath
 }
 
-// GetLicense will return the license associated with a given go package
-func (lg *LicenseGetter) GetLicense(packagePath string) (diligent.License, error) {
+// GetDep will return the dependency, along with its licenses, associated with a given go package
+func (lg *LicenseGetter) GetDep(packagePath string) (diligent.Dep, error) {
+	return lg.GetVendoredDep(packagePath, "")
+}
+
+// GetVendoredDep is identical to GetDep but first looks for the source of the package within vendorDir, the vendor
+// directory of the project. The licenses of a vendored package are identified from its license files and the
+// SPDX-License-Identifier headers of its source files, so the package is only downloaded if it has not been vendored
+// or no license is found within the vendored source.
+func (lg *LicenseGetter) GetVendoredDep(packagePath, vendorDir string) (diligent.Dep, error) {
 	components := strings.Split(packagePath, "/")
 	// in some go vendoring solutions full paths to packages are defined as dependencies
 	// need to look for the base package identifier so github.com/aws/aws-sdk-go/aws becomes github.com/aws/aws-sdk-go
 	if len(components) < 2 {
-		return diligent.License{}, errors.New("invalid go package path")
+		return diligent.Dep{}, diligent.WithReason(diligent.ParseError, errors.New("invalid go package path"))
 	}
 	// try a three component base package, if possible, as it is most common
 	if len(components) >= 3 {
-		l, err := lg.getLicenseForBasePackage(strings.Join(components[:3], "/"))
+		d, err := lg.getDepForBasePackage(packagePath, strings.Join(components[:3], "/"), vendorDir)
 		if err == nil {
-			return l, nil
+			return d, nil
 		}
+		// can have libraries with just two components, for example gopkg.in/mgo.v2. If neither is found, the error of
+		// the more common three component base package is returned.
+		if d, twoErr := lg.getDepForBasePackage(packagePath, strings.Join(components[:2], "/"), vendorDir); twoErr == nil {
+			return d, nil
+		}
+		return diligent.Dep{}, err
 	}
-	// can have libraries with just two components, for example gopkg.in/mgo.v2
-	return lg.getLicenseForBasePackage(strings.Join(components[:2], "/"))
+	return lg.getDepForBasePackage(packagePath, strings.Join(components[:2], "/"), vendorDir)
 }
 
-func (lg *LicenseGetter) getLicenseForBasePackage(pkg string) (diligent.License, error) {
-	if lg.webLG.IsCompatibleURL(fmt.Sprintf("https://%s", pkg)) {
-		l, err := lg.webLG.GetLicenseFromURL(fmt.Sprintf("https://%s", pkg))
+func (lg *LicenseGetter) getDepForBasePackage(packagePath, pkg, vendorDir string) (diligent.Dep, error) {
+	if vendorDir != "" {
+		dir := filepath.Join(vendorDir, filepath.FromSlash(pkg))
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			expression, provenance, err := lg.getExpressionFromDir(dir, true)
+			if err == nil {
+				return newDep(packagePath, expression, provenance)
+			}
+		}
+	}
+	var webErr error
+	if !lg.config.ScanHeaders && lg.webLG.IsCompatibleURL(fmt.Sprintf("https://%s", pkg)) {
+		l, p, err := lg.webLG.GetLicenseFromURL(fmt.Sprintf("https://%s", pkg))
 		if err == nil {
-			return l, nil
+			return diligent.Dep{
+				Name:       packagePath,
+				License:    l,
+				Provenance: []diligent.Provenance{p},
+			}, nil
 		}
+		webErr = err
 	}
-	l, err := getLicenseFromLicenseFile(pkg)
-	if err == nil {
-		return l, nil
+	expression, provenance, err := lg.getExpressionFromSource(pkg)
+	if err != nil {
+		// the reason the web lookup failed, such as rate limiting, explains the failure better than the download
+		// which was only attempted as a fallback
+		if r := diligent.ReasonOf(webErr); r != diligent.UnknownReason {
+			return diligent.Dep{}, diligent.WithReason(r, fmt.Errorf("failed to find license: %v", webErr))
+		}
+		return diligent.Dep{}, diligent.WithReason(sourceReason(err), errors.New("failed to find license"))
+	}
+	return newDep(packagePath, expression, provenance)
+}
+
+func newDep(packagePath, expression string, provenance []diligent.Provenance) (diligent.Dep, error) {
+	d, err := diligent.NewDep(packagePath, expression)
+	if err != nil {
+		return diligent.Dep{}, err
 	}
-	return diligent.License{}, errors.New("failed to find license")
+	d.Provenance = provenance
+	return d, nil
 }
 
-func getLicenseFromLicenseFile(pkg string) (diligent.License, error) {
+// getExpressionFromSource downloads the package and identifies the licenses held in its license files and, if
+// configured, the SPDX-License-Identifier headers of its source files
+func (lg *LicenseGetter) getExpressionFromSource(pkg string) (string, []diligent.Provenance, error) {
 	cmd := exec.Command("go", "get", "-d", fmt.Sprintf("%s/...", pkg))
 	err := cmd.Run()
 	if err != nil {
-		return diligent.License{}, err
+		return "", nil, diligent.WithReason(diligent.NetworkError, err)
 	}
+	return lg.getExpressionFromDir(fmt.Sprintf("%s/src/%s", goPath(), pkg), lg.config.ScanHeaders)
+}
 
-	l, err := license.NewFromDir(fmt.Sprintf("%s/src/%s", goPath(), pkg))
-	if err != nil {
-		return diligent.License{}, err
+// getExpressionFromDir identifies the licenses held in the license files within dir and, if scanHeaders is true, the
+// SPDX-License-Identifier headers of the source files beneath it
+func (lg *LicenseGetter) getExpressionFromDir(dir string, scanHeaders bool) (string, []diligent.Provenance, error) {
+	var expression string
+	provenance := make([]diligent.Provenance, 0)
+	matches, err := lg.classifier.ClassifyDir(dir)
+	if err == nil {
+		expression = classifier.Expression(matches)
+		for _, m := range matches {
+			provenance = append(provenance, diligent.Provenance{
+				Source:     diligent.LicenseFile,
+				License:    m.Identifier,
+				Location:   m.Path,
+				Snippet:    diligent.Snippet(m.Text),
+				Text:       m.Text,
+				Confidence: m.Confidence,
+			})
+		}
+	}
+	if !scanHeaders {
+		return expression, provenance, err
 	}
 
-	return diligent.GetLicenseFromIdentifier(l.Type)
+	result, scanErr := lg.scanner.Scan(dir)
+	if scanErr != nil {
+		return "", nil, scanErr
+	}
+	expression = header.Combine(expression, result.Expression())
+	if expression == "" {
+		return "", nil, err
+	}
+	return expression, append(provenance, headerProvenance(dir, result)...), nil
+}
+
+// sourceReason returns the reason the license of a package could not be determined from its source
+func sourceReason(err error) diligent.Reason {
+	if r := diligent.ReasonOf(err); r != diligent.UnknownReason {
+		return r
+	}
+	if errors.Is(err, classifier.ErrNoMatch) {
+		return diligent.UnknownIdentifier
+	}
+	return diligent.NotFound
+}
+
+// headerProvenance records the first file declaring each of the expressions found by a header scan
+func headerProvenance(dir string, result header.Result) []diligent.Provenance {
+	provenance := make([]diligent.Provenance, 0)
+	found := map[string]bool{}
+	for _, f := range result.Files {
+		if f.Err != nil || found[f.Expression] {
+			continue
+		}
+		found[f.Expression] = true
+		provenance = append(provenance, diligent.Provenance{
+			Source:     diligent.SourceHeader,
+			License:    f.Expression,
+			Location:   filepath.Join(dir, filepath.FromSlash(f.Path)),
+			Snippet:    fmt.Sprintf("SPDX-License-Identifier: %s (declared by %d file(s))", f.Expression, result.Expressions()[f.Expression]),
+			Confidence: 1,
+		})
+	}
+	return provenance
 }
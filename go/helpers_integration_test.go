@@ -1,3 +1,4 @@
+//go:build integration
 // +build integration
 
 package _go_test
@@ -25,12 +26,12 @@ func (m mockWebLicenseGetter) IsCompatibleURL(s string) bool {
 	r, ok := m.responses[s]
 	return ok && r.isCompatible
 }
-func (m mockWebLicenseGetter) GetLicenseFromURL(s string) (diligent.License, error) {
+func (m mockWebLicenseGetter) GetLicenseFromURL(s string) (diligent.License, diligent.Provenance, error) {
 	r, ok := m.responses[s]
 	if !ok {
-		return diligent.License{}, errors.New("not mocked")
+		return diligent.License{}, diligent.Provenance{}, errors.New("not mocked")
 	}
-	return r.license, r.err
+	return r.license, diligent.Provenance{Source: diligent.RepositoryAPI, Location: s}, r.err
 }
 
 func TestWebLicenseGetter(t *testing.T) {
@@ -64,12 +65,12 @@ func TestWebLicenseGetter(t *testing.T) {
 	for _, c := range cases {
 		t.Run(c.d, func(t *testing.T) {
 			target := _go.NewLicenseGetter(mock)
-			l, err := target.GetLicense(c.pkgInput)
+			d, err := target.GetDep(c.pkgInput)
 			if (err != nil) != c.expFailure {
 				t.Errorf("expected failure: %t, got %v", c.expFailure, err)
 			}
-			if c.expFailure == false && l.Name != "test-license" {
-				t.Errorf("expected license test-license, got %+v", l)
+			if c.expFailure == false && d.License.Name != "test-license" {
+				t.Errorf("expected license test-license, got %+v", d)
 			}
 		})
 	}
@@ -80,19 +81,19 @@ func TestGoGetLicenseLookup(t *testing.T) {
 	target := _go.NewLicenseGetter(mock)
 
 	t.Run("should fail with an invalid package", func(t *testing.T) {
-		_, err := target.GetLicense("not/a/real/package")
+		_, err := target.GetDep("not/a/real/package")
 		if err == nil {
 			t.Error("expected an error")
 		}
 	})
 
 	t.Run("should succeed with an valid package", func(t *testing.T) {
-		l, err := target.GetLicense("github.com/senseyeio/spaniel")
+		d, err := target.GetDep("github.com/senseyeio/spaniel")
 		if err != nil {
 			t.Errorf("did not expect an error, got %v", err)
 		}
-		if l.Identifier != "MIT" {
-			t.Errorf("expected MIT license, got %+v", l)
+		if d.LicenseExpression() != "MIT" {
+			t.Errorf("expected MIT license, got %+v", d)
 		}
 	})
 }
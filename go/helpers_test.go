@@ -1,15 +1,96 @@
 package _go_test
 
 import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/senseyeio/diligent"
 	"github.com/senseyeio/diligent/go"
 )
 
 func TestInvalidPath(t *testing.T) {
 	target := _go.NewLicenseGetter(nil)
-	_, err := target.GetLicense("no-components")
+	_, err := target.GetDep("no-components")
 	if err == nil {
 		t.Error("expected an error")
 	}
 }
+
+func TestVendoredDep(t *testing.T) {
+	vendorDir, err := ioutil.TempDir("", "diligent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(vendorDir)
+	pkgDir := filepath.Join(vendorDir, "github.com", "acme", "lib")
+	if err := os.MkdirAll(filepath.Join(pkgDir, "sub"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"lib.go":        "// SPDX-License-Identifier: MIT\npackage lib\n",
+		"sub/compat.go": "// SPDX-License-Identifier: Apache-2.0\npackage sub\n",
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(pkgDir, filepath.FromSlash(name)), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the web license getter is not required as the package is vendored
+	target := _go.NewLicenseGetter(nil)
+	d, err := target.GetVendoredDep("github.com/acme/lib/sub", vendorDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Name != "github.com/acme/lib/sub" || d.LicenseExpression() != "Apache-2.0 AND MIT" {
+		t.Errorf("expected Apache-2.0 AND MIT, got %s %s", d.Name, d.LicenseExpression())
+	}
+	if len(d.Provenance) != 2 || d.Provenance[0].Source != diligent.SourceHeader {
+		t.Errorf("expected provenance from each header, got %+v", d.Provenance)
+	}
+}
+
+type webLicenseGetter struct {
+	compatible bool
+	err        error
+}
+
+func (w webLicenseGetter) IsCompatibleURL(s string) bool {
+	return w.compatible && s == "https://github.com/acme/lib"
+}
+
+func (w webLicenseGetter) GetLicenseFromURL(s string) (diligent.License, diligent.Provenance, error) {
+	return diligent.License{}, diligent.Provenance{}, w.err
+}
+
+func TestWebLicenseGetterReason(t *testing.T) {
+	// go get fails to run without a PATH, as it would without network access
+	path := os.Getenv("PATH")
+	defer os.Setenv("PATH", path)
+	os.Setenv("PATH", "")
+
+	cases := []struct {
+		d      string
+		webLG  webLicenseGetter
+		reason diligent.Reason
+	}{
+		{"rate limited", webLicenseGetter{true, diligent.WithReason(diligent.RateLimited, errors.New("rate limit exceeded"))}, diligent.RateLimited},
+		{"not found", webLicenseGetter{true, diligent.WithReason(diligent.NotFound, errors.New("requested failed with status 404"))}, diligent.NotFound},
+		{"web error without a reason", webLicenseGetter{true, errors.New("failed")}, diligent.NetworkError},
+		{"incompatible url", webLicenseGetter{false, nil}, diligent.NetworkError},
+	}
+	for _, tt := range cases {
+		t.Run(tt.d, func(t *testing.T) {
+			_, err := _go.NewLicenseGetter(tt.webLG).GetDep("github.com/acme/lib/sub")
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if r := diligent.ReasonOf(err); r != tt.reason {
+				t.Errorf("expected reason %s, got %s: %v", tt.reason, r, err)
+			}
+		})
+	}
+}
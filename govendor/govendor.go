@@ -2,14 +2,29 @@ package govendor
 
 import (
 	"encoding/json"
+	"path/filepath"
 
 	"github.com/senseyeio/diligent"
 	"github.com/senseyeio/diligent/warning"
 )
 
 type pkg struct {
-	Path     string `json:"path"`
-	Revision string `json:"revision"`
+	Path         string `json:"path"`
+	Revision     string `json:"revision"`
+	Version      string `json:"version"`
+	VersionExact string `json:"versionExact"`
+}
+
+// version returns the exact version of the package if known, falling back to the version constraint and then the
+// revision
+func (p pkg) version() string {
+	if p.VersionExact != "" {
+		return p.VersionExact
+	}
+	if p.Version != "" {
+		return p.Version
+	}
+	return p.Revision
 }
 
 type vendor struct {
@@ -20,8 +35,11 @@ type govendor struct {
 	lg GoLicenseGetter
 }
 
+// GoLicenseGetter retrieves the licenses associated with go packages
 type GoLicenseGetter interface {
-	GetLicense(packagePath string) (diligent.License, error)
+	GetDep(packagePath string) (diligent.Dep, error)
+	// GetVendoredDep is identical to GetDep but first looks for the source of the package within vendorDir
+	GetVendoredDep(packagePath, vendorDir string) (diligent.Dep, error)
 }
 
 // New returns a Deper capable of handling govendor manifest files
@@ -36,6 +54,19 @@ func (g *govendor) Name() string {
 
 // Dependencies returns the licenses of the go packages defined within the govendor manifest
 func (g *govendor) Dependencies(file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	return g.dependencies(file, g.lg.GetDep)
+}
+
+// ManifestDependencies is identical to Dependencies, but identifies the licenses of packages found within the vendor
+// directory holding the manifest from their source
+func (g *govendor) ManifestDependencies(path string, file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	vendorDir := filepath.Dir(path)
+	return g.dependencies(file, func(packagePath string) (diligent.Dep, error) {
+		return g.lg.GetVendoredDep(packagePath, vendorDir)
+	})
+}
+
+func (g *govendor) dependencies(file []byte, getDep func(packagePath string) (diligent.Dep, error)) ([]diligent.Dep, []diligent.Warning, error) {
 	var vendorFile vendor
 	err := json.Unmarshal(file, &vendorFile)
 	if err != nil {
@@ -46,14 +77,13 @@ func (g *govendor) Dependencies(file []byte) ([]diligent.Dep, []diligent.Warning
 	warns := make([]diligent.Warning, 0, len(vendorFile.Packages))
 	for _, pkg := range vendorFile.Packages {
 		pkgPath := pkg.Path
-		l, err := g.lg.GetLicense(pkgPath)
+		pkgDep, err := getDep(pkgPath)
 		if err != nil {
-			warns = append(warns, warning.New(pkgPath, err.Error()))
+			warns = append(warns, warning.NewWithVersion(pkgPath, pkg.version(), g.Name(), err))
 		} else {
-			deps = append(deps, diligent.Dep{
-				Name:    pkgPath,
-				License: l,
-			})
+			pkgDep.Version = pkg.version()
+			pkgDep.Ecosystem = g.Name()
+			deps = append(deps, pkgDep)
 		}
 	}
 	return deps, warns, nil
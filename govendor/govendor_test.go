@@ -2,6 +2,7 @@ package govendor_test
 
 import (
 	"errors"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -18,6 +19,7 @@ type licenseGetterResponse struct {
 type mockLicenseGetter struct {
 	responses map[string]licenseGetterResponse
 	t         *testing.T
+	vendorDir string
 }
 
 func newMockLicenseGetter(t *testing.T, responses map[string]licenseGetterResponse) *mockLicenseGetter {
@@ -27,12 +29,17 @@ func newMockLicenseGetter(t *testing.T, responses map[string]licenseGetterRespon
 	}
 }
 
-func (mlg *mockLicenseGetter) GetLicense(packagePath string) (diligent.License, error) {
+func (mlg *mockLicenseGetter) GetDep(packagePath string) (diligent.Dep, error) {
 	resp, ok := mlg.responses[packagePath]
 	if !ok {
 		mlg.t.Errorf("mock not expecting %s", packagePath)
 	}
-	return resp.license, resp.err
+	return diligent.Dep{Name: packagePath, License: resp.license}, resp.err
+}
+
+func (mlg *mockLicenseGetter) GetVendoredDep(packagePath, vendorDir string) (diligent.Dep, error) {
+	mlg.vendorDir = vendorDir
+	return mlg.GetDep(packagePath)
 }
 
 func TestName(t *testing.T) {
@@ -96,8 +103,40 @@ var depTests = []struct {
 		},
 	},
 	[]diligent.Dep{{
-		Name:    "github.com/go-logfmt/logfmt",
-		License: diligent.License{Identifier: "MIT"},
+		Name:      "github.com/go-logfmt/logfmt",
+		License:   diligent.License{Identifier: "MIT"},
+		Version:   "390ab7935ee28ec6b286364bba9b4dd6410cb3d5",
+		Ecosystem: "govendor",
+	}},
+	[]diligent.Warning{},
+	false,
+}, {
+	"exact versions",
+	[]byte(`
+{
+	"package": [
+		{
+			"checksumSHA1": "KxX/Drph+byPXBFIXaCZaCOAnrU=",
+			"path": "github.com/go-logfmt/logfmt",
+			"revision": "390ab7935ee28ec6b286364bba9b4dd6410cb3d5",
+			"revisionTime": "2016-11-15T14:25:13Z",
+			"version": "v0.3",
+			"versionExact": "v0.3.0"
+		}
+	]
+}
+`),
+	map[string]licenseGetterResponse{
+		"github.com/go-logfmt/logfmt": {
+			err:     nil,
+			license: diligent.License{Identifier: "MIT"},
+		},
+	},
+	[]diligent.Dep{{
+		Name:      "github.com/go-logfmt/logfmt",
+		License:   diligent.License{Identifier: "MIT"},
+		Version:   "v0.3.0",
+		Ecosystem: "govendor",
 	}},
 	[]diligent.Warning{},
 	false,
@@ -132,11 +171,15 @@ var depTests = []struct {
 		},
 	},
 	[]diligent.Dep{{
-		Name:    "github.com/go-logfmt/logfmt",
-		License: diligent.License{Identifier: "MIT"},
+		Name:      "github.com/go-logfmt/logfmt",
+		License:   diligent.License{Identifier: "MIT"},
+		Version:   "390ab7935ee28ec6b286364bba9b4dd6410cb3d5",
+		Ecosystem: "govendor",
 	}, {
-		Name:    "github.com/go-stack/stack",
-		License: diligent.License{Identifier: "DOC"},
+		Name:      "github.com/go-stack/stack",
+		License:   diligent.License{Identifier: "DOC"},
+		Version:   "817915b46b97fd7bb80e8ab6b69f01a53ac3eebf",
+		Ecosystem: "govendor",
 	}},
 	[]diligent.Warning{},
 	false,
@@ -171,11 +214,13 @@ var depTests = []struct {
 		},
 	},
 	[]diligent.Dep{{
-		Name:    "github.com/go-logfmt/logfmt",
-		License: diligent.License{Identifier: "MIT"},
+		Name:      "github.com/go-logfmt/logfmt",
+		License:   diligent.License{Identifier: "MIT"},
+		Version:   "390ab7935ee28ec6b286364bba9b4dd6410cb3d5",
+		Ecosystem: "govendor",
 	}},
 	[]diligent.Warning{
-		warning.New("github.com/go-stack/stack", "error"),
+		warning.NewWithVersion("github.com/go-stack/stack", "817915b46b97fd7bb80e8ab6b69f01a53ac3eebf", "govendor", errors.New("error")),
 	},
 	false,
 }, {
@@ -210,8 +255,8 @@ var depTests = []struct {
 	},
 	[]diligent.Dep{},
 	[]diligent.Warning{
-		warning.New("github.com/go-logfmt/logfmt", "eeek"),
-		warning.New("github.com/go-stack/stack", "error"),
+		warning.NewWithVersion("github.com/go-logfmt/logfmt", "390ab7935ee28ec6b286364bba9b4dd6410cb3d5", "govendor", errors.New("eeek")),
+		warning.NewWithVersion("github.com/go-stack/stack", "817915b46b97fd7bb80e8ab6b69f01a53ac3eebf", "govendor", errors.New("error")),
 	},
 	false,
 }, {
@@ -246,3 +291,29 @@ func TestDependencies(t *testing.T) {
 		})
 	}
 }
+
+func TestManifestDependencies(t *testing.T) {
+	mockLG := newMockLicenseGetter(t, map[string]licenseGetterResponse{
+		"github.com/pkg/errors": {license: diligent.License{Identifier: "BSD-2-Clause"}},
+	})
+	target := govendor.New(mockLG).(diligent.ManifestDeper)
+	d, _, err := target.ManifestDependencies(filepath.Join("project", "vendor", "vendor.json"), []byte(`
+{
+	"package": [
+		{
+			"path": "github.com/pkg/errors",
+			"revision": "645ef00459ed84a119197bfb8d8205042c6df63d"
+		}
+	]
+}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d) != 1 || d[0].License.Identifier != "BSD-2-Clause" {
+		t.Errorf("expected the vendored dependency, got %v", d)
+	}
+	if want := filepath.Join("project", "vendor"); mockLG.vendorDir != want {
+		t.Errorf("expected vendor directory %s, got %s", want, mockLG.vendorDir)
+	}
+}
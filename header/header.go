@@ -0,0 +1,204 @@
+// Package header finds the licenses declared by SPDX-License-Identifier comments within source files.
+//
+// Source files commonly declare their license using a comment such as:
+//
+//	// SPDX-License-Identifier: Apache-2.0
+//
+// Headers are only recognised at the start of a comment, so that code which mentions a header, such as a string
+// literal, is not mistaken for one.
+//
+// Scanning every file of a dependency finds licenses which would otherwise go unnoticed, for example where a package
+// has no license file or where some of its files are covered by a different license to the package as a whole.
+package header
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/senseyeio/diligent"
+)
+
+// DefaultMaxLines is the number of lines, from the start of each file, which are searched for a header unless
+// configured otherwise
+const DefaultMaxLines = 30
+
+var (
+	// headerRegexp matches a header following the comment prefix which starts the line
+	headerRegexp = regexp.MustCompile(`^\s*(?://|#|/\*|\*|--|<!--)\s*SPDX-License-Identifier:\s*(.*)$`)
+	// commentEndRegexp matches the end of comments which may share a line with a header, such as "*/" or "-->"
+	commentEndRegexp = regexp.MustCompile(`\s*(\*/|-->|\*\)|#}|%>|\?>)\s*$`)
+)
+
+// skippedDirs are directories which hold other packages, or files unrelated to the package being scanned
+var skippedDirs = map[string]bool{
+	".git":         true,
+	".hg":          true,
+	".svn":         true,
+	"node_modules": true,
+	"testdata":     true,
+	"vendor":       true,
+}
+
+// File is the license declared by a single source file
+type File struct {
+	// Path of the file, relative to the scanned directory
+	Path string
+	// Expression is the SPDX license expression declared by the file's header
+	Expression string
+	// Err is set if the header does not hold a valid license expression
+	Err error
+}
+
+// Result describes the headers found within a directory
+type Result struct {
+	// Files holds each file with a header, ordered by path
+	Files []File
+}
+
+// Config allows the behaviour of a Scanner to be customised
+type Config struct {
+	// MaxLines is the number of lines, from the start of each file, searched for a header. DefaultMaxLines is used
+	// when zero.
+	MaxLines int
+}
+
+// Scanner finds SPDX-License-Identifier headers within source files
+type Scanner struct {
+	config Config
+}
+
+// New returns a Scanner using the default configuration
+func New() *Scanner {
+	return NewWithOptions(Config{})
+}
+
+// NewWithOptions returns a Scanner using the provided configuration
+func NewWithOptions(c Config) *Scanner {
+	if c.MaxLines <= 0 {
+		c.MaxLines = DefaultMaxLines
+	}
+	return &Scanner{c}
+}
+
+// Scan walks dir, recording the header of every file which has one. Directories containing other packages, such as
+// vendor and node_modules, are skipped.
+func (s *Scanner) Scan(dir string) (Result, error) {
+	files := make([]File, 0)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if path != dir && skippedDirs[info.Name()] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		expression, found, err := s.scanFile(path)
+		if err != nil || !found {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		f := File{Path: filepath.ToSlash(rel), Expression: expression}
+		if e, err := diligent.ParseExpression(expression); err != nil {
+			f.Err = err
+		} else {
+			f.Expression = e.String()
+		}
+		files = append(files, f)
+		return nil
+	})
+	if err != nil {
+		return Result{}, err
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+	return Result{files}, nil
+}
+
+// scanFile returns the expression declared by the file's header, if it has one
+func (s *Scanner) scanFile(path string) (string, bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", false, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for i := 0; i < s.config.MaxLines && scanner.Scan(); i++ {
+		m := headerRegexp.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+		return strings.TrimSpace(commentEndRegexp.ReplaceAllString(m[1], "")), true, nil
+	}
+	// long lines, as found in minified or binary files, are not expected to hold headers
+	if err := scanner.Err(); err != nil && err != bufio.ErrTooLong {
+		return "", false, err
+	}
+	return "", false, nil
+}
+
+// Expressions returns each distinct, valid expression found along with the number of files declaring it
+func (r Result) Expressions() map[string]int {
+	out := map[string]int{}
+	for _, f := range r.Files {
+		if f.Err == nil {
+			out[f.Expression]++
+		}
+	}
+	return out
+}
+
+// Invalid returns the files whose headers do not hold a valid license expression
+func (r Result) Invalid() []File {
+	out := make([]File, 0)
+	for _, f := range r.Files {
+		if f.Err != nil {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// Expression summarises the headers as a single SPDX license expression. The terms of every file apply to the
+// package, so distinct expressions are combined using AND. An empty string is returned if no valid headers were
+// found.
+func (r Result) Expression() string {
+	expressions := make([]string, 0)
+	for e := range r.Expressions() {
+		expressions = append(expressions, e)
+	}
+	sort.Strings(expressions)
+	return Combine(expressions...)
+}
+
+// Combine joins SPDX license expressions using AND, parenthesising compound expressions where necessary. Empty and
+// duplicate expressions are ignored.
+func Combine(expressions ...string) string {
+	parts := make([]string, 0, len(expressions))
+	found := map[string]bool{}
+	for _, e := range expressions {
+		if e == "" || found[e] {
+			continue
+		}
+		found[e] = true
+		parsed, err := diligent.ParseExpression(e)
+		if err == nil && parsed.Operator == diligent.Or {
+			e = "(" + e + ")"
+		}
+		parts = append(parts, e)
+	}
+	return strings.Join(parts, " AND ")
+}
@@ -0,0 +1,145 @@
+package header_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent/header"
+)
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "diligent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestScan(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"a.go":              "// SPDX-License-Identifier: MIT\n\npackage a\n",
+		"b.c":               "/* SPDX-License-Identifier: Apache-2.0 */\nint main() {}\n",
+		"sub/c.py":          "#!/usr/bin/env python\n# SPDX-License-Identifier: (MIT OR Apache-2.0)\n",
+		"sub/d.html":        "<!-- SPDX-License-Identifier: MIT -->\n<html></html>\n",
+		"no-header.go":      "package a\n",
+		"invalid.go":        "// SPDX-License-Identifier: SEE LICENSE\n",
+		"late-header.go":    strings.Repeat("\n", header.DefaultMaxLines) + "// SPDX-License-Identifier: GPL-3.0-only\n",
+		"vendor/v.go":       "// SPDX-License-Identifier: GPL-3.0-only\n",
+		"testdata/t.go":     "// SPDX-License-Identifier: GPL-3.0-only\n",
+		"node_modules/n.js": "// SPDX-License-Identifier: GPL-3.0-only\n",
+	})
+	defer os.RemoveAll(dir)
+
+	result, err := header.New().Scan(dir)
+	if err != nil {
+		t.Fatalf("did not expect an error, got %v", err)
+	}
+
+	paths := make([]string, len(result.Files))
+	for i, f := range result.Files {
+		paths[i] = f.Path
+	}
+	expPaths := []string{"a.go", "b.c", "invalid.go", "sub/c.py", "sub/d.html"}
+	if !reflect.DeepEqual(paths, expPaths) {
+		t.Errorf("expected files %v, got %v", expPaths, paths)
+	}
+	if invalid := result.Invalid(); len(invalid) != 1 || invalid[0].Path != "invalid.go" {
+		t.Errorf("expected invalid.go to be invalid, got %+v", invalid)
+	}
+	expCounts := map[string]int{"MIT": 2, "Apache-2.0": 1, "MIT OR Apache-2.0": 1}
+	if !reflect.DeepEqual(result.Expressions(), expCounts) {
+		t.Errorf("expected %v, got %v", expCounts, result.Expressions())
+	}
+	if out := result.Expression(); out != "Apache-2.0 AND MIT AND (MIT OR Apache-2.0)" {
+		t.Errorf("unexpected expression %s", out)
+	}
+}
+
+func TestScanComments(t *testing.T) {
+	cases := []struct {
+		d       string
+		content string
+		found   bool
+	}{
+		{"line comment", "// SPDX-License-Identifier: MIT\n", true},
+		{"hash comment", "# SPDX-License-Identifier: MIT\n", true},
+		{"block comment", "/* SPDX-License-Identifier: MIT */\n", true},
+		{"block comment continuation", "/*\n * SPDX-License-Identifier: MIT\n */\n", true},
+		{"indented dash comment", "  -- SPDX-License-Identifier: MIT\n", true},
+		{"markup comment", "<!-- SPDX-License-Identifier: MIT -->\n", true},
+		{"string literal", "var header = \"// SPDX-License-Identifier: MIT\"\n", false},
+		{"regular expression", "var r = regexp.MustCompile(`SPDX-License-Identifier:\\s*(.*)$`)\n", false},
+		{"trailing comment", "writeHeader() // SPDX-License-Identifier: MIT\n", false},
+		{"no comment", "SPDX-License-Identifier: MIT\n", false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.d, func(t *testing.T) {
+			dir := writeFiles(t, map[string]string{"file": tt.content})
+			defer os.RemoveAll(dir)
+
+			result, err := header.New().Scan(dir)
+			if err != nil {
+				t.Fatalf("did not expect an error, got %v", err)
+			}
+			if found := len(result.Files) == 1 && result.Files[0].Expression == "MIT"; found != tt.found {
+				t.Errorf("expected found to be %v, got %+v", tt.found, result.Files)
+			}
+		})
+	}
+}
+
+func TestScanMaxLines(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"late-header.go": "\n\n\n// SPDX-License-Identifier: MIT\n",
+	})
+	defer os.RemoveAll(dir)
+
+	result, err := header.NewWithOptions(header.Config{MaxLines: 3}).Scan(dir)
+	if err != nil {
+		t.Fatalf("did not expect an error, got %v", err)
+	}
+	if len(result.Files) != 0 {
+		t.Errorf("expected no files, got %+v", result.Files)
+	}
+}
+
+func TestScanInvalidDir(t *testing.T) {
+	if _, err := header.New().Scan("does/not/exist"); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestCombine(t *testing.T) {
+	cases := []struct {
+		in  []string
+		out string
+	}{
+		{[]string{"MIT"}, "MIT"},
+		{[]string{"MIT", "", "MIT"}, "MIT"},
+		{[]string{"MIT", "ISC"}, "MIT AND ISC"},
+		{[]string{"MIT OR Apache-2.0", "ISC"}, "(MIT OR Apache-2.0) AND ISC"},
+		{[]string{}, ""},
+	}
+
+	for _, c := range cases {
+		t.Run(strings.Join(c.in, ","), func(t *testing.T) {
+			if out := header.Combine(c.in...); out != c.out {
+				t.Errorf("expected %s, got %s", c.out, out)
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+// Package html outputs diligent reports as a single, static HTML page intended for legal review.
+//
+// The page summarises the dependencies by license category, highlights any violations, exceptions and warnings and
+// lists every dependency in a table which can be sorted and filtered. All styles and scripts are held within the page,
+// so it can be archived and viewed without network access.
+package html
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/senseyeio/diligent"
+)
+
+// unknownCategory is used for dependencies whose licenses have no category
+const unknownCategory = "unknown"
+
+type page struct {
+	ToolVersion string
+	Timestamp   string
+	Manifests   []string
+	Categories  []category
+	Deps        []dependency
+	Violations  []diligent.Violation
+	Exceptions  []diligent.Exception
+	Warnings    []warning
+}
+
+type category struct {
+	Name    string
+	Count   int
+	Percent string
+}
+
+type dependency struct {
+	diligent.Dep
+	Categories string
+	Licenses   []diligent.License
+	Violation  bool
+}
+
+type warning struct {
+	Dependency string
+	Ecosystem  string
+	Reason     string
+	Message    string
+}
+
+type htmlReporter struct{}
+
+// NewReporter returns a Reporter which outputs the report as a self-contained HTML page
+func NewReporter() diligent.Reporter {
+	return &htmlReporter{}
+}
+
+// Report outputs the report as an HTML page
+func (h *htmlReporter) Report(w io.Writer, r diligent.Report) error {
+	return pageTemplate.Execute(w, newPage(r))
+}
+
+func newPage(r diligent.Report) page {
+	p := page{
+		ToolVersion: r.ToolVersion,
+		Timestamp:   r.Timestamp.UTC().Format(time.RFC3339),
+		Manifests:   r.Manifests,
+		Deps:        make([]dependency, len(r.Deps)),
+		Violations:  r.Violations,
+		Exceptions:  r.Exceptions,
+		Warnings:    make([]warning, len(r.Warnings)),
+	}
+	violations := map[string]bool{}
+	for _, v := range r.Violations {
+		violations[v.Dep.Name+"@"+v.Dep.Version] = true
+	}
+	counts := map[string]int{}
+	for i, d := range r.Deps {
+		cc := categories(d)
+		for _, c := range cc {
+			counts[c]++
+		}
+		p.Deps[i] = dependency{
+			Dep:        d,
+			Categories: strings.Join(cc, ", "),
+			Licenses:   d.Licenses(),
+			Violation:  violations[d.Name+"@"+d.Version],
+		}
+	}
+	for name, count := range counts {
+		p.Categories = append(p.Categories, category{
+			Name:    name,
+			Count:   count,
+			Percent: fmt.Sprintf("%.1f", 100*float64(count)/float64(len(r.Deps))),
+		})
+	}
+	sort.Slice(p.Categories, func(i, j int) bool {
+		if p.Categories[i].Count == p.Categories[j].Count {
+			return p.Categories[i].Name < p.Categories[j].Name
+		}
+		return p.Categories[i].Count > p.Categories[j].Count
+	})
+	for i, wa := range r.Warnings {
+		p.Warnings[i] = warning{
+			Dependency: wa.Dependency(),
+			Ecosystem:  wa.Ecosystem(),
+			Reason:     string(wa.Reason()),
+			Message:    wa.Err().Error(),
+		}
+	}
+	return p
+}
+
+// categories returns the distinct categories of the licenses of the dependency
+func categories(d diligent.Dep) []string {
+	seen := map[string]bool{}
+	out := make([]string, 0)
+	for _, l := range d.Licenses() {
+		c := string(l.Category)
+		if c == "" {
+			c = unknownCategory
+		}
+		if !seen[c] {
+			seen[c] = true
+			out = append(out, c)
+		}
+	}
+	if len(out) == 0 {
+		out = append(out, unknownCategory)
+	}
+	sort.Strings(out)
+	return out
+}
+
+var pageTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Dependency License Report</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #24292e; }
+table { border-collapse: collapse; width: 100%; }
+th, td { border-bottom: 1px solid #e1e4e8; padding: 0.4em; text-align: left; vertical-align: top; }
+th { cursor: pointer; background: #f6f8fa; user-select: none; }
+th.asc::after { content: " \25B2"; }
+th.desc::after { content: " \25BC"; }
+tr.violation { background: #ffeef0; }
+.chart { max-width: 40em; }
+.bar { display: flex; align-items: center; margin: 0.2em 0; }
+.bar .label { width: 10em; }
+.bar .fill { background: #0366d6; height: 1em; margin-right: 0.5em; }
+.problems { border-left: 4px solid #d73a49; background: #fffbfb; padding: 0.5em 1em; margin: 1em 0; }
+.problems.warnings { border-color: #dbab09; background: #fffdf0; }
+.problems.exceptions { border-color: #0366d6; background: #f1f8ff; }
+#filter { margin: 1em 0; padding: 0.4em; width: 20em; }
+dl { margin: 0.5em 0; }
+dt { font-weight: bold; }
+dd { margin: 0 0 0.3em 1em; }
+</style>
+</head>
+<body>
+<h1>Dependency License Report</h1>
+<p>Generated by diligent {{.ToolVersion}} at {{.Timestamp}} from {{len .Manifests}} manifest(s){{range $i, $m := .Manifests}}{{if $i}},{{else}}:{{end}} <code>{{$m}}</code>{{end}}</p>
+
+<h2>Summary</h2>
+<p>{{len .Deps}} dependencies, {{len .Violations}} violation(s), {{len .Exceptions}} exception(s), {{len .Warnings}} warning(s)</p>
+<div class="chart">
+{{- range .Categories}}
+<div class="bar"><span class="label">{{.Name}}</span><span class="fill" style="width: {{.Percent}}%"></span><span>{{.Count}}</span></div>
+{{- end}}
+</div>
+{{- if .Violations}}
+
+<section class="problems violations">
+<h2>Violations</h2>
+<ul>
+{{- range .Violations}}
+<li><strong>{{.Dep.Name}}</strong> {{.Dep.Version}} ({{.Dep.LicenseExpression}}): {{.Message}}</li>
+{{- end}}
+</ul>
+</section>
+{{- end}}
+{{- if .Exceptions}}
+
+<section class="problems exceptions">
+<h2>Exceptions</h2>
+<ul>
+{{- range .Exceptions}}
+<li><strong>{{.Package}}</strong> ({{.License}}) permitted until {{.Expires}}, approved by {{.Approver}}: {{.Ticket}}{{if not .Applied}} (not used){{end}}</li>
+{{- end}}
+</ul>
+</section>
+{{- end}}
+{{- if .Warnings}}
+
+<section class="problems warnings">
+<h2>Warnings</h2>
+<ul>
+{{- range .Warnings}}
+<li><strong>{{.Dependency}}</strong> ({{.Ecosystem}}) [{{.Reason}}]: {{.Message}}</li>
+{{- end}}
+</ul>
+</section>
+{{- end}}
+
+<h2>Dependencies</h2>
+<input id="filter" type="search" placeholder="Filter dependencies">
+<table id="dependencies">
+<thead>
+<tr><th>Name</th><th>Version</th><th>License</th><th>Category</th><th>Ecosystem</th><th>Relationship</th><th>Manifest</th></tr>
+</thead>
+<tbody>
+{{- range .Deps}}
+<tr{{if .Violation}} class="violation"{{end}}>
+<td>{{.Name}}</td>
+<td>{{.Version}}</td>
+<td><details><summary>{{.LicenseExpression}}</summary>
+{{- range .Licenses}}
+<dl>
+<dt>{{.Name}}</dt>
+<dd>Owner: {{if .OwnerURL}}<a href="{{.OwnerURL}}">{{.Owner}}</a>{{else}}{{.Owner}}{{end}}</dd>
+<dd>URL: {{if .URL}}<a href="{{.URL}}">{{.URL}}</a>{{end}}</dd>
+<dd>Type: {{.Type}}</dd>
+</dl>
+{{- end}}
+</details></td>
+<td>{{.Categories}}</td>
+<td>{{.Ecosystem}}</td>
+<td>{{.Relationship}}</td>
+<td>{{.Manifest}}</td>
+</tr>
+{{- end}}
+</tbody>
+</table>
+
+<script>
+(function() {
+  var table = document.getElementById("dependencies");
+  var body = table.tBodies[0];
+  // the license column sorts by its summary rather than the license details
+  function text(cell) {
+    return (cell.querySelector("summary") || cell).textContent.trim();
+  }
+  document.getElementById("filter").addEventListener("input", function(e) {
+    var query = e.target.value.toLowerCase();
+    Array.prototype.forEach.call(body.rows, function(row) {
+      row.style.display = row.textContent.toLowerCase().indexOf(query) === -1 ? "none" : "";
+    });
+  });
+  Array.prototype.forEach.call(table.tHead.rows[0].cells, function(th, col) {
+    th.addEventListener("click", function() {
+      var asc = !th.classList.contains("asc");
+      Array.prototype.forEach.call(table.tHead.rows[0].cells, function(c) { c.classList.remove("asc", "desc"); });
+      th.classList.add(asc ? "asc" : "desc");
+      var rows = Array.prototype.slice.call(body.rows);
+      rows.sort(function(a, b) {
+        var x = text(a.cells[col]), y = text(b.cells[col]);
+        return (asc ? 1 : -1) * x.localeCompare(y, undefined, {numeric: true});
+      });
+      rows.forEach(function(row) { body.appendChild(row); });
+    });
+  });
+})();
+</script>
+</body>
+</html>
+`))
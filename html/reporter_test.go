@@ -0,0 +1,47 @@
+package html_test
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent/html"
+	"github.com/senseyeio/diligent/internal/reporttest"
+)
+
+var externalAssetRegexp = regexp.MustCompile(`(?i)<(script|link|img|iframe)[^>]+(src|href)=`)
+
+func TestReport(t *testing.T) {
+	var b bytes.Buffer
+	if err := html.NewReporter().Report(&b, reporttest.Report(t)); err != nil {
+		t.Fatal(err)
+	}
+	out := b.String()
+
+	cases := []struct {
+		d        string
+		expected string
+	}{
+		{"run metadata", "Generated by diligent 1.2.3 at 2018-05-01T12:00:00Z"},
+		{"permissive count", `<span class="label">permissive</span><span class="fill" style="width: 80.0%"></span><span>4</span>`},
+		{"copyleft count", `<span class="label">copyleft</span><span class="fill" style="width: 40.0%"></span><span>2</span>`},
+		{"violations", "<li><strong>&lt;cypress&gt;</strong> 2.1.0 (MIT OR GPL-3.0): not permitted</li>"},
+		{"exceptions", "<li><strong>readline</strong> (GPL-3.0) permitted until 2018-06-30, approved by legal@example.com: LEGAL-42</li>"},
+		{"warnings", "<li><strong>left-pad</strong> (npm) [not-found]: requested failed with status 404</li>"},
+		{"violating dependencies are highlighted", "<tr class=\"violation\">\n<td>&lt;cypress&gt;</td>"},
+		{"license details", "<dd>Owner: <a href=\"http://web.mit.edu/aboutmit/\">MIT</a></dd>"},
+		{"filter", `<input id="filter"`},
+	}
+	for _, tt := range cases {
+		t.Run(tt.d, func(t *testing.T) {
+			if !strings.Contains(out, tt.expected) {
+				t.Errorf("expected output to contain %q, got %s", tt.expected, out)
+			}
+		})
+	}
+
+	if externalAssetRegexp.MatchString(out) {
+		t.Errorf("expected no external assets, got %s", externalAssetRegexp.FindString(out))
+	}
+}
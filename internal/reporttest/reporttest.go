@@ -0,0 +1,51 @@
+// Package reporttest provides the report shared by the tests of each Reporter
+package reporttest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/warning"
+)
+
+// Report returns a report of two manifests, package.json and Gopkg.lock, with a warning for left-pad, an npm dependency
+// which could not be found, and the dependencies:
+//   - d3 5.0.0 (MIT), a direct npm dependency whose license was found in the registry
+//   - <cypress> 2.1.0 (MIT OR GPL-3.0), a direct npm dependency which is not permitted
+//   - readline 1.3.0 (GPL-3.0), a direct npm dependency permitted by exception LEGAL-42 until 2018-06-30
+//   - github.com/pkg/errors v0.8.0 (BSD-2-Clause OR MIT), a direct dep dependency
+//   - github.com/pelletier/go-toml v1.1.0 (MIT), a transitive dep dependency
+func Report(t *testing.T) diligent.Report {
+	d3 := newDep(t, "d3", "MIT", "5.0.0", "npm", "package.json", diligent.Direct)
+	d3.Provenance = []diligent.Provenance{{Source: diligent.Registry, License: "MIT", Location: "https://registry.npmjs.org/d3", Snippet: `"license": "MIT"`, Confidence: 1}}
+	cypress := newDep(t, "<cypress>", "MIT OR GPL-3.0", "2.1.0", "npm", "package.json", diligent.Direct)
+	readline := newDep(t, "readline", "GPL-3.0", "1.3.0", "npm", "package.json", diligent.Direct)
+	errs := newDep(t, "github.com/pkg/errors", "BSD-2-Clause OR MIT", "v0.8.0", "dep", "Gopkg.lock", diligent.Direct)
+	toml := newDep(t, "github.com/pelletier/go-toml", "MIT", "v1.1.0", "dep", "Gopkg.lock", diligent.Transitive)
+
+	return diligent.Report{
+		Deps: []diligent.Dep{d3, cypress, readline, errs, toml},
+		Warnings: []diligent.Warning{
+			warning.New("left-pad", "npm", diligent.WithReason(diligent.NotFound, errors.New("requested failed with status 404"))),
+		},
+		Violations:  []diligent.Violation{{Dep: cypress, Message: "not permitted"}},
+		Exceptions:  []diligent.Exception{{Package: "readline", License: "GPL-3.0", Approver: "legal@example.com", Ticket: "LEGAL-42", Expires: "2018-06-30", Applied: true}},
+		Manifests:   []string{"Gopkg.lock", "package.json"},
+		ToolVersion: "1.2.3",
+		Timestamp:   time.Date(2018, 5, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func newDep(t *testing.T, name, expression, version, ecosystem, manifest string, relationship diligent.Relationship) diligent.Dep {
+	d, err := diligent.NewDep(name, expression)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Version = version
+	d.Ecosystem = ecosystem
+	d.Manifest = manifest
+	d.Relationship = relationship
+	return d
+}
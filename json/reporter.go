@@ -0,0 +1,194 @@
+// Package json outputs diligent reports as JSON documents.
+//
+// The structure of the document is described by the JSON Schema held in schema.json. The schema is versioned using
+// SchemaVersion, which is included in every document. Fields may be added without changing the major version, whereas
+// removing or changing the meaning of a field increments it.
+package json
+
+import (
+	encJSON "encoding/json"
+	"io"
+	"time"
+
+	"github.com/senseyeio/diligent"
+)
+
+// SchemaVersion is the version of the schema to which output documents conform
+const SchemaVersion = "1.4"
+
+type document struct {
+	SchemaVersion string       `json:"schemaVersion"`
+	ToolVersion   string       `json:"toolVersion"`
+	Timestamp     time.Time    `json:"timestamp"`
+	Distribution  string       `json:"distribution,omitempty"`
+	Manifests     []string     `json:"manifests"`
+	Dependencies  []dependency `json:"dependencies"`
+	Warnings      []warning    `json:"warnings"`
+	Violations    []violation  `json:"violations"`
+	Exceptions    []exception  `json:"exceptions"`
+}
+
+type dependency struct {
+	Name         string       `json:"name"`
+	Version      string       `json:"version"`
+	Ecosystem    string       `json:"ecosystem"`
+	Manifest     string       `json:"manifest"`
+	Relationship string       `json:"relationship"`
+	Scope        string       `json:"scope,omitempty"`
+	License      string       `json:"license"`
+	Licenses     []license    `json:"licenses"`
+	Provenance   []provenance `json:"provenance"`
+	Obligations  []obligation `json:"obligations,omitempty"`
+}
+
+type license struct {
+	Identifier    string `json:"identifier"`
+	Name          string `json:"name"`
+	ShortName     string `json:"shortName"`
+	Category      string `json:"category"`
+	Type          string `json:"type"`
+	Owner         string `json:"owner"`
+	OwnerURL      string `json:"ownerUrl"`
+	OwnerType     string `json:"ownerType"`
+	URL           string `json:"url"`
+	IsOSIApproved bool   `json:"isOsiApproved"`
+	IsFSFLibre    bool   `json:"isFsfLibre"`
+	IsDeprecated  bool   `json:"isDeprecated"`
+}
+
+type obligation struct {
+	License     string `json:"license"`
+	Description string `json:"description"`
+}
+
+type provenance struct {
+	Source     string  `json:"source"`
+	License    string  `json:"license"`
+	Location   string  `json:"location"`
+	Snippet    string  `json:"snippet"`
+	Confidence float64 `json:"confidence"`
+}
+
+type warning struct {
+	Dependency string `json:"dependency"`
+	Ecosystem  string `json:"ecosystem"`
+	Reason     string `json:"reason"`
+	Message    string `json:"message"`
+}
+
+type violation struct {
+	Dependency string `json:"dependency"`
+	Version    string `json:"version"`
+	License    string `json:"license"`
+	Message    string `json:"message"`
+}
+
+type exception struct {
+	Dependency string `json:"dependency"`
+	License    string `json:"license"`
+	Approver   string `json:"approver"`
+	Ticket     string `json:"ticket"`
+	Expires    string `json:"expires"`
+	Applied    bool   `json:"applied"`
+}
+
+type jsonReporter struct{}
+
+// NewReporter returns a Reporter which outputs the report as a JSON document
+func NewReporter() diligent.Reporter {
+	return &jsonReporter{}
+}
+
+// Report outputs the report as an indented JSON document
+func (j *jsonReporter) Report(w io.Writer, r diligent.Report) error {
+	doc := document{
+		SchemaVersion: SchemaVersion,
+		ToolVersion:   r.ToolVersion,
+		Timestamp:     r.Timestamp,
+		Distribution:  r.Distribution,
+		Manifests:     r.Manifests,
+		Dependencies:  make([]dependency, len(r.Deps)),
+		Warnings:      make([]warning, len(r.Warnings)),
+		Violations:    make([]violation, len(r.Violations)),
+		Exceptions:    make([]exception, len(r.Exceptions)),
+	}
+	if doc.Manifests == nil {
+		doc.Manifests = []string{}
+	}
+	for i, d := range r.Deps {
+		doc.Dependencies[i] = toDependency(d)
+	}
+	for i, wa := range r.Warnings {
+		doc.Warnings[i] = warning{
+			Dependency: wa.Dependency(),
+			Ecosystem:  wa.Ecosystem(),
+			Reason:     string(wa.Reason()),
+			Message:    wa.Err().Error(),
+		}
+	}
+	for i, v := range r.Violations {
+		doc.Violations[i] = violation{
+			Dependency: v.Dep.Name,
+			Version:    v.Dep.Version,
+			License:    v.Dep.LicenseExpression(),
+			Message:    v.Message,
+		}
+	}
+	for i, e := range r.Exceptions {
+		doc.Exceptions[i] = exception{
+			Dependency: e.Package,
+			License:    e.License,
+			Approver:   e.Approver,
+			Ticket:     e.Ticket,
+			Expires:    e.Expires,
+			Applied:    e.Applied,
+		}
+	}
+
+	enc := encJSON.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+func toDependency(d diligent.Dep) dependency {
+	out := dependency{
+		Name:         d.Name,
+		Version:      d.Version,
+		Ecosystem:    d.Ecosystem,
+		Manifest:     d.Manifest,
+		Relationship: string(d.Relationship),
+		Scope:        string(d.Scope),
+		License:      d.LicenseExpression(),
+		Licenses:     make([]license, 0),
+		Provenance:   make([]provenance, len(d.Provenance)),
+	}
+	for _, l := range d.Licenses() {
+		out.Licenses = append(out.Licenses, license{
+			Identifier:    l.Identifier,
+			Name:          l.Name,
+			ShortName:     l.ShortName,
+			Category:      string(l.Category),
+			Type:          string(l.Type),
+			Owner:         l.Owner,
+			OwnerURL:      l.OwnerURL,
+			OwnerType:     string(l.OwnerType),
+			URL:           l.URL,
+			IsOSIApproved: l.IsOSIApproved,
+			IsFSFLibre:    l.IsFSFLibre,
+			IsDeprecated:  l.IsDeprecated,
+		})
+	}
+	for i, p := range d.Provenance {
+		out.Provenance[i] = provenance{
+			Source:     string(p.Source),
+			License:    p.License,
+			Location:   p.Location,
+			Snippet:    p.Snippet,
+			Confidence: p.Confidence,
+		}
+	}
+	for _, o := range d.DistributionObligations {
+		out.Obligations = append(out.Obligations, obligation{License: o.License, Description: o.Description})
+	}
+	return out
+}
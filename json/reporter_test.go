@@ -0,0 +1,209 @@
+package json_test
+
+import (
+	"bytes"
+	encJSON "encoding/json"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/internal/reporttest"
+	"github.com/senseyeio/diligent/json"
+)
+
+func TestReport(t *testing.T) {
+	var buf bytes.Buffer
+	if err := json.NewReporter().Report(&buf, reporttest.Report(t)); err != nil {
+		t.Fatalf("did not expect an error, got %v", err)
+	}
+
+	var out struct {
+		SchemaVersion string    `json:"schemaVersion"`
+		ToolVersion   string    `json:"toolVersion"`
+		Timestamp     time.Time `json:"timestamp"`
+		Dependencies  []struct {
+			Name     string `json:"name"`
+			License  string `json:"license"`
+			Licenses []struct {
+				Identifier string `json:"identifier"`
+				Category   string `json:"category"`
+				Owner      string `json:"owner"`
+			} `json:"licenses"`
+		} `json:"dependencies"`
+		Warnings []struct {
+			Dependency string `json:"dependency"`
+			Reason     string `json:"reason"`
+			Message    string `json:"message"`
+		} `json:"warnings"`
+		Violations []struct {
+			Dependency string `json:"dependency"`
+			License    string `json:"license"`
+		} `json:"violations"`
+		Exceptions []struct {
+			Dependency string `json:"dependency"`
+			Ticket     string `json:"ticket"`
+			Expires    string `json:"expires"`
+			Applied    bool   `json:"applied"`
+		} `json:"exceptions"`
+	}
+	if err := encJSON.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatalf("expected valid JSON, got %v", err)
+	}
+	if out.SchemaVersion != json.SchemaVersion || out.ToolVersion != "1.2.3" || !out.Timestamp.Equal(time.Date(2018, 5, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected metadata %s %s %s", out.SchemaVersion, out.ToolVersion, out.Timestamp)
+	}
+	if len(out.Dependencies) != 5 {
+		t.Fatalf("expected 5 dependencies, got %d", len(out.Dependencies))
+	}
+	d3 := out.Dependencies[0]
+	if d3.Name != "d3" || d3.License != "MIT" || len(d3.Licenses) != 1 || d3.Licenses[0].Category != "permissive" || d3.Licenses[0].Owner != "MIT" {
+		t.Errorf("unexpected dependency %+v", d3)
+	}
+	if cypress := out.Dependencies[1]; cypress.License != "MIT OR GPL-3.0" || len(cypress.Licenses) != 2 {
+		t.Errorf("unexpected dependency %+v", cypress)
+	}
+	if len(out.Warnings) != 1 || out.Warnings[0].Dependency != "left-pad" || out.Warnings[0].Reason != "not-found" || out.Warnings[0].Message != "requested failed with status 404" {
+		t.Errorf("unexpected warnings %+v", out.Warnings)
+	}
+	if len(out.Violations) != 1 || out.Violations[0].Dependency != "<cypress>" || out.Violations[0].License != "MIT OR GPL-3.0" {
+		t.Errorf("unexpected violations %+v", out.Violations)
+	}
+	if len(out.Exceptions) != 1 || out.Exceptions[0].Dependency != "readline" || out.Exceptions[0].Ticket != "LEGAL-42" || out.Exceptions[0].Expires != "2018-06-30" || !out.Exceptions[0].Applied {
+		t.Errorf("unexpected exceptions %+v", out.Exceptions)
+	}
+}
+
+// TestSchemaRequiredFields ensures the documented schema and the output stay in step
+func TestSchemaRequiredFields(t *testing.T) {
+	schemaBytes, err := ioutil.ReadFile("schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	type object struct {
+		Required []string `json:"required"`
+	}
+	var schema struct {
+		object
+		Definitions map[string]object `json:"definitions"`
+	}
+	if err := encJSON.Unmarshal(schemaBytes, &schema); err != nil {
+		t.Fatalf("expected a valid schema, got %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewReporter().Report(&buf, reporttest.Report(t)); err != nil {
+		t.Fatal(err)
+	}
+	var doc map[string]interface{}
+	if err := encJSON.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	assertRequired := func(name string, o object, value interface{}) {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			t.Fatalf("expected %s to be an object", name)
+		}
+		for _, r := range o.Required {
+			if _, ok := m[r]; !ok {
+				t.Errorf("%s is missing required field %s", name, r)
+			}
+		}
+		if len(m) != len(o.Required) {
+			t.Errorf("%s has %d fields, the schema requires %d", name, len(m), len(o.Required))
+		}
+	}
+	first := func(key string, v interface{}) interface{} {
+		return v.(map[string]interface{})[key].([]interface{})[0]
+	}
+	dependency := first("dependencies", doc)
+	assertRequired("document", schema.object, doc)
+	assertRequired("dependency", schema.Definitions["dependency"], dependency)
+	assertRequired("license", schema.Definitions["license"], first("licenses", dependency))
+	assertRequired("provenance", schema.Definitions["provenance"], first("provenance", dependency))
+	assertRequired("warning", schema.Definitions["warning"], first("warnings", doc))
+	assertRequired("violation", schema.Definitions["violation"], first("violations", doc))
+	assertRequired("exception", schema.Definitions["exception"], first("exceptions", doc))
+}
+
+func TestObligations(t *testing.T) {
+	cases := []struct {
+		d            string
+		distribution string
+		obligations  []diligent.LicenseObligation
+		present      bool
+	}{
+		{"obligations under the distribution model", "saas", []diligent.LicenseObligation{{License: "MIT", Description: "retain the copyright and license notices"}}, true},
+		{"obligations are omitted without a distribution model", "", nil, false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.d, func(t *testing.T) {
+			r := reporttest.Report(t)
+			r.Distribution = tt.distribution
+			r.Deps[0].DistributionObligations = tt.obligations
+			var buf bytes.Buffer
+			if err := json.NewReporter().Report(&buf, r); err != nil {
+				t.Fatal(err)
+			}
+			var doc struct {
+				Distribution *string `json:"distribution"`
+				Dependencies []struct {
+					Obligations []struct {
+						License     string `json:"license"`
+						Description string `json:"description"`
+					} `json:"obligations"`
+				} `json:"dependencies"`
+			}
+			if err := encJSON.Unmarshal(buf.Bytes(), &doc); err != nil {
+				t.Fatal(err)
+			}
+			if (doc.Distribution != nil) != tt.present || (doc.Distribution != nil && *doc.Distribution != tt.distribution) {
+				t.Errorf("expected distribution %q, got %v", tt.distribution, doc.Distribution)
+			}
+			oo := doc.Dependencies[0].Obligations
+			if len(oo) != len(tt.obligations) {
+				t.Fatalf("expected %d obligations, got %+v", len(tt.obligations), oo)
+			}
+			for i, o := range tt.obligations {
+				if oo[i].License != o.License || oo[i].Description != o.Description {
+					t.Errorf("expected obligation %+v, got %+v", o, oo[i])
+				}
+			}
+		})
+	}
+}
+
+func TestScope(t *testing.T) {
+	cases := []struct {
+		d       string
+		scope   diligent.Scope
+		present bool
+	}{
+		{"development dependencies", diligent.Development, true},
+		{"runtime dependencies", diligent.Runtime, true},
+		{"scope is omitted when unknown", "", false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.d, func(t *testing.T) {
+			r := reporttest.Report(t)
+			r.Deps[0].Scope = tt.scope
+			var buf bytes.Buffer
+			if err := json.NewReporter().Report(&buf, r); err != nil {
+				t.Fatal(err)
+			}
+			var doc struct {
+				Dependencies []map[string]interface{} `json:"dependencies"`
+			}
+			if err := encJSON.Unmarshal(buf.Bytes(), &doc); err != nil {
+				t.Fatal(err)
+			}
+			scope, ok := doc.Dependencies[0]["scope"]
+			if ok != tt.present || (ok && scope != string(tt.scope)) {
+				t.Errorf("expected scope %q, got %v", tt.scope, scope)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+// Package junit outputs diligent reports as JUnit XML test results, allowing CI systems to display license checks
+// alongside their tests.
+//
+// Each manifest is a test suite containing a test case per dependency. Dependencies whose licenses are not permitted
+// fail, those permitted by an exception pass with the exception recorded in their output, and dependencies whose
+// licenses could not be determined are skipped and collected in a suite of their own.
+package junit
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/senseyeio/diligent"
+)
+
+const (
+	suitesName = "diligent"
+	// unresolvedSuite holds the dependencies whose licenses could not be determined, which are not associated with a
+	// manifest
+	unresolvedSuite = "unresolved"
+	// defaultSuite holds the dependencies which are not associated with a manifest
+	defaultSuite = "dependencies"
+)
+
+type testSuites struct {
+	XMLName  xml.Name    `xml:"testsuites"`
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Skipped  int         `xml:"skipped,attr"`
+	Suites   []testSuite `xml:"testsuite"`
+}
+
+type testSuite struct {
+	Name      string     `xml:"name,attr"`
+	Tests     int        `xml:"tests,attr"`
+	Failures  int        `xml:"failures,attr"`
+	Errors    int        `xml:"errors,attr"`
+	Skipped   int        `xml:"skipped,attr"`
+	Timestamp string     `xml:"timestamp,attr"`
+	Cases     []testCase `xml:"testcase"`
+}
+
+type testCase struct {
+	Name      string   `xml:"name,attr"`
+	ClassName string   `xml:"classname,attr"`
+	Failure   *failure `xml:"failure,omitempty"`
+	Skipped   *skipped `xml:"skipped,omitempty"`
+	SystemOut string   `xml:"system-out,omitempty"`
+}
+
+type failure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+type skipped struct {
+	Message string `xml:"message,attr"`
+}
+
+type junitReporter struct{}
+
+// NewReporter returns a Reporter which outputs the report as JUnit XML
+func NewReporter() diligent.Reporter {
+	return &junitReporter{}
+}
+
+// Report outputs the report as JUnit XML
+func (j *junitReporter) Report(w io.Writer, r diligent.Report) error {
+	violations := map[string]diligent.Violation{}
+	for _, v := range r.Violations {
+		violations[v.Dep.Name+"@"+v.Dep.Version] = v
+	}
+	timestamp := r.Timestamp.UTC().Format(time.RFC3339)
+
+	suites := map[string]*testSuite{}
+	names := make([]string, 0)
+	suite := func(name string) *testSuite {
+		s, ok := suites[name]
+		if !ok {
+			s = &testSuite{Name: name, Timestamp: timestamp}
+			suites[name] = s
+			names = append(names, name)
+		}
+		return s
+	}
+
+	for _, d := range r.Deps {
+		name := d.Manifest
+		if name == "" {
+			name = defaultSuite
+		}
+		s := suite(name)
+		c := testCase{Name: fmt.Sprintf("%s@%s", d.Name, d.Version), ClassName: d.Ecosystem}
+		if v, ok := violations[d.Name+"@"+d.Version]; ok {
+			c.Failure = &failure{
+				Message: v.Message,
+				Type:    "license-not-permitted",
+				Text:    fmt.Sprintf("%s %s is licensed under %s", d.Name, d.Version, d.LicenseExpression()),
+			}
+			s.Failures++
+		}
+		if e, ok := r.Exception(d); ok {
+			c.SystemOut = fmt.Sprintf("%s is permitted by exception %s, approved by %s, until %s", d.LicenseExpression(), e.Ticket, e.Approver, e.Expires)
+		}
+		s.Tests++
+		s.Cases = append(s.Cases, c)
+	}
+	for _, wa := range r.Warnings {
+		s := suite(unresolvedSuite)
+		s.Tests++
+		s.Skipped++
+		s.Cases = append(s.Cases, testCase{
+			Name:      wa.Dependency(),
+			ClassName: wa.Ecosystem(),
+			Skipped:   &skipped{Message: fmt.Sprintf("[%s] %s", wa.Reason(), wa.Err())},
+		})
+	}
+
+	sort.Strings(names)
+	doc := testSuites{Name: suitesName}
+	for _, n := range names {
+		s := suites[n]
+		doc.Tests += s.Tests
+		doc.Failures += s.Failures
+		doc.Skipped += s.Skipped
+		doc.Suites = append(doc.Suites, *s)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
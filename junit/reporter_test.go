@@ -0,0 +1,89 @@
+package junit_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+
+	"github.com/senseyeio/diligent/internal/reporttest"
+	"github.com/senseyeio/diligent/junit"
+)
+
+type testSuites struct {
+	Tests    int `xml:"tests,attr"`
+	Failures int `xml:"failures,attr"`
+	Skipped  int `xml:"skipped,attr"`
+	Suites   []struct {
+		Name  string `xml:"name,attr"`
+		Tests int    `xml:"tests,attr"`
+		Cases []struct {
+			Name      string `xml:"name,attr"`
+			ClassName string `xml:"classname,attr"`
+			Failure   *struct {
+				Message string `xml:"message,attr"`
+			} `xml:"failure"`
+			Skipped *struct {
+				Message string `xml:"message,attr"`
+			} `xml:"skipped"`
+			SystemOut string `xml:"system-out"`
+		} `xml:"testcase"`
+	} `xml:"testsuite"`
+}
+
+func TestReport(t *testing.T) {
+	var b bytes.Buffer
+	if err := junit.NewReporter().Report(&b, reporttest.Report(t)); err != nil {
+		t.Fatal(err)
+	}
+	var doc testSuites
+	if err := xml.Unmarshal(b.Bytes(), &doc); err != nil {
+		t.Fatalf("unable to parse output: %v\n%s", err, b.String())
+	}
+	if doc.Tests != 6 || doc.Failures != 1 || doc.Skipped != 1 {
+		t.Errorf("unexpected totals %+v", doc)
+	}
+	if len(doc.Suites) != 3 || doc.Suites[0].Name != "Gopkg.lock" || doc.Suites[1].Name != "package.json" || doc.Suites[2].Name != "unresolved" {
+		t.Fatalf("unexpected suites %+v", doc.Suites)
+	}
+
+	manifest := doc.Suites[1].Cases
+	cases := []struct {
+		d          string
+		name       string
+		failure    string
+		skipped    string
+		expName    string
+		expFailure string
+		expSkipped string
+	}{
+		{"permitted licenses pass", manifest[0].Name, messageOf(manifest[0].Failure), messageOf(manifest[0].Skipped), "d3@5.0.0", "", ""},
+		{"licenses which are not permitted fail", manifest[1].Name, messageOf(manifest[1].Failure), messageOf(manifest[1].Skipped), "<cypress>@2.1.0", "not permitted", ""},
+		{"licenses permitted by exceptions pass", manifest[2].Name, messageOf(manifest[2].Failure), messageOf(manifest[2].Skipped), "readline@1.3.0", "", ""},
+		{"warnings are skipped", doc.Suites[2].Cases[0].Name, messageOf(doc.Suites[2].Cases[0].Failure), messageOf(doc.Suites[2].Cases[0].Skipped), "left-pad", "", "[not-found] requested failed with status 404"},
+	}
+	for _, tt := range cases {
+		t.Run(tt.d, func(t *testing.T) {
+			if tt.name != tt.expName {
+				t.Errorf("expected name %s, got %s", tt.expName, tt.name)
+			}
+			if tt.failure != tt.expFailure {
+				t.Errorf("expected failure %q, got %q", tt.expFailure, tt.failure)
+			}
+			if tt.skipped != tt.expSkipped {
+				t.Errorf("expected skipped %q, got %q", tt.expSkipped, tt.skipped)
+			}
+		})
+	}
+	if out := manifest[2].SystemOut; out != "GPL-3.0 is permitted by exception LEGAL-42, approved by legal@example.com, until 2018-06-30" {
+		t.Errorf("expected the exception to be recorded, got %q", out)
+	}
+}
+
+func messageOf(v *struct {
+	Message string `xml:"message,attr"`
+}) string {
+	if v == nil {
+		return ""
+	}
+	return v.Message
+}
@@ -5,6 +5,8 @@ import (
 	"sort"
 )
 
+//go:generate go run cmd/licensegen/main.go -licenses data/spdx/licenses.json -exceptions data/spdx/exceptions.json -overlay data/overlay.json -terms data/choosealicense.json -out license_db.go
+
 // Category attempts to categorize licenses based on what they allow
 type Category string
 
@@ -50,362 +52,51 @@ const (
 	Proprietary Type = "proprietary"
 )
 
-// OwnerType identifies the license owner as either an individual or organisation
+// OwnerType identifies the license owner as either an individual, organisation or project
 type OwnerType string
 
 const (
 	Organization OwnerType = "organization"
 	Person       OwnerType = "person"
+	Project      OwnerType = "project"
 )
 
 // License contains information about a given license
 type License struct {
 	Identifier string
-	Name       string
-	ShortName  string
-	Category   Category
-	Type       Type
-	Owner      string
-	OwnerURL   string
-	OwnerType  OwnerType
-	URL        string
+	// Family groups the versions and variants of a license, for example GPL or BSD
+	Family    string
+	Name      string
+	ShortName string
+	Category  Category
+	Type      Type
+	Owner     string
+	OwnerURL  string
+	OwnerType OwnerType
+	URL       string
+	// IsOSIApproved is true if the license is approved by the Open Source Initiative
+	IsOSIApproved bool
+	// IsFSFLibre is true if the Free Software Foundation considers the license to be a free software license
+	IsFSFLibre bool
+	// IsDeprecated is true if SPDX has deprecated the license identifier in favour of another
+	IsDeprecated bool
+	// IsSPDXListed is true if the license is on the SPDX license list. Other licenses, whether known to diligent or user
+	// defined, must be referred to using a LicenseRef within SPDX documents.
+	IsSPDXListed bool
+	// Permissions, Obligations and Limitations describe what the license allows, requires and does not grant. They are
+	// only known for the licenses described by choosealicense.com and user defined licenses which declare them.
+	Permissions []Permission
+	Obligations []Obligation
+	Limitations []Limitation
 }
 
-var lookup = map[string]License{
-
-	"Glide":                      {Identifier: "Glide", Name: "3DFX GLIDE Source Code General Public License", ShortName: "3DFX GLIDE", Category: CopyLeft, Type: OpenSource, URL: "http://www.users.on.net/~triforce/glidexp/COPYING.txt", Owner: "NVIDIA", OwnerURL: "http://www.nvidia.com/page/home.html", OwnerType: Organization},
-	"Abstyles":                   {Identifier: "Abstyles", Name: "Abstyles License", ShortName: "Abstyles License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/Abstyles", Owner: "CTAN", OwnerURL: "http://www.ctan.org/", OwnerType: Organization},
-	"AFL-1.1":                    {Identifier: "AFL-1.1", Name: "Academic Free License 1.1", ShortName: "AFL 1.1", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/AFL-1.1", Owner: "Lawrence Rosen", OwnerURL: "http://www.rosenlaw.com/rosen.htm", OwnerType: Person},
-	"AFL-1.2":                    {Identifier: "AFL-1.2", Name: "Academic Free License 1.2", ShortName: "AFL 1.2", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/AFL-1.2.html", Owner: "Lawrence Rosen", OwnerURL: "http://www.rosenlaw.com/rosen.htm", OwnerType: Person},
-	"AFL-2.0":                    {Identifier: "AFL-2.0", Name: "Academic Free License 2.0", ShortName: "AFL 2.0", Category: Permissive, Type: OpenSource, URL: "http://opensource.linux-mirror.org/licenses/afl-2.0.txt", Owner: "Lawrence Rosen", OwnerURL: "http://www.rosenlaw.com/rosen.htm", OwnerType: Person},
-	"AFL-2.1":                    {Identifier: "AFL-2.1", Name: "Academic Free License 2.1", ShortName: "AFL 2.1", Category: Permissive, Type: OpenSource, URL: "http://www.rosenlaw.com/afl21.htm", Owner: "Lawrence Rosen", OwnerURL: "http://www.rosenlaw.com/rosen.htm", OwnerType: Person},
-	"AFL-3.0":                    {Identifier: "AFL-3.0", Name: "Academic Free License 3.0", ShortName: "AFL 3.0", Category: Permissive, Type: OpenSource, URL: "http://opensource.org/licenses/afl-3.0.php", Owner: "Lawrence Rosen", OwnerURL: "http://www.rosenlaw.com/rosen.htm", OwnerType: Person},
-	"AMPAS":                      {Identifier: "AMPAS", Name: "Academy of Motion Picture Arts and Sciences BSD-Style", ShortName: "AMPAS BSD-Style License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/BSD#AMPASBSD", Owner: "AMPAS", OwnerURL: "", OwnerType: Organization},
-	"DOC":                        {Identifier: "DOC", Name: "ACE TAO License", ShortName: "ACE TAO License", Category: Permissive, Type: OpenSource, URL: "http://www.cs.wustl.edu/~schmidt/", Owner: "Douglas Schmidt", OwnerURL: "http://www.cs.wustl.edu/~schmidt/", OwnerType: Person},
-	"APL-1.0":                    {Identifier: "APL-1.0", Name: "Adaptive Public License", ShortName: "APL 1.0", Category: CopyLeft, Type: OpenSource, URL: "http://www.opensource.org/licenses/apl1.0.php", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization},
-	"Adobe-Glyph":                {Identifier: "Adobe-Glyph", Name: "Adobe Glyph License", ShortName: "Adobe Glyph License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/MIT#AdobeGlyph", Owner: "Adobe Systems", OwnerURL: "http://www.adobe.com/", OwnerType: Organization},
-	"APAFML":                     {Identifier: "APAFML", Name: "Adobe Postscript AFM License", ShortName: "Adobe Postscript AFM License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/AdobePostscriptAFM", Owner: "Adobe Systems", OwnerURL: "http://www.adobe.com/", OwnerType: Organization},
-	"Adobe-2006":                 {Identifier: "Adobe-2006", Name: "Adobe Systems Incorporated Source Code License Agreement", ShortName: "Adobe Source Code License 2006", Category: Permissive, Type: OpenSource, URL: "http://fedoraproject.org/wiki/Licensing/AdobeLicense", Owner: "Adobe Systems", OwnerURL: "http://www.adobe.com/", OwnerType: Organization},
-	"AGPL-1.0":                   {Identifier: "AGPL-1.0", Name: "Affero General Public License 1.0", ShortName: "AGPL 1.0", Category: CopyLeft, Type: OpenSource, URL: "http://www.affero.org/oagpl.html", Owner: "Affero", OwnerURL: "http://www.affero.com/", OwnerType: Organization},
-	"Afmparse":                   {Identifier: "Afmparse", Name: "afmparse License", ShortName: "afmparse License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/Afmparse", Owner: "Adobe Systems", OwnerURL: "http://www.adobe.com/", OwnerType: Organization},
-	"Aladdin":                    {Identifier: "Aladdin", Name: "Aladdin Free Public License v8", ShortName: "Aladdin FPL v8", Category: CopyLeft, Type: OpenSource, URL: "http://pages.cs.wisc.edu/~ghost/doc/AFPL/6.01/Public.htm", Owner: "Aladdin Enterprises", OwnerURL: "http://www.major2nd.com/ae/", OwnerType: Organization},
-	"Giftware":                   {Identifier: "Giftware", Name: "Allegro 4 License", ShortName: "Allegro 4 License", Category: Permissive, Type: OpenSource, URL: "http://alleg.sourceforge.net//license.html", Owner: "Allegro Project", OwnerURL: "http://alleg.sourceforge.net//readme.html", OwnerType: "project"},
-	"Xnet":                       {Identifier: "Xnet", Name: "Altera License", ShortName: "Altera License", Category: Permissive, Type: OpenSource, URL: "http://www.opensource.org/licenses/xnet.php", Owner: "Altera Corporation", OwnerURL: "http://www.altera.com/", OwnerType: Organization},
-	"ADSL":                       {Identifier: "ADSL", Name: "Amazon Digital Services License", ShortName: "Amazon Digital Services License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/AmazonDigitalServicesLicense", Owner: "Amazon Web Services", OwnerURL: "http://aws.amazon.com/", OwnerType: Organization},
-	"AMDPLPA":                    {Identifier: "AMDPLPA", Name: "AMD PLPA License", ShortName: "AMD PLPA License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/AMD_plpa_map_License", Owner: "Advanced Micro Devices", OwnerURL: "http://www.amd.com/us/pages/amdhomepage.aspx", OwnerType: Organization},
-	"ANTLR-PD":                   {Identifier: "ANTLR-PD", Name: "ANTLR Software Rights Notice", ShortName: "ANTLR-PD", Category: Permissive, Type: OpenSource, URL: "http://www.antlr2.org/", Owner: "ANTLR", OwnerURL: "http://antlr.org/about.html", OwnerType: "project"},
-	"Apache-1.0":                 {Identifier: "Apache-1.0", Name: "Apache License 1.0", ShortName: "Apache 1.0", Category: Permissive, Type: OpenSource, URL: "http://www.apache.org/licenses/", Owner: "Apache Software Foundation", OwnerURL: "http://www.apache.org/", OwnerType: Organization},
-	"Apache-1.1":                 {Identifier: "Apache-1.1", Name: "Apache License 1.1", ShortName: "Apache 1.1", Category: Permissive, Type: OpenSource, URL: "http://www.apache.org/licenses/", Owner: "Apache Software Foundation", OwnerURL: "http://www.apache.org/", OwnerType: Organization},
-	"Apache-2.0":                 {Identifier: "Apache-2.0", Name: "Apache License 2.0", ShortName: "Apache 2.0", Category: Permissive, Type: OpenSource, URL: "http://www.apache.org/licenses/", Owner: "Apache Software Foundation", OwnerURL: "http://www.apache.org/", OwnerType: Organization},
-	"AML":                        {Identifier: "AML", Name: "Apple MIT License", ShortName: "Apple MIT License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/Apple_MIT_License", Owner: "Apple", OwnerURL: "http://www.apple.com/", OwnerType: Organization},
-	"APSL-1.0":                   {Identifier: "APSL-1.0", Name: "Apple Public Source License 1.0", ShortName: "APSL 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.opensource.apple.com/source/architecture/architecture-229/APPLE_LICENSE?f=text", Owner: "Apple", OwnerURL: "http://www.apple.com/", OwnerType: Organization},
-	"APSL-1.1":                   {Identifier: "APSL-1.1", Name: "Apple Public Source License 1.1", ShortName: "APSL 1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "http://web.archive.org/web/20000901055846/http://www.opensource.apple.com/apsl/", Owner: "Apple", OwnerURL: "http://www.apple.com/", OwnerType: Organization},
-	"APSL-1.2":                   {Identifier: "APSL-1.2", Name: "Apple Public Source License 1.2", ShortName: "APSL 1.2", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.opensource.apple.com/apsl/1.2.txt", Owner: "Apple", OwnerURL: "http://www.apple.com/", OwnerType: Organization},
-	"APSL-2.0":                   {Identifier: "APSL-2.0", Name: "Apple Public Source License 2.0", ShortName: "APSL 2.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.opensource.apple.com/license/apsl/", Owner: "Apple", OwnerURL: "http://www.apple.com/", OwnerType: Organization},
-	"Artistic-1.0":               {Identifier: "Artistic-1.0", Name: "Artistic License 1.0", ShortName: "Artistic 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.perlfoundation.org/", Owner: "Perl Foundation", OwnerURL: "http://www.perlfoundation.org/", OwnerType: Organization},
-	"Artistic-1.0-cl8":           {Identifier: "Artistic-1.0-cl8", Name: "Artistic License 1.0 w/clause 8", ShortName: "Artistic 1.0 w/clause 8", Category: CopyLeftLimited, Type: OpenSource, URL: "http://spdx.org/licenses/Artistic-1.0-cl8", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization},
-	"Artistic-2.0":               {Identifier: "Artistic-2.0", Name: "Artistic License 2.0", ShortName: "Artistic 2.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.perlfoundation.org/", Owner: "Perl Foundation", OwnerURL: "http://www.perlfoundation.org/", OwnerType: Organization},
-	"Artistic-1.0-Perl":          {Identifier: "Artistic-1.0-Perl", Name: "Artistic License (Perl) 1.0", ShortName: "Artistic-Perl-1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://dev.perl.org/licenses/artistic.html", Owner: "Perl Foundation", OwnerURL: "http://www.perlfoundation.org/", OwnerType: Organization},
-	"AAL":                        {Identifier: "AAL", Name: "Attribution Assurance License", ShortName: "AAL", Category: Permissive, Type: OpenSource, URL: "http://opensource.org/licenses/attribution.php", Owner: "Unspecified", OwnerURL: "", OwnerType: "project"},
-	"Bahyph":                     {Identifier: "Bahyph", Name: "Bahyph License", ShortName: "Bahyph License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/Bahyph", Owner: "GMV", OwnerURL: "", OwnerType: "project"},
-	"Barr":                       {Identifier: "Barr", Name: "Barr TeX License", ShortName: "Barr TeX License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/Barr", Owner: "Michael Barr", OwnerURL: "", OwnerType: Person},
-	"BitTorrent-1.0":             {Identifier: "BitTorrent-1.0", Name: "BitTorrent Open Source License 1.0", ShortName: "BitTorrent 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.bittorrent.com/license/", Owner: "BitTorrent, Inc.", OwnerURL: "http://www.bittorrent.com/", OwnerType: Organization},
-	"BitTorrent-1.1":             {Identifier: "BitTorrent-1.1", Name: "BitTorrent Open Source License 1.1", ShortName: "BitTorrent 1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "https://web.archive.org/web/20080213154112/http://www.bittorrent.com/bittorrent-open-source-license", Owner: "BitTorrent, Inc.", OwnerURL: "http://www.bittorrent.com/", OwnerType: Organization},
-	"BSL-1.0":                    {Identifier: "BSL-1.0", Name: "Boost Software License 1.0", ShortName: "Boost 1.0", Category: Permissive, Type: OpenSource, URL: "http://www.boost.org/users/license.html", Owner: "Boost", OwnerURL: "http://www.boost.org/", OwnerType: Organization},
-	"Borceux":                    {Identifier: "Borceux", Name: "Borceux License", ShortName: "Borceux License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/Borceux", Owner: "Francis Borceux", OwnerURL: "", OwnerType: Person},
-	"BSD-2-Clause":               {Identifier: "BSD-2-Clause", Name: "BSD-2-Clause", ShortName: "BSD-2-Clause", Category: Permissive, Type: OpenSource, URL: "http://www.opensource.org/licenses/BSD-2-Clause", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization},
-	"BSD-2-Clause-FreeBSD":       {Identifier: "BSD-2-Clause-FreeBSD", Name: "BSD 2-clause \"FreeBSD\" License", ShortName: "BSD 2-clause \"FreeBSD\"", Category: Permissive, Type: OpenSource, URL: "http://www.freebsd.org/copyright/freebsd-license.html", Owner: "FreeBSD", OwnerURL: "http://www.freebsd.org/", OwnerType: Organization},
-	"BSD-2-Clause-NetBSD":        {Identifier: "BSD-2-Clause-NetBSD", Name: "BSD 2-clause \"NetBSD\" License", ShortName: "BSD 2-clause \"NetBSD\"", Category: Permissive, Type: OpenSource, URL: "http://www.netbsd.org/about/redistribution.html#default", Owner: "NetBSD", OwnerURL: "http://www.netbsd.org/", OwnerType: Organization},
-	"BSD-3-Clause":               {Identifier: "BSD-3-Clause", Name: "BSD-3-Clause", ShortName: "BSD-3-Clause", Category: Permissive, Type: OpenSource, URL: "http://www.opensource.org/licenses/BSD-3-Clause", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization},
-	"BSD-3-Clause-Attribution":   {Identifier: "BSD-3-Clause-Attribution", Name: "BSD Acknowledgment License", ShortName: "BSD Acknowledgment License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/BSD_with_Attribution", Owner: "Universidad de Palermo", OwnerURL: "http://www.palermo.edu/", OwnerType: Organization},
-	"BSD-4-Clause":               {Identifier: "BSD-4-Clause", Name: "BSD-Original", ShortName: "BSD-Original", Category: Permissive, Type: OpenSource, URL: "http://www.xfree86.org/3.3.6/COPYRIGHT2.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization},
-	"BSD-4-Clause-UC":            {Identifier: "BSD-4-Clause-UC", Name: "BSD-Original-UC", ShortName: "BSD-Original-UC", Category: Permissive, Type: OpenSource, URL: "ftp://ftp.cs.berkeley.edu/pub/4bsd/README.Impt.License.Change", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization},
-	"BSD-Protection":             {Identifier: "BSD-Protection", Name: "BSD Protection License", ShortName: "BSD Protection License", Category: CopyLeft, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/BSD_Protection_License", Owner: "FreeBSD", OwnerURL: "http://www.freebsd.org/", OwnerType: Organization},
-	"0BSD":                       {Identifier: "0BSD", Name: "BSD Zero Clause License", ShortName: "BSD Zero Clause License", Category: Permissive, Type: OpenSource, URL: "http://landley.net/toybox/license.html", Owner: "Rob Landley", OwnerURL: "http://landley.net/", OwnerType: Person},
-	"bzip2-1.0.5":                {Identifier: "bzip2-1.0.5", Name: "bzip2 License", ShortName: "bzip2 License", Category: Permissive, Type: OpenSource, URL: "http://bzip.org/1.0.5/bzip2-manual-1.0.5.html", Owner: "bzip", OwnerURL: "http://www.bzip.org/", OwnerType: "project"},
-	"bzip2-1.0.6":                {Identifier: "bzip2-1.0.6", Name: "bzip2 License 2010", ShortName: "bzip2 License 2010", Category: Permissive, Type: OpenSource, URL: "https://github.com/asimonov-im/bzip2/blob/master/LICENSE", Owner: "bzip", OwnerURL: "http://www.bzip.org/", OwnerType: "project"},
-	"Caldera":                    {Identifier: "Caldera", Name: "Caldera License", ShortName: "Caldera License", Category: Permissive, Type: OpenSource, URL: "http://www.lemis.com/grog/UNIX/ancient-source-all.pdf", Owner: "Caldera", OwnerURL: "http://www.caldera.com/", OwnerType: Organization},
-	"CECILL-B":                   {Identifier: "CECILL-B", Name: "CeCILL-B Free Software License Agreement", ShortName: "CeCILL-B License", Category: Permissive, Type: OpenSource, URL: "http://www.cecill.info/licences/Licence_CeCILL-B_V1-fr.html", Owner: "CeCILL", OwnerURL: "http://www.cecill.info/licences.en.html", OwnerType: Organization},
-	"CECILL-C":                   {Identifier: "CECILL-C", Name: "CeCILL-C Free Software License Agreement", ShortName: "CeCILL-C License", Category: CopyLeft, Type: OpenSource, URL: "http://www.cecill.info/licences/Licence_CeCILL-C_V1-fr.html", Owner: "CeCILL", OwnerURL: "http://www.cecill.info/licences.en.html", OwnerType: Organization},
-	"CECILL-1.0":                 {Identifier: "CECILL-1.0", Name: "CeCILL Free Software License Agreement v1.0", ShortName: "CeCILL 1.0", Category: CopyLeft, Type: OpenSource, URL: "http://www.cecill.info/licences/Licence_CeCILL_V1-fr.html", Owner: "CeCILL", OwnerURL: "http://www.cecill.info/licences.en.html", OwnerType: Organization},
-	"CECILL-1.1":                 {Identifier: "CECILL-1.1", Name: "CeCILL Free Software License Agreement v1.1", ShortName: "CeCILL 1.1 English", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.cecill.info/licences.en.html", Owner: "CeCILL", OwnerURL: "http://www.cecill.info/licences.en.html", OwnerType: Organization},
-	"CECILL-2.0":                 {Identifier: "CECILL-2.0", Name: "CeCILL Free Software License Agreement v2.0", ShortName: "CeCILL 2.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.cecill.info/licences.en.html", Owner: "CeCILL", OwnerURL: "http://www.cecill.info/licences.en.html", OwnerType: Organization},
-	"CECILL-2.1":                 {Identifier: "CECILL-2.1", Name: "CeCILL Free Software License Agreement v2.1", ShortName: "CeCILL 2.1", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.cecill.info/licences/Licence_CeCILL_V2.1-fr.html", Owner: "CeCILL", OwnerURL: "http://www.cecill.info/licences.en.html", OwnerType: Organization},
-	"ClArtistic":                 {Identifier: "ClArtistic", Name: "Clarified Artistic License", ShortName: "Clarified Artistic License", Category: CopyLeftLimited, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/ArtisticClarified", Owner: "Fedora", OwnerURL: "http://fedoraproject.org/", OwnerType: Organization},
-	"Plexus":                     {Identifier: "Plexus", Name: "Classworlds License", ShortName: "Classworlds License", Category: Permissive, Type: OpenSource, URL: "http://classworlds.codehaus.org/license.html", Owner: "Codehaus", OwnerURL: "http://codehaus.org/", OwnerType: Organization},
-	"BSD-3-Clause-Clear":         {Identifier: "BSD-3-Clause-Clear", Name: "Clear BSD License", ShortName: "Clear BSD License", Category: Permissive, Type: OpenSource, URL: "http://labs.metacarta.com/license-explanation.html", Owner: "MetaCarta", OwnerURL: "http://www.metacarta.com/", OwnerType: Organization},
-	"MIT-CMU":                    {Identifier: "MIT-CMU", Name: "CMU UC Regents License", ShortName: "CMU UC Regents License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing:MIT?rd=Licensing/MIT#CMU_Style", Owner: "Carnegie Mellon University", OwnerURL: "http://www.cmu.edu/about/index.shtml", OwnerType: Organization},
-	"CNRI-Jython":                {Identifier: "CNRI-Jython", Name: "CNRI Jython License", ShortName: "CNRI Jython License", Category: Permissive, Type: OpenSource, URL: "http://www.jython.org/license.html", Owner: "CNRI", OwnerURL: "http://www.cnri.reston.va.us/", OwnerType: Organization},
-	"CNRI-Python":                {Identifier: "CNRI-Python", Name: "CNRI Open Source License Agreement for Python 1.6", ShortName: "CNRI Python 1.6", Category: Permissive, Type: OpenSource, URL: "http://www.handle.net/python_licenses/python1.6_9-5-00.html", Owner: "CNRI", OwnerURL: "http://www.cnri.reston.va.us/", OwnerType: Organization},
-	"CNRI-Python-GPL-Compatible": {Identifier: "CNRI-Python-GPL-Compatible", Name: "CNRI Open Source License Agreement for Python 1.6.1", ShortName: "CNRI Python 1.6.1", Category: Permissive, Type: OpenSource, URL: "http://www.python.org/download/releases/1.6.1/download_win/", Owner: "CNRI", OwnerURL: "http://www.cnri.reston.va.us/", OwnerType: Organization},
-	"CPOL-1.02":                  {Identifier: "CPOL-1.02", Name: "Code Project Open License (CPOL) 1.02", ShortName: "CPOL 1.02", Category: FreeRestricted, Type: OpenSource, URL: "http://www.codeproject.com/info/cpol10.aspx", Owner: "Code Project", OwnerURL: "http://www.codeproject.com/", OwnerType: "project"},
-	"CDDL-1.0":                   {Identifier: "CDDL-1.0", Name: "Common Development and Distribution License 1.0", ShortName: "CDDL 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.sun.com/cddl/", Owner: "Oracle Corporation", OwnerURL: "http://www.oracle.com/index.html", OwnerType: Organization},
-	"CDDL-1.1":                   {Identifier: "CDDL-1.1", Name: "Common Development and Distribution License 1.1", ShortName: "CDDL 1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "http://glassfish.java.net/public/CDDL+GPL_1_1.html", Owner: "Oracle Corporation", OwnerURL: "http://www.oracle.com/index.html", OwnerType: Organization},
-	"CPAL-1.0":                   {Identifier: "CPAL-1.0", Name: "Common Public Attribution License 1.0", ShortName: "CPAL 1.0", Category: CopyLeft, Type: OpenSource, URL: "http://www.opensource.org/licenses/cpal_1.0", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization},
-	"CPL-1.0":                    {Identifier: "CPL-1.0", Name: "Common Public License 1.0", ShortName: "CPL 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.eclipse.org/legal/cpl-v10.html", Owner: "IBM", OwnerURL: "http://www.ibm.com/developerworks/", OwnerType: Organization},
-	"CATOSL-1.1":                 {Identifier: "CATOSL-1.1", Name: "Computer Associates Trusted Open Source License 1.1", ShortName: "CA Trusted Open Source License 1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.opensource.org/licenses/ca-tosl1.1.php", Owner: "Computer Associates", OwnerURL: "http://www.ca.com/us/default.aspx", OwnerType: Organization},
-	"Condor-1.1":                 {Identifier: "Condor-1.1", Name: "Condor Public License 1.1", ShortName: "Condor Public License 1.1", Category: Permissive, Type: OpenSource, URL: "http://www.cs.wisc.edu/condor/license.html", Owner: "Condor Project", OwnerURL: "http://www.cs.wisc.edu/condor/", OwnerType: "project"},
-	"CC-BY-4.0":                  {Identifier: "CC-BY-4.0", Name: "Creative Commons Attribution 4.0 International Public License", ShortName: "CC-BY-4.0", Category: Permissive, Type: OpenSource, URL: "http://creativecommons.org/licenses/by/4.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-1.0":                  {Identifier: "CC-BY-1.0", Name: "Creative Commons Attribution License 1.0", ShortName: "CC-BY-1.0", Category: Permissive, Type: OpenSource, URL: "http://creativecommons.org/licenses/by/1.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-2.0":                  {Identifier: "CC-BY-2.0", Name: "Creative Commons Attribution License 2.0", ShortName: "CC-BY-2.0", Category: Permissive, Type: OpenSource, URL: "http://creativecommons.org/licenses/by/2.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-2.5":                  {Identifier: "CC-BY-2.5", Name: "Creative Commons Attribution License 2.5", ShortName: "CC-BY-2.5", Category: Permissive, Type: OpenSource, URL: "http://creativecommons.org/licenses/by/2.5/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-3.0":                  {Identifier: "CC-BY-3.0", Name: "Creative Commons Attribution License 3.0", ShortName: "CC-BY-3.0", Category: Permissive, Type: OpenSource, URL: "http://creativecommons.org/licenses/by/3.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-ND-4.0":               {Identifier: "CC-BY-ND-4.0", Name: "Creative Commons Attribution-NoDerivatives 4.0 International Public License", ShortName: "CC-BY-ND-4.0", Category: FreeRestricted, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-nd/4.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-ND-1.0":               {Identifier: "CC-BY-ND-1.0", Name: "Creative Commons Attribution No Derivatives License 1.0", ShortName: "CC-BY-ND-1.0", Category: FreeRestricted, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-nd/1.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-ND-2.0":               {Identifier: "CC-BY-ND-2.0", Name: "Creative Commons Attribution No Derivatives License 2.0", ShortName: "CC-BY-ND-2.0", Category: FreeRestricted, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-nd/2.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-ND-2.5":               {Identifier: "CC-BY-ND-2.5", Name: "Creative Commons Attribution No Derivatives License 2.5", ShortName: "CC-BY-ND-2.5", Category: FreeRestricted, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-nd/2.5/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-ND-3.0":               {Identifier: "CC-BY-ND-3.0", Name: "Creative Commons Attribution No Derivatives License 3.0", ShortName: "CC-BY-ND-3.0", Category: FreeRestricted, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-nd/3.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-NC-1.0":               {Identifier: "CC-BY-NC-1.0", Name: "Creative Commons Attribution Non-Commercial 1.0", ShortName: "CC-BY-NC-1.0", Category: FreeRestricted, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-nc/1.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-NC-2.0":               {Identifier: "CC-BY-NC-2.0", Name: "Creative Commons Attribution Non-Commercial 2.0", ShortName: "CC-BY-NC-2.0", Category: FreeRestricted, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-nc/2.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-NC-2.5":               {Identifier: "CC-BY-NC-2.5", Name: "Creative Commons Attribution Non-Commercial 2.5", ShortName: "CC-BY-NC-2.5", Category: FreeRestricted, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-nc/2.5/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-NC-3.0":               {Identifier: "CC-BY-NC-3.0", Name: "Creative Commons Attribution Non-Commercial 3.0", ShortName: "CC-BY-NC-3.0", Category: FreeRestricted, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-nc/3.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-NC-4.0":               {Identifier: "CC-BY-NC-4.0", Name: "Creative Commons Attribution-NonCommercial 4.0 International Public License", ShortName: "CC-BY-NC-4.0", Category: FreeRestricted, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-nc/4.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-NC-ND-4.0":            {Identifier: "CC-BY-NC-ND-4.0", Name: "Creative Commons Attribution-NonCommercial-NoDerivatives 4.0 International Public License", ShortName: "CC-BY-NC-ND-4.0", Category: FreeRestricted, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-nc-nd/4.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-NC-ND-1.0":            {Identifier: "CC-BY-NC-ND-1.0", Name: "Creative Commons Attribution Non-Commercial No Derivatives License 1.0", ShortName: "CC-BY-NC-ND-1.0", Category: FreeRestricted, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-nd-nc/1.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-NC-ND-2.0":            {Identifier: "CC-BY-NC-ND-2.0", Name: "Creative Commons Attribution Non-Commercial No Derivatives License 2.0", ShortName: "CC-BY-NC-ND-2.0", Category: FreeRestricted, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-nc-nd/2.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-NC-ND-2.5":            {Identifier: "CC-BY-NC-ND-2.5", Name: "Creative Commons Attribution Non-Commercial No Derivatives License 2.5", ShortName: "CC-BY-NC-ND-2.5", Category: FreeRestricted, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-nc-nd/2.5/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-NC-ND-3.0":            {Identifier: "CC-BY-NC-ND-3.0", Name: "Creative Commons Attribution Non-Commercial No Derivatives License 3.0", ShortName: "CC-BY-NC-ND-3.0", Category: FreeRestricted, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-nc-nd/3.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-NC-SA-4.0":            {Identifier: "CC-BY-NC-SA-4.0", Name: "Creative Commons Attribution-NonCommercial-ShareAlike 4.0 International Public License", ShortName: "CC-BY-NC-SA-4.0", Category: CopyLeft, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-nc-sa/4.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-NC-SA-1.0":            {Identifier: "CC-BY-NC-SA-1.0", Name: "Creative Commons Attribution Non-Commercial Share Alike License 1.0", ShortName: "CC-BY-NC-SA-1.0", Category: FreeRestricted, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-nc-sa/1.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-NC-SA-2.0":            {Identifier: "CC-BY-NC-SA-2.0", Name: "Creative Commons Attribution Non-Commercial Share Alike License 2.0", ShortName: "CC-BY-NC-SA-2.0", Category: FreeRestricted, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-nc-sa/2.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-NC-SA-2.5":            {Identifier: "CC-BY-NC-SA-2.5", Name: "Creative Commons Attribution Non-Commercial Share Alike License 2.5", ShortName: "CC-BY-NC-SA-2.5", Category: FreeRestricted, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-nc-sa/2.5/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-NC-SA-3.0":            {Identifier: "CC-BY-NC-SA-3.0", Name: "Creative Commons Attribution Non-Commercial Share Alike License 3.0", ShortName: "CC-BY-NC-SA-3.0", Category: FreeRestricted, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-nc-sa/3.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-SA-4.0":               {Identifier: "CC-BY-SA-4.0", Name: "Creative Commons Attribution-ShareAlike 4.0 International Public License", ShortName: "CC-BY-SA-4.0", Category: CopyLeft, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-sa/4.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-SA-1.0":               {Identifier: "CC-BY-SA-1.0", Name: "Creative Commons Attribution Share Alike License 1.0", ShortName: "CC-BY-SA-1.0", Category: Permissive, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-sa/1.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-SA-2.0":               {Identifier: "CC-BY-SA-2.0", Name: "Creative Commons Attribution Share Alike License 2.0", ShortName: "CC-BY-SA-2.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-sa/2.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-SA-2.5":               {Identifier: "CC-BY-SA-2.5", Name: "Creative Commons Attribution Share Alike License 2.5", ShortName: "CC-BY-SA-2.5", Category: CopyLeftLimited, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-sa/2.5/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC-BY-SA-3.0":               {Identifier: "CC-BY-SA-3.0", Name: "Creative Commons Attribution Share Alike License 3.0", ShortName: "CC-BY-SA-3.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://creativecommons.org/licenses/by-sa/3.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"CC0-1.0":                    {Identifier: "CC0-1.0", Name: "Creative Commons CC0 1.0 Universal", ShortName: "CC0-1.0", Category: PublicDomain, Type: OpenSource, URL: "http://creativecommons.org/publicdomain/zero/1.0/", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization},
-	"Crossword":                  {Identifier: "Crossword", Name: "Crossword License", ShortName: "Crossword License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/Crossword", Owner: "Gerd Neugebauer", OwnerURL: "", OwnerType: Person},
-	"CrystalStacker":             {Identifier: "CrystalStacker", Name: "Crystal Stacker License", ShortName: "Crystal Stacker License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing:CrystalStacker?rd=Licensing/CrystalStacker", Owner: "NewCreature Design", OwnerURL: "http://www.ncdgames.com/", OwnerType: Organization},
-	"CUA-OPL-1.0":                {Identifier: "CUA-OPL-1.0", Name: "CUA Office Public License 1.0", ShortName: "CUA-OPL-1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.opensource.org/licenses/cuaoffice.php", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization},
-	"Cube":                       {Identifier: "Cube", Name: "Cube License", ShortName: "Cube License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/Cube", Owner: "Wouter van Oortmerssen", OwnerURL: "", OwnerType: Person},
-	"curl":                       {Identifier: "curl", Name: "cURL License", ShortName: "cURL License", Category: Permissive, Type: OpenSource, URL: "http://curl.haxx.se/", Owner: "cURL", OwnerURL: "http://curl.haxx.se/", OwnerType: "project"},
-	"D-FSL-1.0-DE":               {Identifier: "D-FSL-1.0-DE", Name: "Deutsche Freie Software Lizenz", ShortName: "Deutsche Freie Software Lizenz", Category: CopyLeft, Type: OpenSource, URL: "http://www.dipp.nrw.de/d-fsl/index_html/lizenzen/de/D-FSL-1_0_de.txt", Owner: "Institute for Legal Issues On Free and Open Source Software", OwnerURL: "http://www.ifross.de/", OwnerType: Organization},
-	"diffmark":                   {Identifier: "diffmark", Name: "diffmark License", ShortName: "diffmark License", Category: PublicDomain, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/diffmark", Owner: "Unspecified", OwnerURL: "", OwnerType: "project"},
-	"Dotseqn":                    {Identifier: "Dotseqn", Name: "Dotseqn License", ShortName: "Dotseqn License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/Dotseqn", Owner: "Donald Arsenau", OwnerURL: "", OwnerType: Person},
-	"DSDP":                       {Identifier: "DSDP", Name: "DSDP License", ShortName: "DSDP License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/DSDP", Owner: "University of Chicago", OwnerURL: "http://www.uchicago.edu/", OwnerType: Organization},
-	"dvipdfm":                    {Identifier: "dvipdfm", Name: "dvipdfm License", ShortName: "dvipdfm License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/dvipdfm", Owner: "Unspecified", OwnerURL: "", OwnerType: "project"},
-	"EPL-1.0":                    {Identifier: "EPL-1.0", Name: "Eclipse Public License 1.0", ShortName: "EPL 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.eclipse.org/legal/epl-v10.html", Owner: "Eclipse Foundation", OwnerURL: "http://www.eclipse.org/", OwnerType: Organization},
-	"EPL-2.0":                    {Identifier: "EPL-2.0", Name: "Eclipse Public License 2.0", ShortName: "EPL 2.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://www.eclipse.org/legal/epl-2.0/", Owner: "Eclipse Foundation", OwnerURL: "http://www.eclipse.org/", OwnerType: Organization},
-	"ECL-1.0":                    {Identifier: "ECL-1.0", Name: "Educational Community License 1.0", ShortName: "ECL 1.0", Category: Permissive, Type: OpenSource, URL: "http://www.opensource.org/licenses/ecl1.php", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization},
-	"ECL-2.0":                    {Identifier: "ECL-2.0", Name: "Educational Community License 2.0", ShortName: "ECL 2.0", Category: Permissive, Type: OpenSource, URL: "http://www.opensource.org/licenses/ecl2.php", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization},
-	"eGenix":                     {Identifier: "eGenix", Name: "eGenix Public License 1.1.0", ShortName: "eGenix Public License 1.1.0", Category: Permissive, Type: OpenSource, URL: "http://www.egenix.com/products/eGenix.com-Public-License-1.1.0.pdf", Owner: "eGenix", OwnerURL: "http://www.egenix.com/", OwnerType: Organization},
-	"EFL-1.0":                    {Identifier: "EFL-1.0", Name: "Eiffel Forum License 1.0", ShortName: "EFL 1.0", Category: Permissive, Type: OpenSource, URL: "http://www.eiffel-nice.org/license/", Owner: "Eiffel NICE", OwnerURL: "http://www.eiffel-nice.org/", OwnerType: Organization},
-	"EFL-2.0":                    {Identifier: "EFL-2.0", Name: "Eiffel Forum License 2.0", ShortName: "EFL 2.0", Category: Permissive, Type: OpenSource, URL: "http://www.eiffel-nice.org/license/", Owner: "Eiffel NICE", OwnerURL: "http://www.eiffel-nice.org/", OwnerType: Organization},
-	"MIT-advertising":            {Identifier: "MIT-advertising", Name: "Enlightenment (EFL) MIT-Style License", ShortName: "EFL MIT-Style License", Category: Permissive, Type: OpenSource, URL: "http://www.enlightenment.org/", Owner: "Enlightenment", OwnerURL: "http://www.enlightenment.org/", OwnerType: Organization},
-	"Entessa":                    {Identifier: "Entessa", Name: "Entessa Public License v1.0", ShortName: "Entessa 1.0", Category: Permissive, Type: OpenSource, URL: "http://openseal.sourceforge.net/epl/index.html", Owner: "Entessa", OwnerURL: "http://esi.entessa.com/", OwnerType: Organization},
-	"ErlPL-1.1":                  {Identifier: "ErlPL-1.1", Name: "Erlang Public License v1.1", ShortName: "Erlang Public License 1.1", Category: CopyLeft, Type: OpenSource, URL: "http://www.erlang.org/EPLICENSE", Owner: "Erlang", OwnerURL: "http://www.erlang.org/", OwnerType: Organization},
-	"EUDatagrid":                 {Identifier: "EUDatagrid", Name: "EU DataGrid Software License", ShortName: "EU DataGrid Software License", Category: Permissive, Type: OpenSource, URL: "http://eu-datagrid.web.cern.ch/eu-datagrid/license.html", Owner: "DataGrid Project", OwnerURL: "http://eu-datagrid.web.cern.ch/eu-datagrid/default.htm", OwnerType: "project"},
-	"EUPL-1.0":                   {Identifier: "EUPL-1.0", Name: "European Union Public Licence 1.0", ShortName: "EUPL 1.0", Category: CopyLeft, Type: OpenSource, URL: "http://ec.europa.eu/idabc/en/document/7330.html", Owner: "OSOR.eu", OwnerURL: "http://www.osor.eu/", OwnerType: Organization},
-	"EUPL-1.1":                   {Identifier: "EUPL-1.1", Name: "European Union Public Licence 1.1", ShortName: "EUPL 1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "http://ec.europa.eu/idabc/eupl", Owner: "OSOR.eu", OwnerURL: "http://www.osor.eu/", OwnerType: Organization},
-	"Eurosym":                    {Identifier: "Eurosym", Name: "Eurosym License", ShortName: "Eurosym License", Category: CopyLeftLimited, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing:Eurosym?rd=Licensing/Eurosym", Owner: "Henrik Theiling", OwnerURL: "", OwnerType: Person},
-	"Fair":                       {Identifier: "Fair", Name: "Fair License", ShortName: "Fair License", Category: Permissive, Type: OpenSource, URL: "http://opensource.org/licenses/fair.php", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization},
-	"OML":                        {Identifier: "OML", Name: "FastCGI DevKit", ShortName: "FastCGI DevKit", Category: Permissive, Type: OpenSource, URL: "http://www.fastcgi.com/devkit/LICENSE.TERMS", Owner: "OpenMarket", OwnerURL: "http://www.openmarket.com/", OwnerType: Organization},
-	"Frameworx-1.0":              {Identifier: "Frameworx-1.0", Name: "Frameworx Open License v1.0", ShortName: "Frameworx 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://opensource.org/licenses/frameworx.php", Owner: "Frameworx Company", OwnerURL: "http://investing.businessweek.com/research/stocks/private/snapshot.asp?privcapId=691225", OwnerType: Organization},
-	"FreeImage":                  {Identifier: "FreeImage", Name: "FreeImage Public License Version 1.0", ShortName: "FreeImage Public License 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://freeimage.sourceforge.net/freeimage-license.txt", Owner: "FreeImage Project", OwnerURL: "http://freeimage.sourceforge.net/", OwnerType: "project"},
-	"FSFUL":                      {Identifier: "FSFUL", Name: "Free Software Foundation - Free Software License", ShortName: "FSF Free Software License", Category: PublicDomain, Type: OpenSource, URL: "http://www.fsf.org/licensing/licenses/", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"FSFULLR":                    {Identifier: "FSFULLR", Name: "Free Software Foundation - Unlimited License", ShortName: "FSF-Unlimited", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/FSF_Unlimited_License#License_Retention_Variant", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"FTL":                        {Identifier: "FTL", Name: "FreeType Project License", ShortName: "FreeType Project License", Category: Permissive, Type: OpenSource, URL: "http://www.freetype.org/license.html", Owner: "FreeType Project", OwnerURL: "http://www.freetype.org/index2.html", OwnerType: "project"},
-	"FSFAP":                      {Identifier: "FSFAP", Name: "FSF All Permissive License", ShortName: "FSF All Permissive License", Category: Permissive, Type: OpenSource, URL: "http://www.gnu.org/prep/maintain/html_node/License-Notices-for-Other-Files.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"GPL-3.0-with-GCC-exception": {Identifier: "GPL-3.0-with-GCC-exception", Name: "GCC Runtime Library Exception Version 3.1", ShortName: "GCC Runtime Library Exception 3.1", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.gnu.org/licenses/gcc-exception-3.1.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"GL2PS":                            {Identifier: "GL2PS", Name: "GL2PS License", ShortName: "GL2PS License", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.geuz.org/gl2ps/COPYING.GL2PS", Owner: "Christophe Geuzaine", OwnerURL: "", OwnerType: Person},
-	"Glulxe":                           {Identifier: "Glulxe", Name: "Glulxe License", ShortName: "Glulxe License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/Glulxe", Owner: "Andrew Plotkin", OwnerURL: "", OwnerType: Person},
-	"AGPL-3.0":                         {Identifier: "AGPL-3.0", Name: "GNU Affero General Public License 3.0", ShortName: "AGPL 3.0", Category: CopyLeft, Type: OpenSource, URL: "http://www.fsf.org/licensing/licenses/agpl-3.0.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"GFDL-1.1":                         {Identifier: "GFDL-1.1", Name: "GNU Free Documentation License 1.1", ShortName: "GFDL 1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.gnu.org/copyleft/fdl.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"GFDL-1.2":                         {Identifier: "GFDL-1.2", Name: "GNU Free Documentation License 1.2", ShortName: "GFDL 1.2", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.gnu.org/copyleft/fdl.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"GFDL-1.3":                         {Identifier: "GFDL-1.3", Name: "GNU Free Documentation License 1.3", ShortName: "GFDL 1.3", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.gnu.org/licenses/fdl-1.3.txt", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"GPL-1.0":                          {Identifier: "GPL-1.0", Name: "GNU General Public License 1.0", ShortName: "GPL 1.0", Category: CopyLeft, Type: OpenSource, URL: "http://www.gnu.org/licenses/gpl-1.0.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"GPL-1.0+":                         {Identifier: "GPL-1.0+", Name: "GNU General Public License 1.0 or later", ShortName: "GPL 1.0 or later", Category: CopyLeft, Type: OpenSource, URL: "http://www.gnu.org/licenses/old-licenses/gpl-1.0-standalone.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"GPL-2.0":                          {Identifier: "GPL-2.0", Name: "GNU General Public License 2.0", ShortName: "GPL 2.0", Category: CopyLeft, Type: OpenSource, URL: "http://www.gnu.org/licenses/gpl-2.0.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"GPL-2.0+":                         {Identifier: "GPL-2.0+", Name: "GNU General Public License 2.0 or later", ShortName: "GPL 2.0 or later", Category: CopyLeft, Type: OpenSource, URL: "http://www.gnu.org/licenses/old-licenses/gpl-2.0-standalone.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"GPL-2.0-with-autoconf-exception":  {Identifier: "GPL-2.0-with-autoconf-exception", Name: "GNU General Public License 2.0 with Autoconf exception", ShortName: "GPL 2.0 with autoconf exception", Category: CopyLeftLimited, Type: OpenSource, URL: "", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"GPL-2.0-with-bison-exception":     {Identifier: "GPL-2.0-with-bison-exception", Name: "GNU General Public License 2.0 with Bison exception", ShortName: "GPL 2.0 with Bison exception", Category: CopyLeftLimited, Type: OpenSource, URL: "", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"GPL-2.0-with-classpath-exception": {Identifier: "GPL-2.0-with-classpath-exception", Name: "GNU General Public License 2.0 with Classpath exception", ShortName: "GPL 2.0 with classpath exception", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.gnu.org/software/classpath/", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"eCos-2.0":                         {Identifier: "eCos-2.0", Name: "GNU General Public License 2.0 with eCos Exception", ShortName: "GPL 2.0 with eCos Exception", Category: CopyLeftLimited, Type: OpenSource, URL: "http://ecos.sourceware.org/ecos-license/", Owner: "eCos", OwnerURL: "http://ecos.sourceware.org/", OwnerType: Organization},
-	"GPL-2.0-with-font-exception":      {Identifier: "GPL-2.0-with-font-exception", Name: "GNU General Public License 2.0 with Font exception", ShortName: "GPL 2.0 with font exception", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.gnu.org/licenses/gpl-faq.html#FontException", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"GPL-2.0-with-GCC-exception":       {Identifier: "GPL-2.0-with-GCC-exception", Name: "GNU General Public License 2.0 with GCC Runtime Library exception", ShortName: "GPL 2.0 with GCC exception", Category: CopyLeftLimited, Type: OpenSource, URL: "", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"GPL-3.0":                          {Identifier: "GPL-3.0", Name: "GNU General Public License 3.0", ShortName: "GPL 3.0", Category: CopyLeft, Type: OpenSource, URL: "http://www.gnu.org/licenses/gpl-3.0.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"GPL-3.0+":                         {Identifier: "GPL-3.0+", Name: "GNU General Public License 3.0 or later", ShortName: "GPL 3.0 or later", Category: CopyLeft, Type: OpenSource, URL: "http://www.gnu.org/licenses/gpl-3.0-standalone.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"GPL-3.0-with-autoconf-exception":  {Identifier: "GPL-3.0-with-autoconf-exception", Name: "GNU General Public License 3.0 with Autoconf exception", ShortName: "GPL 3.0 with autoconf exception", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.gnu.org/licenses/autoconf-exception-3.0.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"LGPL-2.1":                         {Identifier: "LGPL-2.1", Name: "GNU Lesser General Public License 2.1", ShortName: "LGPL 2.1", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.gnu.org/licenses/lgpl-2.1.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"LGPL-2.1+":                        {Identifier: "LGPL-2.1+", Name: "GNU Lesser General Public License 2.1 or later", ShortName: "LGPL 2.1 or later", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.gnu.org/licenses/old-licenses/lgpl-2.1-standalone.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"LGPL-3.0":                         {Identifier: "LGPL-3.0", Name: "GNU Lesser General Public License 3.0", ShortName: "LGPL 3.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.gnu.org/licenses/lgpl-3.0.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"LGPL-3.0+":                        {Identifier: "LGPL-3.0+", Name: "GNU Lesser General Public License 3.0 or later", ShortName: "LGPL 3.0 or later", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.gnu.org/licenses/lgpl-3.0-standalone.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"LGPL-2.0":                         {Identifier: "LGPL-2.0", Name: "GNU Library General Public License 2.0", ShortName: "LGPL 2.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.gnu.org/licenses/old-licenses/lgpl-2.0.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"LGPL-2.0+":                        {Identifier: "LGPL-2.0+", Name: "GNU Library General Public License 2.0 or later", ShortName: "LGPL 2.0 or later", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.gnu.org/licenses/old-licenses/lgpl-2.0.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization},
-	"gnuplot":                          {Identifier: "gnuplot", Name: "gnuplot License", ShortName: "gnuplot License", Category: CopyLeftLimited, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing:Gnuplot?rd=Licensing/Gnuplot", Owner: "gnuplot Project", OwnerURL: "", OwnerType: "project"},
-	"gSOAP-1.3b":                       {Identifier: "gSOAP-1.3b", Name: "gSOAP Public License v1.3b", ShortName: "gSOAP Public License v1.3b", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.cs.fsu.edu/~engelen/license.html", Owner: "Genivia", OwnerURL: "http://www.genivia.com/", OwnerType: Organization},
-	"HaskellReport":                    {Identifier: "HaskellReport", Name: "Haskell Language Report License", ShortName: "Haskell Report License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/Haskell_Language_Report_License", Owner: "Simon Marlow", OwnerURL: "", OwnerType: Person},
-	"Spencer-99":                       {Identifier: "Spencer-99", Name: "Henry Spencer License 1999", ShortName: "Henry Spencer License 1999", Category: Permissive, Type: OpenSource, URL: "http://www.opensource.apple.com/source/tcl/tcl-5/tcl/generic/regfronts.c", Owner: "Henry Spencer", OwnerURL: "http://en.wikipedia.org/wiki/Henry_Spencer", OwnerType: Person},
-	"Spencer-94":                       {Identifier: "Spencer-94", Name: "Henry Spencer Regexp License", ShortName: "Henry Spencer Regexp License", Category: Permissive, Type: OpenSource, URL: "https://github.com/garyhouston/regex/blob/master/COPYRIGHT", Owner: "Henry Spencer", OwnerURL: "http://en.wikipedia.org/wiki/Henry_Spencer", OwnerType: Person},
-	"HPND":                             {Identifier: "HPND", Name: "Historical Permission Notice and Disclaimer", ShortName: "Historical Permission Notice and Disclaimer", Category: Permissive, Type: OpenSource, URL: "http://www.opensource.org/licenses/historical.php", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization},
-	"IBM-pibs":                         {Identifier: "IBM-pibs", Name: "IBM PowerPC Initialization and Boot Software", ShortName: "IBM PowerPC Software", Category: Permissive, Type: OpenSource, URL: "http://git.denx.de/?p=u-boot.git;a=blob;f=arch/powerpc/cpu/ppc4xx/miiphy.c;h=297155fdafa064b955e53e9832de93bfb0cfb85b;hb=9fab4bf4cc077c21e43941866f3f2c196f28670d", Owner: "IBM", OwnerURL: "http://www.ibm.com/developerworks/", OwnerType: Organization},
-	"IPL-1.0":                          {Identifier: "IPL-1.0", Name: "IBM Public License", ShortName: "IPL 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.opensource.org/licenses/ibmpl.php", Owner: "IBM", OwnerURL: "http://www.ibm.com/developerworks/", OwnerType: Organization},
-	"ICU":                              {Identifier: "ICU", Name: "ICU Composite License", ShortName: "ICU Composite License", Category: Permissive, Type: OpenSource, URL: "http://source.icu-project.org/repos/icu/icu/trunk/license.html", Owner: "IBM", OwnerURL: "http://www.ibm.com/developerworks/", OwnerType: Organization},
-	"ImageMagick":                      {Identifier: "ImageMagick", Name: "ImageMagick License", ShortName: "ImageMagick License", Category: Permissive, Type: OpenSource, URL: "http://www.imagemagick.org/script/license.php", Owner: "ImageMagick", OwnerURL: "http://www.imagemagick.org/script/index.php", OwnerType: Organization},
-	"Imlib2":                           {Identifier: "Imlib2", Name: "Imlib2 License", ShortName: "Imlib2 License", Category: CopyLeftLimited, Type: OpenSource, URL: "http://trac.enlightenment.org/e/browser/trunk/imlib2/COPYING", Owner: "Enlightenment", OwnerURL: "http://www.enlightenment.org/", OwnerType: Organization},
-	"IJG":                              {Identifier: "IJG", Name: "Independent JPEG Group License", ShortName: "JPEG License", Category: Permissive, Type: OpenSource, URL: "http://fedoraproject.org/wiki/Licensing/IJG", Owner: "IJG - Independent JPEG Group", OwnerURL: "http://www.ijg.org/", OwnerType: "project"},
-	"xpp":                              {Identifier: "xpp", Name: "Indiana University Extreme! Lab Software License Version 1.2", ShortName: "Indiana Extreme License 1.2", Category: Permissive, Type: OpenSource, URL: "http://www.extreme.indiana.edu/license.txt", Owner: "Indiana University", OwnerURL: "http://www.extreme.indiana.edu/", OwnerType: Organization},
-	"Info-ZIP":                         {Identifier: "Info-ZIP", Name: "Info-Zip License", ShortName: "Info-Zip License", Category: Permissive, Type: OpenSource, URL: "http://www.info-zip.org/doc/", Owner: "info-zip", OwnerURL: "http://www.info-zip.org/", OwnerType: "project"},
-	"Intel-ACPI":                       {Identifier: "Intel-ACPI", Name: "Intel ACPI Software License Agreement", ShortName: "Intel ACPI SLA", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/Intel_ACPI_Software_License_Agreement", Owner: "Intel Corporation", OwnerURL: "http://www.intel.com/about/index.htm?iid=gg_about-en_US+intel_aboutintel", OwnerType: Organization},
-	"Intel":                            {Identifier: "Intel", Name: "Intel BSD - Export Control", ShortName: "Intel BSD - Export Control", Category: Permissive, Type: OpenSource, URL: "http://opensource.org/licenses/intel-open-source-license.html", Owner: "Intel Corporation", OwnerURL: "http://www.intel.com/about/index.htm?iid=gg_about-en_US+intel_aboutintel", OwnerType: Organization},
-	"Interbase-1.0":                    {Identifier: "Interbase-1.0", Name: "Interbase Public License 1.0", ShortName: "Interbase Public License 1.0", Category: CopyLeft, Type: OpenSource, URL: "http://info.borland.com/devsupport/interbase/opensource/IPL.html", Owner: "Borland", OwnerURL: "http://www.borland.com/", OwnerType: Organization},
-	"IPA":                              {Identifier: "IPA", Name: "IPA Font License Agreement v1.0", ShortName: "IPA Font License 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://opensource.org/licenses/ipafont.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization},
-	"ISC":                              {Identifier: "ISC", Name: "ISC License", ShortName: "ISC License", Category: Permissive, Type: OpenSource, URL: "https://www.isc.org/software/license", Owner: "ISC - Internet Systems Consortium", OwnerURL: "https://www.isc.org/", OwnerType: Organization},
-	"JasPer-2.0":                       {Identifier: "JasPer-2.0", Name: "Jasper License 2.0", ShortName: "Jasper 2.0", Category: Permissive, Type: OpenSource, URL: "http://www.ece.uvic.ca/~frodo/jasper/LICENSE", Owner: "JasperSoft", OwnerURL: "http://www.jaspersoft.com/", OwnerType: Organization},
-	"JSON":                             {Identifier: "JSON", Name: "JSON License", ShortName: "JSON License", Category: Permissive, Type: OpenSource, URL: "http://www.json.org/license.html", Owner: "JSON.org", OwnerURL: "http://json.org/", OwnerType: "project"},
-	"Latex2e":                          {Identifier: "Latex2e", Name: "Latex2e License", ShortName: "Latex2e License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/Latex2e", Owner: "LaTeX", OwnerURL: "http://www.latex-project.org/", OwnerType: Organization},
-	"LPPL-1.0":                         {Identifier: "LPPL-1.0", Name: "LaTeX Project Public License v1.0", ShortName: "LPPL 1.0", Category: CopyLeft, Type: OpenSource, URL: "http://www.latex-project.org/lppl/lppl-1-0.txt", Owner: "LaTeX", OwnerURL: "http://www.latex-project.org/", OwnerType: Organization},
-	"LPPL-1.1":                         {Identifier: "LPPL-1.1", Name: "LaTeX Project Public License v1.1", ShortName: "LPPL 1.1", Category: CopyLeft, Type: OpenSource, URL: "http://www.latex-project.org/lppl/lppl-1-1.txt", Owner: "LaTeX", OwnerURL: "http://www.latex-project.org/", OwnerType: Organization},
-	"LPPL-1.2":                         {Identifier: "LPPL-1.2", Name: "LaTeX Project Public License v1.2", ShortName: "LPPL 1.2", Category: CopyLeft, Type: OpenSource, URL: "http://www.latex-project.org/lppl/lppl-1-2.txt", Owner: "LaTeX", OwnerURL: "http://www.latex-project.org/", OwnerType: Organization},
-	"LPPL-1.3a":                        {Identifier: "LPPL-1.3a", Name: "LaTeX Project Public License v1.3a", ShortName: "LPPL 1.3a", Category: CopyLeft, Type: OpenSource, URL: "http://www.latex-project.org/lppl/lppl-1-3a.txt", Owner: "LaTeX", OwnerURL: "http://www.latex-project.org/", OwnerType: Organization},
-	"LPPL-1.3c":                        {Identifier: "LPPL-1.3c", Name: "LaTeX Project Public License v1.3c", ShortName: "LPPL 1.3c", Category: CopyLeft, Type: OpenSource, URL: "http://www.latex-project.org/lppl/lppl-1-3c.txt", Owner: "LaTeX", OwnerURL: "http://www.latex-project.org/", OwnerType: Organization},
-	"BSD-3-Clause-LBNL":                {Identifier: "BSD-3-Clause-LBNL", Name: "Lawrence Berkeley National Labs BSD variant license", ShortName: "LBNL BSD Variant", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing:LBNLBSD?rd=Licensing/LBNLBSD", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization},
-	"Leptonica":                        {Identifier: "Leptonica", Name: "Leptonica License", ShortName: "Leptonica License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/Leptonica", Owner: "Leptonica", OwnerURL: "http://www.leptonica.com/", OwnerType: Organization},
-	"LGPLLR":                           {Identifier: "LGPLLR", Name: "Lesser General Public License For Linguistic Resources", ShortName: "LGPLLR", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www-igm.univ-mlv.fr/~unitex/lgpllr.html", Owner: "Unitex GramLab", OwnerURL: "http://www-igm.univ-mlv.fr/~unitex/", OwnerType: Organization},
-	"Libpng":                           {Identifier: "Libpng", Name: "Libpng License", ShortName: "Libpng License", Category: Permissive, Type: OpenSource, URL: "http://www.libpng.org/pub/png/libpng.html", Owner: "libpng", OwnerURL: "http://www.libpng.org/", OwnerType: Organization},
-	"LAL-1.2":                          {Identifier: "LAL-1.2", Name: "Licence Art Libre 1.2", ShortName: "Licence Art Libre 1.2", Category: CopyLeft, Type: OpenSource, URL: "http://artlibre.org/licence/lal/licence-art-libre-12/", Owner: "Licence Art Libre", OwnerURL: "http://artlibre.org/", OwnerType: Organization},
-	"LAL-1.3":                          {Identifier: "LAL-1.3", Name: "Licence Art Libre 1.3", ShortName: "Licence Art Libre 1.3", Category: CopyLeft, Type: OpenSource, URL: "http://artlibre.org/", Owner: "Licence Art Libre", OwnerURL: "http://artlibre.org/", OwnerType: Organization},
-	"LiLiQ-P-1.1":                      {Identifier: "LiLiQ-P-1.1", Name: "Licence Libre du Québec – Permissive version 1.1", ShortName: "LiLiQ-P-1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "https://opensource.org/licenses/LiLiQ-P-1.1", Owner: "Quebec", OwnerURL: "", OwnerType: Organization},
-	"LiLiQ-Rplus-1.1":                  {Identifier: "LiLiQ-Rplus-1.1", Name: "Licence Libre du Québec – Réciprocité forte version 1.1", ShortName: "LiLiQ-Rplus-1.1", Category: CopyLeft, Type: OpenSource, URL: "http://opensource.org/licenses/LiLiQ-Rplus-1.1", Owner: "Quebec", OwnerURL: "", OwnerType: Organization},
-	"LiLiQ-R-1.1":                      {Identifier: "LiLiQ-R-1.1", Name: "Licence Libre du Québec – Réciprocité version 1.", ShortName: "LiLiQ-R-1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "https://opensource.org/licenses/LiLiQ-R-1.1", Owner: "Quebec", OwnerURL: "", OwnerType: Organization},
-	"LPL-1.0":                          {Identifier: "LPL-1.0", Name: "Lucent Public License 1.0", ShortName: "Lucent Public License 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.opensource.org/licenses/plan9.php", Owner: "Alcatel-Lucent", OwnerURL: "http://www.alcatel-lucent.com/", OwnerType: Organization},
-	"LPL-1.02":                         {Identifier: "LPL-1.02", Name: "Lucent Public License 1.02", ShortName: "Lucent Public License 1.02", Category: CopyLeftLimited, Type: OpenSource, URL: "http://plan9.bell-labs.com/plan9/license.html", Owner: "Alcatel-Lucent", OwnerURL: "http://www.alcatel-lucent.com/", OwnerType: Organization},
-	"MakeIndex":                        {Identifier: "MakeIndex", Name: "MakeIndex Distribution Notice", ShortName: "MakeIndex Distribution Notice", Category: CopyLeft, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/MakeIndex", Owner: "MakeIndex Project", OwnerURL: "", OwnerType: "project"},
-	"MTLL":                             {Identifier: "MTLL", Name: "Matrix Template Library License", ShortName: "Matrix Template Library License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/Matrix_Template_Library_License", Owner: "Indiana University", OwnerURL: "http://www.extreme.indiana.edu/", OwnerType: Organization},
-	"MS-PL":                            {Identifier: "MS-PL", Name: "Microsoft Public License", ShortName: "MS-PL", Category: Permissive, Type: OpenSource, URL: "http://www.microsoft.com/opensource/licenses.mspx#Ms-PL", Owner: "Microsoft", OwnerURL: "http://msdn.microsoft.com/", OwnerType: Organization},
-	"MS-RL":                            {Identifier: "MS-RL", Name: "Microsoft Reciprocal License", ShortName: "MS-RL", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.microsoft.com/opensource/licenses.mspx#Ms-RL", Owner: "Microsoft", OwnerURL: "http://msdn.microsoft.com/", OwnerType: Organization},
-	"MirOS":                            {Identifier: "MirOS", Name: "MirOS License", ShortName: "MirOS License", Category: Permissive, Type: OpenSource, URL: "https://www.mirbsd.org/MirOS-Licence", Owner: "MirOS Project", OwnerURL: "https://www.mirbsd.org/", OwnerType: "project"},
-	"MIT-feh":                          {Identifier: "MIT-feh", Name: "MIT Acknowledgment License", ShortName: "MIT Acknowledgment License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing:MIT?rd=Licensing/MIT#feh", Owner: "Unspecified", OwnerURL: "", OwnerType: "project"},
-	"MIT":                              {Identifier: "MIT", Name: "MIT License", ShortName: "MIT License", Category: Permissive, Type: OpenSource, URL: "http://opensource.org/licenses/mit-license.php", Owner: "MIT", OwnerURL: "http://web.mit.edu/aboutmit/", OwnerType: Organization},
-	"Xerox":                            {Identifier: "Xerox", Name: "MIT with Export Control", ShortName: "MIT with Export Control", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing:Xerox?rd=Licensing/Xerox", Owner: "Xerox PARC", OwnerURL: "http://www.parc.com/", OwnerType: Organization},
-	"MITNFA":                           {Identifier: "MITNFA", Name: "MIT with no false attribution License", ShortName: "MIT no false attribution License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/MITNFA", Owner: "npm Registry", OwnerURL: "https://npmjs.org/", OwnerType: "project"},
-	"Motosoto":                         {Identifier: "Motosoto", Name: "Motosoto Open Source License v0.9.1", ShortName: "Motosoto 0.9.1", Category: CopyLeft, Type: OpenSource, URL: "http://opensource.org/licenses/motosoto.php", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization},
-	"MPL-1.0":                          {Identifier: "MPL-1.0", Name: "Mozilla Public License 1.0", ShortName: "MPL 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.mozilla.org/MPL/MPL-1.0.html", Owner: "Mozilla", OwnerURL: "http://www.mozilla.org/", OwnerType: Organization},
-	"MPL-1.1":                          {Identifier: "MPL-1.1", Name: "Mozilla Public License 1.1", ShortName: "MPL 1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.mozilla.org/MPL/MPL-1.1.html", Owner: "Mozilla", OwnerURL: "http://www.mozilla.org/", OwnerType: Organization},
-	"MPL-2.0":                          {Identifier: "MPL-2.0", Name: "Mozilla Public License 2.0", ShortName: "MPL 2.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://mpl.mozilla.org/2012/01/03/announcing-mpl-2-0/", Owner: "Mozilla", OwnerURL: "http://www.mozilla.org/", OwnerType: Organization},
-	"MPL-2.0-no-copyleft-exception":    {Identifier: "MPL-2.0-no-copyleft-exception", Name: "Mozilla Public License 2.0 (no copyleft exception)", ShortName: "MPL 2.0 with no copyleft exception", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.mozilla.org/MPL/2.0/", Owner: "Mozilla", OwnerURL: "http://www.mozilla.org/", OwnerType: Organization},
-	"mpich2":                           {Identifier: "mpich2", Name: "MPICH License", ShortName: "MPICH License", Category: Permissive, Type: OpenSource, URL: "https://svn.mcs.anl.gov/repos/mpi/mpich2/trunk/COPYRIGHT", Owner: "University of Chicago", OwnerURL: "http://www.uchicago.edu/", OwnerType: Organization},
-	"Multics":                          {Identifier: "Multics", Name: "Multics License", ShortName: "Multics License", Category: Permissive, Type: OpenSource, URL: "http://www.multicians.org/", Owner: "Multics", OwnerURL: "http://www.multicians.org/", OwnerType: Organization},
-	"Mup":                              {Identifier: "Mup", Name: "Mup License", ShortName: "Mup License", Category: Permissive, Type: OpenSource, URL: "http://www.arkkra.com/doc/license.html", Owner: "Arkkra Enterprises", OwnerURL: "http://www.arkkra.com/", OwnerType: Organization},
-	"NASA-1.3":                         {Identifier: "NASA-1.3", Name: "NASA Open Source License v1.3", ShortName: "NASA 1.3", Category: CopyLeftLimited, Type: OpenSource, URL: "http://opensource.arc.nasa.gov/static/html/NASA_Open_Source_Agreement_1.3.txt", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization},
-	"Naumen":                           {Identifier: "Naumen", Name: "NAUMEN Public License", ShortName: "NAUMEN Public License", Category: Permissive, Type: OpenSource, URL: "http://www.opensource.org/licenses/naumen.php", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization},
-	"NBPL-1.0":                         {Identifier: "NBPL-1.0", Name: "Net Boolean Public License 1.0", ShortName: "NBPL-1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=37b4b3f6cc4bf34e1d3dec61e69914b9819d8894", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization},
-	"NetCDF":                           {Identifier: "NetCDF", Name: "NetCDF License", ShortName: "NetCDF License", Category: Permissive, Type: OpenSource, URL: "http://www.unidata.ucar.edu/software/netcdf/copyright.html", Owner: "Unidata", OwnerURL: "http://www.unidata.ucar.edu/", OwnerType: Organization},
-	"NGPL":                             {Identifier: "NGPL", Name: "Nethack General Public License", ShortName: "Nethack General Public License", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.nethack.org/common/license.html", Owner: "NetHack", OwnerURL: "http://www.nethack.org/", OwnerType: "project"},
-	"NOSL":                             {Identifier: "NOSL", Name: "Netizen Open Source License 1.0", ShortName: "NOSL 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://bits.netizen.com.au/licenses/NOSL/", Owner: "Netizen", OwnerURL: "http://www.netizen.com.au/", OwnerType: Organization},
-	"NPL-1.0":                          {Identifier: "NPL-1.0", Name: "Netscape Public License 1.0", ShortName: "NPL 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.mozilla.org/MPL/NPL-1.0.html", Owner: "Mozilla", OwnerURL: "http://www.mozilla.org/", OwnerType: Organization},
-	"NPL-1.1":                          {Identifier: "NPL-1.1", Name: "Netscape Public License 1.1", ShortName: "NPL 1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.mozilla.org/MPL/NPL-1.1.html", Owner: "Mozilla", OwnerURL: "http://www.mozilla.org/", OwnerType: Organization},
-	"NTP":                              {Identifier: "NTP", Name: "Network Time Protocol License", ShortName: "NTP License", Category: Permissive, Type: OpenSource, URL: "https://www.eecis.udel.edu/~mills/ntp/html/copyright.html", Owner: "University of Delaware", OwnerURL: "http://www.eecis.udel.edu/~mills/ntp/html/copyright.html", OwnerType: Organization},
-	"Newsletr":                         {Identifier: "Newsletr", Name: "Newsletr License", ShortName: "Newsletr License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/Newsletr", Owner: "Hunter Goatley", OwnerURL: "", OwnerType: Person},
-	"Nokia":                            {Identifier: "Nokia", Name: "Nokia Open Source License 1.0a", ShortName: "NOKOS License 1.0a", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.opensource.org/licenses/nokia.html", Owner: "Nokia", OwnerURL: "http://www.nokia.com/about-nokia", OwnerType: Organization},
-	"NLPL":                             {Identifier: "NLPL", Name: "No Limit Public License", ShortName: "NLPL", Category: PublicDomain, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/NLPL", Owner: "Gilles Lamiral", OwnerURL: "", OwnerType: Person},
-	"NPOSL-3.0":                        {Identifier: "NPOSL-3.0", Name: "Non-Profit Open Software License 3.0", ShortName: "Non-Profit OSL 3.0", Category: CopyLeft, Type: OpenSource, URL: "http://www.opensource.org/licenses/NOSL3.0.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization},
-	"NLOD-1.0":                         {Identifier: "NLOD-1.0", Name: "Norwegian Licence for Open Government Data", ShortName: "NLOD-1.0", Category: Permissive, Type: OpenSource, URL: "http://data.norge.no/nlod/en/1.0", Owner: "Norway", OwnerURL: "http://data.norge.no/", OwnerType: Organization},
-	"Noweb":                            {Identifier: "Noweb", Name: "Noweb License", ShortName: "Noweb License", Category: CopyLeftLimited, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing:Noweb?rd=Licensing/Noweb", Owner: "Norman Ramsey", OwnerURL: "", OwnerType: Person},
-	"NRL":                              {Identifier: "NRL", Name: "NRL License", ShortName: "NRL License", Category: Permissive, Type: OpenSource, URL: "http://web.mit.edu/network/isakmp/nrllicense.html", Owner: "NRL", OwnerURL: "http://www.nrl.navy.mil/", OwnerType: Organization},
-	"Nunit":                            {Identifier: "Nunit", Name: "NUnit v2 License", ShortName: "NUnit v2 License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing:Nunit?rd=Licensing/Nunit", Owner: "Charlie Poole", OwnerURL: "", OwnerType: Person},
-	"OCLC-2.0":                         {Identifier: "OCLC-2.0", Name: "OCLC Research Public License 2.0", ShortName: "OCLC Research Public License 2.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://opensource.org/licenses/oclc2.php", Owner: "OCLC Research", OwnerURL: "http://www.oclc.org/research/default.htm", OwnerType: Organization},
-	"ODbL-1.0":                         {Identifier: "ODbL-1.0", Name: "ODC Open Database License v1.0", ShortName: "ODbL 1.0", Category: CopyLeft, Type: OpenSource, URL: "http://www.opendatacommons.org/licenses/odbl/1.0/", Owner: "Open Data Commons", OwnerURL: "http://opendatacommons.org/about/", OwnerType: Organization},
-	"OCCT-PL":                          {Identifier: "OCCT-PL", Name: "Open CASCADE Technology Public License", ShortName: "OCCT-PL", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.opencascade.com/content/occt-public-license", Owner: "Open Cascade", OwnerURL: "http://www.opencascade.com/", OwnerType: Organization},
-	"OFL-1.0":                          {Identifier: "OFL-1.0", Name: "Open Font License 1.0", ShortName: "OFL 1.0", Category: FreeRestricted, Type: OpenSource, URL: "http://scripts.sil.org/cms/scripts/page.php?item_id=OFL10_web", Owner: "SIL International", OwnerURL: "http://scripts.sil.org/cms/scripts/page.php?item_id=OFL_web", OwnerType: Organization},
-	"OFL-1.1":                          {Identifier: "OFL-1.1", Name: "Open Font License 1.1", ShortName: "OFL 1.1", Category: FreeRestricted, Type: OpenSource, URL: "http://scripts.sil.org/cms/scripts/page.php?item_id=OFL_web", Owner: "SIL International", OwnerURL: "http://scripts.sil.org/cms/scripts/page.php?item_id=OFL_web", OwnerType: Organization},
-	"OGTSL":                            {Identifier: "OGTSL", Name: "Open Group Test Suite License", ShortName: "Open Group Test Suite License", Category: CopyLeftLimited, Type: OpenSource, URL: "http://opensource.org/licenses/opengroup.php", Owner: "Open Group", OwnerURL: "http://www.opengroup.org/", OwnerType: Organization},
-	"OLDAP-1.1":                        {Identifier: "OLDAP-1.1", Name: "OpenLDAP Public License 1.1", ShortName: "OpenLDAP Public License 1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=806557a5ad59804ef3a44d5abfbe91d706b0791f", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization},
-	"OLDAP-1.2":                        {Identifier: "OLDAP-1.2", Name: "OpenLDAP Public License 1.2", ShortName: "OpenLDAP Public License 1.2", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=42b0383c50c299977b5893ee695cf4e486fb0dc7", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization},
-	"OLDAP-1.3":                        {Identifier: "OLDAP-1.3", Name: "OpenLDAP Public License 1.3", ShortName: "OpenLDAP Public License 1.3", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=e5f8117f0ce088d0bd7a8e18ddf37eaa40eb09b1", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization},
-	"OLDAP-1.4":                        {Identifier: "OLDAP-1.4", Name: "OpenLDAP Public License 1.4", ShortName: "OpenLDAP Public License 1.4", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=c9f95c2f3f2ffb5e0ae55fe7388af75547660941", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization},
-	"OLDAP-2.0":                        {Identifier: "OLDAP-2.0", Name: "OpenLDAP Public License 2.0", ShortName: "OpenLDAP Public License 2.0", Category: Permissive, Type: OpenSource, URL: "http://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=cbf50f4e1185a21abd4c0a54d3f4341fe28f36ea", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization},
-	"OLDAP-2.0.1":                      {Identifier: "OLDAP-2.0.1", Name: "OpenLDAP Public License 2.0.1", ShortName: "OpenLDAP Public License 2.0.1", Category: Permissive, Type: OpenSource, URL: "http://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=b6d68acd14e51ca3aab4428bf26522aa74873f0e", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization},
-	"OLDAP-2.1":                        {Identifier: "OLDAP-2.1", Name: "OpenLDAP Public License 2.1", ShortName: "OpenLDAP Public License 2.1", Category: Permissive, Type: OpenSource, URL: "http://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=b0d176738e96a0d3b9f85cb51e140a86f21be715", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization},
-	"OLDAP-2.2":                        {Identifier: "OLDAP-2.2", Name: "OpenLDAP Public License 2.2", ShortName: "OpenLDAP Public License 2.2", Category: Permissive, Type: OpenSource, URL: "http://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=470b0c18ec67621c85881b2733057fecf4a1acc3", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization},
-	"OLDAP-2.2.1":                      {Identifier: "OLDAP-2.2.1", Name: "OpenLDAP Public License 2.2.1", ShortName: "OpenLDAP Public License 2.2.1", Category: Permissive, Type: OpenSource, URL: "http://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=4bc786f34b50aa301be6f5600f58a980070f481e", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization},
-	"OLDAP-2.2.2":                      {Identifier: "OLDAP-2.2.2", Name: "OpenLDAP Public License 2.2.2", ShortName: "OpenLDAP Public License 2.2.2", Category: Permissive, Type: OpenSource, URL: "http://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=df2cc1e21eb7c160695f5b7cffd6296c151ba188", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization},
-	"OLDAP-2.3":                        {Identifier: "OLDAP-2.3", Name: "OpenLDAP Public License 2.3", ShortName: "OpenLDAP Public License 2.3", Category: Permissive, Type: OpenSource, URL: "http://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=d32cf54a32d581ab475d23c810b0a7fbaf8d63c3", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization},
-	"OLDAP-2.4":                        {Identifier: "OLDAP-2.4", Name: "OpenLDAP Public License 2.4", ShortName: "OpenLDAP Public License 2.4", Category: Permissive, Type: OpenSource, URL: "http://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=cd1284c4a91a8a380d904eee68d1583f989ed386", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization},
-	"OLDAP-2.5":                        {Identifier: "OLDAP-2.5", Name: "OpenLDAP Public License 2.5", ShortName: "OpenLDAP Public License 2.5", Category: Permissive, Type: OpenSource, URL: "http://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=6852b9d90022e8593c98205413380536b1b5a7cf", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization},
-	"OLDAP-2.6":                        {Identifier: "OLDAP-2.6", Name: "OpenLDAP Public License 2.6", ShortName: "OpenLDAP Public License 2.6", Category: Permissive, Type: OpenSource, URL: "http://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=1cae062821881f41b73012ba816434897abf4205", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization},
-	"OLDAP-2.7":                        {Identifier: "OLDAP-2.7", Name: "OpenLDAP Public License 2.7", ShortName: "OpenLDAP Public License 2.7", Category: Permissive, Type: OpenSource, URL: "http://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=47c2415c1df81556eeb39be6cad458ef87c534a2", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization},
-	"OLDAP-2.8":                        {Identifier: "OLDAP-2.8", Name: "OpenLDAP Public License 2.8", ShortName: "OpenLDAP Public License 2.8", Category: Permissive, Type: OpenSource, URL: "http://www.openldap.org/software/release/license.html", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization},
-	"OPL-1.0":                          {Identifier: "OPL-1.0", Name: "Open Public License v1.0", ShortName: "Open Public License 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://ksoap.objectweb.org/software/license/opl.html", Owner: "Lutris Technologies, Inc.", OwnerURL: "http://www.lutris.com/", OwnerType: Organization},
-	"OSL-1.0":                          {Identifier: "OSL-1.0", Name: "Open Software License 1.0", ShortName: "OSL 1.0", Category: CopyLeft, Type: OpenSource, URL: "http://www.opensource.org/licenses/osl-1.0.txt", Owner: "Lawrence Rosen", OwnerURL: "http://www.rosenlaw.com/rosen.htm", OwnerType: Person},
-	"OSL-1.1":                          {Identifier: "OSL-1.1", Name: "Open Software License 1.1", ShortName: "OSL 1.1", Category: CopyLeft, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing:OSL1.1?rd=Licensing/OSL1.1", Owner: "Lawrence Rosen", OwnerURL: "http://www.rosenlaw.com/rosen.htm", OwnerType: Person},
-	"OSL-2.0":                          {Identifier: "OSL-2.0", Name: "Open Software License 2.0", ShortName: "OSL 2.0", Category: CopyLeft, Type: OpenSource, URL: "http://www.nexb.com/license/LICENSE-OSL-2.0.html", Owner: "Lawrence Rosen", OwnerURL: "http://www.rosenlaw.com/rosen.htm", OwnerType: Person},
-	"OSL-2.1":                          {Identifier: "OSL-2.1", Name: "Open Software License 2.1", ShortName: "OSL 2.1", Category: CopyLeft, Type: OpenSource, URL: "http://www.nexb.com/license/LICENSE-OSL-2.1.html", Owner: "Lawrence Rosen", OwnerURL: "http://www.rosenlaw.com/rosen.htm", OwnerType: Person},
-	"OSL-3.0":                          {Identifier: "OSL-3.0", Name: "Open Software License 3.0", ShortName: "OSL 3.0", Category: CopyLeft, Type: OpenSource, URL: "http://www.opensource.org/licenses/osl-3.0.php", Owner: "Lawrence Rosen", OwnerURL: "http://www.rosenlaw.com/rosen.htm", OwnerType: Person},
-	"OpenSSL":                          {Identifier: "OpenSSL", Name: "OpenSSL/SSLeay License", ShortName: "OpenSSL/SSLeay License", Category: Permissive, Type: OpenSource, URL: "http://www.openssl.org/source/license.html", Owner: "OpenSSL", OwnerURL: "http://www.openssl.org/about/", OwnerType: Organization},
-	"OSET-PL-2.1":                      {Identifier: "OSET-PL-2.1", Name: "OSET Public License version 2.1", ShortName: "OSET-PL-2.1", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.osetfoundation.org/public-license/", Owner: "OSET Foundation", OwnerURL: "http://www.osetfoundation.org/about", OwnerType: Organization},
-	"PHP-3.0":                          {Identifier: "PHP-3.0", Name: "PHP License 3.0", ShortName: "PHP License 3.0", Category: Permissive, Type: OpenSource, URL: "http://www.php.net/license/", Owner: "PHP Project", OwnerURL: "http://www.php.net/", OwnerType: "project"},
-	"PHP-3.01":                         {Identifier: "PHP-3.01", Name: "PHP License 3.01", ShortName: "PHP License 3.01", Category: Permissive, Type: OpenSource, URL: "http://www.php.net/license/3_01.txt", Owner: "PHP Project", OwnerURL: "http://www.php.net/", OwnerType: "project"},
-	"PostgreSQL":                       {Identifier: "PostgreSQL", Name: "PostgreSQL License", ShortName: "PostgreSQL License", Category: Permissive, Type: OpenSource, URL: "http://www.postgresql.org/about/licence", Owner: "PostgreSQL", OwnerURL: "http://www.postgresql.org/about/", OwnerType: Organization},
-	"psfrag":                           {Identifier: "psfrag", Name: "psfrag License", ShortName: "psfrag License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/psfrag", Owner: "Craig Barratt", OwnerURL: "", OwnerType: Person},
-	"psutils":                          {Identifier: "psutils", Name: "PS Utilities License", ShortName: "PS Utilities License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing:Psutils?rd=Licensing/psutils", Owner: "Angus J. C. Duggan", OwnerURL: "", OwnerType: Person},
-	"PDDL-1.0":                         {Identifier: "PDDL-1.0", Name: "Public Domain Dedication & Licence (PDDL)", ShortName: "PDDL 1.0", Category: PublicDomain, Type: OpenSource, URL: "http://opendatacommons.org/licenses/pddl/1-0/", Owner: "Open Data Commons", OwnerURL: "http://opendatacommons.org/about/", OwnerType: Organization},
-	"Python-2.0":                       {Identifier: "Python-2.0", Name: "Python Software Foundation License v2", ShortName: "Python License 2.0", Category: Permissive, Type: OpenSource, URL: "http://docs.python.org/license.html", Owner: "Python Software Foundation (PSF)", OwnerURL: "http://www.python.org/psf/", OwnerType: Organization},
-	"Qhull":                            {Identifier: "Qhull", Name: "Qhull License", ShortName: "Qhull License", Category: CopyLeftLimited, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing:Qhull?rd=Licensing/Qhull", Owner: "Geometry Center", OwnerURL: "", OwnerType: Organization},
-	"QPL-1.0":                          {Identifier: "QPL-1.0", Name: "Q Public License Version 1.0", ShortName: "QPL 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://doc.trolltech.com/4.0/qpl.html", Owner: "Trolltech", OwnerURL: "http://doc.trolltech.com/4.0/trolltech.html", OwnerType: Organization},
-	"Rdisc":                            {Identifier: "Rdisc", Name: "Rdisc License", ShortName: "Rdisc License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/Rdisc_License", Owner: "Oracle (Sun)", OwnerURL: "http://www.oracle.com/us/sun/index.html", OwnerType: Organization},
-	"RPSL-1.0":                         {Identifier: "RPSL-1.0", Name: "RealNetworks Public Source License v1.0", ShortName: "RealNetworks Public Source License 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://opensource.org/licenses/real.php", Owner: "RealNetworks", OwnerURL: "http://www.realnetworks.com/", OwnerType: Organization},
-	"RPL-1.1":                          {Identifier: "RPL-1.1", Name: "Reciprocal Public License 1.1", ShortName: "RPL 1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "http://opensource.org/licenses/RPL-1.1", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization},
-	"RPL-1.5":                          {Identifier: "RPL-1.5", Name: "Reciprocal Public License 1.5", ShortName: "RPL 1.5", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.opensource.org/licenses/rpl1.5.txt", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization},
-	"RHeCos-1.1":                       {Identifier: "RHeCos-1.1", Name: "Red Hat eCos Public License 1.1", ShortName: "Red Hat eCos Public License 1.1", Category: CopyLeft, Type: OpenSource, URL: "http://ecos.sourceware.org/old-license.html", Owner: "Red Hat", OwnerURL: "http://www.redhat.com/", OwnerType: Organization},
-	"Spencer-86":                       {Identifier: "Spencer-86", Name: "Regexp License", ShortName: "Regexp License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/Henry_Spencer_Reg-Ex_Library_License", Owner: "Henry Spencer", OwnerURL: "http://en.wikipedia.org/wiki/Henry_Spencer", OwnerType: Person},
-	"RSCPL":                            {Identifier: "RSCPL", Name: "Ricoh Source Code Public License v1.0", ShortName: "Ricoh Source Code Public License 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://opensource.org/licenses/ricohpl.php", Owner: "Ricoh Global", OwnerURL: "http://www.ricoh.com/", OwnerType: Organization},
-	"RSA-MD":                           {Identifier: "RSA-MD", Name: "RSA Data Security MD5", ShortName: "RSA-MD5 License", Category: Permissive, Type: OpenSource, URL: "http://www.faqs.org/rfcs/rfc1321.html", Owner: "RSA (the Security Division of EMC)", OwnerURL: "http://www.rsa.com/rsalabs/node.asp?id=2253", OwnerType: Organization},
-	"Ruby":                             {Identifier: "Ruby", Name: "Ruby License", ShortName: "Ruby License", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.ruby-lang.org/en/LICENSE.txt", Owner: "Ruby", OwnerURL: "http://www.ruby-lang.org/en/", OwnerType: "project"},
-	"Saxpath":                          {Identifier: "Saxpath", Name: "Saxpath License", ShortName: "Saxpath License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/Saxpath_License", Owner: "Codehaus", OwnerURL: "http://codehaus.org/", OwnerType: Organization},
-	"SAX-PD":                           {Identifier: "SAX-PD", Name: "SAX Public Domain Notice", ShortName: "SAX-PD", Category: PublicDomain, Type: OpenSource, URL: "http://www.saxproject.org/copying.html", Owner: "SAX Project", OwnerURL: "http://www.saxproject.org/", OwnerType: "project"},
-	"SCEA":                             {Identifier: "SCEA", Name: "SCEA Shared Source License 1.0", ShortName: "SCEA Shared Source License 1.0", Category: Permissive, Type: OpenSource, URL: "http://research.scea.com/scea_shared_source_license.html", Owner: "Sony Computer Entertainment", OwnerURL: "http://www.playstation.com/en-us/", OwnerType: Organization},
-	"SWL":                              {Identifier: "SWL", Name: "Scheme Widget Library (SWL) Software License Agreement", ShortName: "Scheme Widget Library (SWL) Software License", Category: Permissive, Type: OpenSource, URL: "http://www.scheme.com/download/swl-lic.html", Owner: "Cadence Research Systems", OwnerURL: "http://www.scheme.com/", OwnerType: Organization},
-	"SMPPL":                            {Identifier: "SMPPL", Name: "Secure Messaging Protocol Public License", ShortName: "SMPPL", Category: CopyLeftLimited, Type: OpenSource, URL: "https://github.com/dcblake/SMP/blob/master/Documentation/License.txt", Owner: "National Security Agency", OwnerURL: "", OwnerType: Organization},
-	"Sendmail":                         {Identifier: "Sendmail", Name: "Sendmail License", ShortName: "Sendmail License", Category: Permissive, Type: OpenSource, URL: "http://www.sendmail.com/", Owner: "Sendmail", OwnerURL: "http://www.sendmail.com/", OwnerType: Organization},
-	"iMatix":                           {Identifier: "iMatix", Name: "SFL License Agreement", ShortName: "SFL License Agreement", Category: Permissive, Type: OpenSource, URL: "http://legacy.imatix.com/html/sfl/sfl4.htm", Owner: "iMatix", OwnerURL: "http://www.imatix.com/", OwnerType: Organization},
-	"SGI-B-1.0":                        {Identifier: "SGI-B-1.0", Name: "SGI Free Software License B v1.0", ShortName: "SGI Free Software License B 1.0", Category: FreeRestricted, Type: OpenSource, URL: "http://oss.sgi.com/projects/FreeB/SGIFreeSWLicB.1.0.html", Owner: "SGI - Silicon Graphics", OwnerURL: "http://www.sgi.com/company_info/", OwnerType: Organization},
-	"SGI-B-1.1":                        {Identifier: "SGI-B-1.1", Name: "SGI Free Software License B v1.1", ShortName: "SGI Free Software License B 1.1", Category: Permissive, Type: OpenSource, URL: "http://oss.sgi.com/projects/FreeB/SGIFreeSWLicB.1.1.doc", Owner: "SGI - Silicon Graphics", OwnerURL: "http://www.sgi.com/company_info/", OwnerType: Organization},
-	"SGI-B-2.0":                        {Identifier: "SGI-B-2.0", Name: "SGI Free Software License B v2.0", ShortName: "SGI Free Software License B 2.0", Category: Permissive, Type: OpenSource, URL: "http://oss.sgi.com/projects/FreeB/SGIFreeSWLicB.2.0.pdf", Owner: "SGI - Silicon Graphics", OwnerURL: "http://www.sgi.com/company_info/", OwnerType: Organization},
-	"SimPL-2.0":                        {Identifier: "SimPL-2.0", Name: "Simple Public License Version 2.0", ShortName: "SimPL 2.0", Category: CopyLeft, Type: OpenSource, URL: "http://www.opensource.org/licenses/simpl-2.0.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization},
-	"Sleepycat":                        {Identifier: "Sleepycat", Name: "Sleepycat License (Berkeley Database License)", ShortName: "Sleepycat License", Category: CopyLeft, Type: OpenSource, URL: "http://www.oracle.com/technology/software/products/berkeley-db/htdocs/oslicense.html", Owner: "Oracle Corporation", OwnerURL: "http://www.oracle.com/index.html", OwnerType: Organization},
-	"SNIA":                             {Identifier: "SNIA", Name: "SNIA Public License 1.1", ShortName: "SNIA Public License 1.1", Category: CopyLeft, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/SNIA_Public_License", Owner: "SNIA", OwnerURL: "http://www.snia.org/", OwnerType: Organization},
-	"StandardML-NJ":                    {Identifier: "StandardML-NJ", Name: "Standard ML of New Jersey", ShortName: "Standard ML of New Jersey", Category: Permissive, Type: OpenSource, URL: "http://www.smlnj.org//license.html", Owner: "Alcatel-Lucent", OwnerURL: "http://www.alcatel-lucent.com/", OwnerType: Organization},
-	"SugarCRM-1.1.3":                   {Identifier: "SugarCRM-1.1.3", Name: "SugarCRM Public License v1.1.3", ShortName: "SugarCRM Public License 1.1.3", Category: CopyLeft, Type: OpenSource, URL: "http://www.sugarcrm.com/crm/SPL", Owner: "SugarCRM", OwnerURL: "http://www.sugarcrm.com/crm/", OwnerType: Organization},
-	"SISSL":                            {Identifier: "SISSL", Name: "Sun Industry Standards Source License 1.1", ShortName: "Sun Industry Standards Source License 1.1", Category: ProprietaryFree, Type: Proprietary, URL: "http://www.openoffice.org/licenses/sissl_license.html", Owner: "Oracle (Sun)", OwnerURL: "http://www.oracle.com/us/sun/index.html", OwnerType: Organization},
-	"SISSL-1.2":                        {Identifier: "SISSL-1.2", Name: "Sun Industry Standards Source License 1.2", ShortName: "Sun Industry Standards Source License 1.2", Category: ProprietaryFree, Type: Proprietary, URL: "http://gridscheduler.sourceforge.net/Gridengine_SISSL_license.html", Owner: "Oracle (Sun)", OwnerURL: "http://www.oracle.com/us/sun/index.html", OwnerType: Organization},
-	"SPL-1.0":                          {Identifier: "SPL-1.0", Name: "Sun Public License 1.0", ShortName: "SPL 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.netbeans.org/about/legal/spl.html", Owner: "Oracle (Sun)", OwnerURL: "http://www.oracle.com/us/sun/index.html", OwnerType: Organization},
-	"Watcom-1.0":                       {Identifier: "Watcom-1.0", Name: "Sybase Open Watcom Public License v1.0", ShortName: "Open Watcom 1.0", Category: ProprietaryFree, Type: Proprietary, URL: "http://opensource.org/licenses/sybase.php", Owner: "Sybase, Inc. (an SAP subsidiary)", OwnerURL: "http://www.sybase.com/", OwnerType: Organization},
-	"TCL":                              {Identifier: "TCL", Name: "TCL/TK License", ShortName: "TCL/TK License", Category: Permissive, Type: OpenSource, URL: "http://www.tcl.tk/software/tcltk/license.html", Owner: "Tcl Developer Xchange", OwnerURL: "http://www.tcl.tk/", OwnerType: Organization},
-	"TMate":                            {Identifier: "TMate", Name: "TMate Open Source License", ShortName: "TMate Open Source License", Category: CopyLeft, Type: OpenSource, URL: "http://svnkit.com/licensing.html", Owner: "SVNKit (TMate)", OwnerURL: "http://svnkit.com/index.html", OwnerType: Organization},
-	"TORQUE-1.1":                       {Identifier: "TORQUE-1.1", Name: "TORQUE v2.5+ Software License v1.1", ShortName: "TORQUE 1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/TORQUEv1.1", Owner: "Adaptive Computing Enterprises", OwnerURL: "http://www.adaptivecomputing.com/", OwnerType: Organization},
-	"TOSL":                             {Identifier: "TOSL", Name: "Trusster Open Source License 1.0a", ShortName: "TOSL 1.0a", Category: CopyLeft, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/TOSL", Owner: "Trusster", OwnerURL: "http://www.trusster.com/", OwnerType: Organization},
-	"Unicode-TOU":                      {Identifier: "Unicode-TOU", Name: "Unicode Terms of Use", ShortName: "Unicode Terms of Use", Category: ProprietaryFree, Type: Proprietary, URL: "http://www.unicode.org/copyright.html", Owner: "Unicode Consortium", OwnerURL: "http://unicode.org/", OwnerType: Organization},
-	"UPL-1.0":                          {Identifier: "UPL-1.0", Name: "Universal Permissive License (UPL) v1.0", ShortName: "UPL 1.0", Category: Permissive, Type: OpenSource, URL: "http://opensource.org/licenses/UPL", Owner: "Oracle Corporation", OwnerURL: "http://www.oracle.com/index.html", OwnerType: Organization},
-	"NCSA":                             {Identifier: "NCSA", Name: "University of Illinois/NCSA Open Source License", ShortName: "NCSA Open Source License", Category: Permissive, Type: OpenSource, URL: "http://www.otm.illinois.edu/faculty/forms/opensource.asp", Owner: "NCSA - University of Illinois", OwnerURL: "http://www.ncsa.illinois.edu/", OwnerType: Organization},
-	"Unlicense":                        {Identifier: "Unlicense", Name: "Unlicense", ShortName: "Unlicense", Category: PublicDomain, Type: OpenSource, URL: "http://unlicense.org/", Owner: "Unlicense", OwnerURL: "http://unlicense.org/", OwnerType: "project"},
-	"Vim":                              {Identifier: "Vim", Name: "VIM License", ShortName: "VIM License", Category: CopyLeft, Type: OpenSource, URL: "http://vimdoc.sourceforge.net/htmldoc/uganda.html", Owner: "VIM", OwnerURL: "http://www.vim.org/about.php", OwnerType: "project"},
-	"VOSTROM":                          {Identifier: "VOSTROM", Name: "VOSTROM Public License for Open Source", ShortName: "VOSTROM Public License", Category: CopyLeft, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing:VOSTROM?rd=Licensing/VOSTROM", Owner: "VOSTROM", OwnerURL: "http://vostrom.com/", OwnerType: Organization},
-	"VSL-1.0":                          {Identifier: "VSL-1.0", Name: "Vovida Software License v. 1.0", ShortName: "Vovida Software License 1.0", Category: Permissive, Type: OpenSource, URL: "http://www.vovida.org/About/license.html", Owner: "Vovida", OwnerURL: "http://www.vovida.org/Main_Page", OwnerType: Organization},
-	"W3C-20150513":                     {Identifier: "W3C-20150513", Name: "W3C Software and Document (2015-05-13)", ShortName: "W3C-SOFTWARE-DOC-20150513", Category: Permissive, Type: OpenSource, URL: "https://www.w3.org/Consortium/Legal/2015/copyright-software-and-document", Owner: "W3C - World Wide Web Consortium", OwnerURL: "http://www.w3.org/", OwnerType: Organization},
-	"W3C":                              {Identifier: "W3C", Name: "W3C Software Notice and License", ShortName: "W3C Software Notice and License", Category: Permissive, Type: OpenSource, URL: "http://www.w3.org/Consortium/Legal/2002/copyright-software-20021231", Owner: "W3C - World Wide Web Consortium", OwnerURL: "http://www.w3.org/", OwnerType: Organization},
-	"W3C-19980720":                     {Identifier: "W3C-19980720", Name: "W3C Software Notice and License (1998-07-20)", ShortName: "W3C-SOFTWARE-19980720", Category: Permissive, Type: OpenSource, URL: "http://www.w3.org/Consortium/Legal/copyright-software-19980720.html", Owner: "W3C - World Wide Web Consortium", OwnerURL: "http://www.w3.org/", OwnerType: Organization},
-	"Wsuipa":                           {Identifier: "Wsuipa", Name: "Wsuipa License", ShortName: "Wsuipa License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/Wsuipa", Owner: "Washington State University", OwnerURL: "http://wsu.edu/", OwnerType: Organization},
-	"WTFPL":                            {Identifier: "WTFPL", Name: "WTFPL 2.0", ShortName: "WTFPL 2.0", Category: PublicDomain, Type: OpenSource, URL: "http://sam.zoy.org/wtfpl/", Owner: "Sam Hocevar", OwnerURL: "http://sam.zoy.org/", OwnerType: Person},
-	"WXwindows":                        {Identifier: "WXwindows", Name: "wxWindows Library Licence 3.1", ShortName: "wxWindows Library Licence 3.1", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.wxwidgets.org/about/newlicen.htm", Owner: "wxWidgets", OwnerURL: "http://www.wxwidgets.org/", OwnerType: Organization},
-	"libtiff":                          {Identifier: "libtiff", Name: "X11-Style (Tiff)", ShortName: "X11-Style (Tiff)", Category: Permissive, Type: OpenSource, URL: "", Owner: "SGI - Silicon Graphics", OwnerURL: "http://www.sgi.com/company_info/", OwnerType: Organization},
-	"X11":                              {Identifier: "X11", Name: "X11-Style (X Consortium)", ShortName: "X11-Style (X Consortium)", Category: Permissive, Type: OpenSource, URL: "http://www.xfree86.org/current/LICENSE5.html", Owner: "X Consortium", OwnerURL: "http://www.x.org/wiki/XConsortium", OwnerType: Organization},
-	"XFree86-1.1":                      {Identifier: "XFree86-1.1", Name: "XFree86 License 1.1", ShortName: "XFree86 License 1.1", Category: Permissive, Type: OpenSource, URL: "http://www.xfree86.org/current/LICENSE4.html", Owner: "XFree86 Project, Inc", OwnerURL: "http://www.xfree86.org/", OwnerType: "project"},
-	"xinetd":                           {Identifier: "xinetd", Name: "xinetd License", ShortName: "xinetd License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/Xinetd_License", Owner: "Xinetd", OwnerURL: "http://www.xinetd.org/", OwnerType: "project"},
-	"XSkat":                            {Identifier: "XSkat", Name: "XSkat License", ShortName: "XSkat License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/XSkat_License", Owner: "XSkat", OwnerURL: "http://www.xskat.de/xskat.html", OwnerType: "project"},
-	"YPL-1.0":                          {Identifier: "YPL-1.0", Name: "Yahoo! Public License v1.0", ShortName: "YPL 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.zimbra.com/license/yahoo_public_license_1.0.html", Owner: "Yahoo", OwnerURL: "http://www.yahoo.com/", OwnerType: Organization},
-	"YPL-1.1":                          {Identifier: "YPL-1.1", Name: "Yahoo! Public License v1.1", ShortName: "YPL 1.1", Category: CopyLeft, Type: OpenSource, URL: "http://www.zimbra.com/license/yahoo_public_license_1.1.html", Owner: "Yahoo", OwnerURL: "http://www.yahoo.com/", OwnerType: Organization},
-	"Zed":                              {Identifier: "Zed", Name: "Zed License", ShortName: "Zed License", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing/Zed", Owner: "Jim Davies", OwnerURL: "", OwnerType: Person},
-	"Zend-2.0":                         {Identifier: "Zend-2.0", Name: "Zend Engine License 2.0", ShortName: "Zend Engine License 2.0", Category: Permissive, Type: OpenSource, URL: "https://web.archive.org/web/20130517195954/http://www.zend.com/license/2_00.txt", Owner: "Zend Technologies Ltd.", OwnerURL: "http://www.zend.com/", OwnerType: Organization},
-	"Zimbra-1.3":                       {Identifier: "Zimbra-1.3", Name: "Zimbra Public License v1.3", ShortName: "ZPL 1.3", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.zimbra.com/license/zimbra-public-license-1-3.html", Owner: "Zimbra", OwnerURL: "http://www.zimbra.com/", OwnerType: Organization},
-	"Zimbra-1.4":                       {Identifier: "Zimbra-1.4", Name: "Zimbra Public License v1.4", ShortName: "ZPL 1.4", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.zimbra.com/legal/zimbra-public-license-1-4", Owner: "Zimbra", OwnerURL: "http://www.zimbra.com/", OwnerType: Organization},
-	"Zlib":                             {Identifier: "Zlib", Name: "ZLIB License", ShortName: "ZLIB License", Category: Permissive, Type: OpenSource, URL: "http://www.zlib.net/", Owner: "zlib", OwnerURL: "http://www.zlib.net/", OwnerType: Organization},
-	"zlib-acknowledgement":             {Identifier: "zlib-acknowledgement", Name: "ZLIB License with Acknowledgment", ShortName: "ZLIB License with Acknowledgment", Category: Permissive, Type: OpenSource, URL: "https://fedoraproject.org/wiki/Licensing:ZlibWithAcknowledgement?rd=Licensing/ZlibWithAcknowledgement", Owner: "Charlie Poole", OwnerURL: "", OwnerType: Person},
-	"ZPL-1.1":                          {Identifier: "ZPL-1.1", Name: "Zope Public License 1.1", ShortName: "ZPL 1.1", Category: Permissive, Type: OpenSource, URL: "http://www.zope.org/Resources/License/", Owner: "Zope Community", OwnerURL: "http://www.zope.org/", OwnerType: Organization},
-	"ZPL-2.0":                          {Identifier: "ZPL-2.0", Name: "Zope Public License 2.0", ShortName: "ZPL 2.0", Category: Permissive, Type: OpenSource, URL: "http://www.zope.org/Resources/License/", Owner: "Zope Community", OwnerURL: "http://www.zope.org/", OwnerType: Organization},
-	"ZPL-2.1":                          {Identifier: "ZPL-2.1", Name: "Zope Public License 2.1", ShortName: "ZPL 2.1", Category: Permissive, Type: OpenSource, URL: "http://www.zope.org/Resources/License/", Owner: "Zope Community", OwnerURL: "http://www.zope.org/", OwnerType: Organization},
+// LicenseException contains information about an exception which can be applied to a license, for example
+// Classpath-exception-2.0 which is commonly used alongside GPL-2.0
+type LicenseException struct {
+	Identifier   string
+	Name         string
+	URL          string
+	IsDeprecated bool
 }
 
 func handleNonSPDXIdentifiers(identifier string) (License, bool) {
@@ -419,13 +110,12 @@ func handleNonSPDXIdentifiers(identifier string) (License, bool) {
 	if spdx == "" {
 		return License{}, false
 	}
-	l, ok := lookup[spdx]
-	return l, ok
+	return findLicense(spdx)
 }
 
 // GetLicenseFromIdentifier returns a License given an identifier. Ideally this identifier would be a SPDX identifier.
 func GetLicenseFromIdentifier(identifier string) (License, error) {
-	l, ok := lookup[identifier]
+	l, ok := findLicense(identifier)
 	if ok {
 		return l, nil
 	}
@@ -433,14 +123,25 @@ func GetLicenseFromIdentifier(identifier string) (License, error) {
 	if ok {
 		return l, nil
 	}
-	return License{}, fmt.Errorf("license identifier %s is not known to diligent", identifier)
+	return License{}, WithReason(UnknownIdentifier, fmt.Errorf("license identifier %s is not known to diligent", identifier))
+}
+
+// GetLicenseExceptionFromIdentifier returns a LicenseException given its SPDX identifier
+func GetLicenseExceptionFromIdentifier(identifier string) (LicenseException, error) {
+	e, ok := exceptionLookup[identifier]
+	if !ok {
+		return LicenseException{}, WithReason(UnknownIdentifier, fmt.Errorf("license exception identifier %s is not known to diligent", identifier))
+	}
+	return e, nil
 }
 
 func getLicenses(predicate func(license License) bool) []License {
-	output := make([]License, 0, len(lookup))
-	for _, v := range lookup {
-		if predicate(v) {
-			output = append(output, v)
+	output := make([]License, 0, len(lookup)+len(customLicenses))
+	for _, identifiers := range []map[string]License{lookup, customLicenses} {
+		for identifier := range identifiers {
+			if l, _ := findLicense(identifier); predicate(l) {
+				output = append(output, l)
+			}
 		}
 	}
 	sort.Slice(output, func(i, j int) bool {
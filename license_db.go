@@ -0,0 +1,718 @@
+// Code generated by licensegen from the SPDX license list 3.23. DO NOT EDIT.
+
+package diligent
+
+// LicenseListVersion is the version of the SPDX license list used to generate the license database
+const LicenseListVersion = "3.23"
+
+var lookup = map[string]License{
+	"0BSD":                                 {Identifier: "0BSD", Family: "0BSD", Name: "BSD Zero Clause License", ShortName: "BSD Zero Clause License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/0BSD.html", Owner: "Rob Landley", OwnerURL: "http://landley.net/", OwnerType: Person, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"AAL":                                  {Identifier: "AAL", Family: "AAL", Name: "Attribution Assurance License", ShortName: "AAL", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/AAL.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"ADSL":                                 {Identifier: "ADSL", Family: "ADSL", Name: "Amazon Digital Services License", ShortName: "Amazon Digital Services License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/ADSL.html", Owner: "Amazon Web Services", OwnerURL: "http://aws.amazon.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"AFL-1.1":                              {Identifier: "AFL-1.1", Family: "AFL", Name: "Academic Free License v1.1", ShortName: "AFL 1.1", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/AFL-1.1.html", Owner: "Lawrence Rosen", OwnerURL: "http://www.rosenlaw.com/rosen.htm", OwnerType: Person, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"AFL-1.2":                              {Identifier: "AFL-1.2", Family: "AFL", Name: "Academic Free License v1.2", ShortName: "AFL 1.2", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/AFL-1.2.html", Owner: "Lawrence Rosen", OwnerURL: "http://www.rosenlaw.com/rosen.htm", OwnerType: Person, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"AFL-2.0":                              {Identifier: "AFL-2.0", Family: "AFL", Name: "Academic Free License v2.0", ShortName: "AFL 2.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/AFL-2.0.html", Owner: "Lawrence Rosen", OwnerURL: "http://www.rosenlaw.com/rosen.htm", OwnerType: Person, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"AFL-2.1":                              {Identifier: "AFL-2.1", Family: "AFL", Name: "Academic Free License v2.1", ShortName: "AFL 2.1", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/AFL-2.1.html", Owner: "Lawrence Rosen", OwnerURL: "http://www.rosenlaw.com/rosen.htm", OwnerType: Person, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"AFL-3.0":                              {Identifier: "AFL-3.0", Family: "AFL", Name: "Academic Free License v3.0", ShortName: "AFL 3.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/AFL-3.0.html", Owner: "Lawrence Rosen", OwnerURL: "http://www.rosenlaw.com/rosen.htm", OwnerType: Person, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{IncludeCopyright, DocumentChanges}, Limitations: []Limitation{NoTrademarkUse, NoLiability, NoWarranty}},
+	"AGPL-1.0":                             {Identifier: "AGPL-1.0", Family: "AGPL", Name: "Affero General Public License v1.0", ShortName: "AGPL 1.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/AGPL-1.0.html", Owner: "Affero", OwnerURL: "http://www.affero.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: true, IsSPDXListed: true},
+	"AGPL-1.0-only":                        {Identifier: "AGPL-1.0-only", Family: "AGPL", Name: "Affero General Public License v1.0 only", ShortName: "AGPL 1.0 only", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/AGPL-1.0-only.html", Owner: "Affero", OwnerURL: "http://www.affero.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"AGPL-1.0-or-later":                    {Identifier: "AGPL-1.0-or-later", Family: "AGPL", Name: "Affero General Public License v1.0 or later", ShortName: "AGPL 1.0 or later", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/AGPL-1.0-or-later.html", Owner: "Affero", OwnerURL: "http://www.affero.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"AGPL-3.0":                             {Identifier: "AGPL-3.0", Family: "AGPL", Name: "GNU Affero General Public License v3.0", ShortName: "AGPL 3.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/AGPL-3.0.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: true, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{IncludeCopyright, DocumentChanges, DiscloseSource, NetworkUseDisclose, SameLicense}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"AGPL-3.0-only":                        {Identifier: "AGPL-3.0-only", Family: "AGPL", Name: "GNU Affero General Public License v3.0 only", ShortName: "AGPL 3.0 only", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/AGPL-3.0-only.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{IncludeCopyright, DocumentChanges, DiscloseSource, NetworkUseDisclose, SameLicense}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"AGPL-3.0-or-later":                    {Identifier: "AGPL-3.0-or-later", Family: "AGPL", Name: "GNU Affero General Public License v3.0 or later", ShortName: "AGPL 3.0 or later", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/AGPL-3.0-or-later.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{IncludeCopyright, DocumentChanges, DiscloseSource, NetworkUseDisclose, SameLicense}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"AMDPLPA":                              {Identifier: "AMDPLPA", Family: "AMDPLPA", Name: "AMD's plpa_map.c License", ShortName: "AMD PLPA License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/AMDPLPA.html", Owner: "Advanced Micro Devices", OwnerURL: "http://www.amd.com/us/pages/amdhomepage.aspx", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"AML":                                  {Identifier: "AML", Family: "AML", Name: "Apple MIT License", ShortName: "Apple MIT License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/AML.html", Owner: "Apple", OwnerURL: "http://www.apple.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"AML-glslang":                          {Identifier: "AML-glslang", Family: "AML-glslang", Name: "AML glslang variant License", ShortName: "AML glslang variant License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/AML-glslang.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"AMPAS":                                {Identifier: "AMPAS", Family: "AMPAS", Name: "Academy of Motion Picture Arts and Sciences BSD", ShortName: "AMPAS BSD-Style License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/AMPAS.html", Owner: "AMPAS", OwnerURL: "", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"ANTLR-PD":                             {Identifier: "ANTLR-PD", Family: "ANTLR-PD", Name: "ANTLR Software Rights Notice", ShortName: "ANTLR-PD", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/ANTLR-PD.html", Owner: "ANTLR", OwnerURL: "http://antlr.org/about.html", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"ANTLR-PD-fallback":                    {Identifier: "ANTLR-PD-fallback", Family: "ANTLR-PD-fallback", Name: "ANTLR Software Rights Notice with license fallback", ShortName: "ANTLR Software Rights Notice with license fallback", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/ANTLR-PD-fallback.html", Owner: "ANTLR", OwnerURL: "http://antlr.org/about.html", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"APAFML":                               {Identifier: "APAFML", Family: "APAFML", Name: "Adobe Postscript AFM License", ShortName: "Adobe Postscript AFM License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/APAFML.html", Owner: "Adobe Systems", OwnerURL: "http://www.adobe.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"APL-1.0":                              {Identifier: "APL-1.0", Family: "APL", Name: "Adaptive Public License 1.0", ShortName: "APL 1.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/APL-1.0.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"APSL-1.0":                             {Identifier: "APSL-1.0", Family: "APSL", Name: "Apple Public Source License 1.0", ShortName: "APSL 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/APSL-1.0.html", Owner: "Apple", OwnerURL: "http://www.apple.com/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"APSL-1.1":                             {Identifier: "APSL-1.1", Family: "APSL", Name: "Apple Public Source License 1.1", ShortName: "APSL 1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/APSL-1.1.html", Owner: "Apple", OwnerURL: "http://www.apple.com/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"APSL-1.2":                             {Identifier: "APSL-1.2", Family: "APSL", Name: "Apple Public Source License 1.2", ShortName: "APSL 1.2", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/APSL-1.2.html", Owner: "Apple", OwnerURL: "http://www.apple.com/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"APSL-2.0":                             {Identifier: "APSL-2.0", Family: "APSL", Name: "Apple Public Source License 2.0", ShortName: "APSL 2.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/APSL-2.0.html", Owner: "Apple", OwnerURL: "http://www.apple.com/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"ASWF-Digital-Assets-1.0":              {Identifier: "ASWF-Digital-Assets-1.0", Family: "ASWF-Digital-Assets", Name: "ASWF Digital Assets License version 1.0", ShortName: "ASWF Digital Assets License version 1.0", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/ASWF-Digital-Assets-1.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"ASWF-Digital-Assets-1.1":              {Identifier: "ASWF-Digital-Assets-1.1", Family: "ASWF-Digital-Assets", Name: "ASWF Digital Assets License 1.1", ShortName: "ASWF Digital Assets License 1.1", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/ASWF-Digital-Assets-1.1.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Abstyles":                             {Identifier: "Abstyles", Family: "Abstyles", Name: "Abstyles License", ShortName: "Abstyles License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Abstyles.html", Owner: "CTAN", OwnerURL: "http://www.ctan.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"AdaCore-doc":                          {Identifier: "AdaCore-doc", Family: "AdaCore-doc", Name: "AdaCore Doc License", ShortName: "AdaCore Doc License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/AdaCore-doc.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Adobe-2006":                           {Identifier: "Adobe-2006", Family: "Adobe", Name: "Adobe Systems Incorporated Source Code License Agreement", ShortName: "Adobe Source Code License 2006", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Adobe-2006.html", Owner: "Adobe Systems", OwnerURL: "http://www.adobe.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Adobe-Display-PostScript":             {Identifier: "Adobe-Display-PostScript", Family: "Adobe-Display-PostScript", Name: "Adobe Display PostScript License", ShortName: "Adobe Display PostScript License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Adobe-Display-PostScript.html", Owner: "Adobe Systems", OwnerURL: "http://www.adobe.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Adobe-Glyph":                          {Identifier: "Adobe-Glyph", Family: "Adobe-Glyph", Name: "Adobe Glyph List License", ShortName: "Adobe Glyph License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Adobe-Glyph.html", Owner: "Adobe Systems", OwnerURL: "http://www.adobe.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Adobe-Utopia":                         {Identifier: "Adobe-Utopia", Family: "Adobe-Utopia", Name: "Adobe Utopia Font License", ShortName: "Adobe Utopia Font License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Adobe-Utopia.html", Owner: "Adobe Systems", OwnerURL: "http://www.adobe.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Afmparse":                             {Identifier: "Afmparse", Family: "Afmparse", Name: "Afmparse License", ShortName: "afmparse License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Afmparse.html", Owner: "Adobe Systems", OwnerURL: "http://www.adobe.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Aladdin":                              {Identifier: "Aladdin", Family: "Aladdin", Name: "Aladdin Free Public License", ShortName: "Aladdin FPL v8", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/Aladdin.html", Owner: "Aladdin Enterprises", OwnerURL: "http://www.major2nd.com/ae/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Apache-1.0":                           {Identifier: "Apache-1.0", Family: "Apache", Name: "Apache License 1.0", ShortName: "Apache 1.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Apache-1.0.html", Owner: "Apache Software Foundation", OwnerURL: "http://www.apache.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"Apache-1.1":                           {Identifier: "Apache-1.1", Family: "Apache", Name: "Apache License 1.1", ShortName: "Apache 1.1", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Apache-1.1.html", Owner: "Apache Software Foundation", OwnerURL: "http://www.apache.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"Apache-2.0":                           {Identifier: "Apache-2.0", Family: "Apache", Name: "Apache License 2.0", ShortName: "Apache 2.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Apache-2.0.html", Owner: "Apache Software Foundation", OwnerURL: "http://www.apache.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{IncludeCopyright, DocumentChanges}, Limitations: []Limitation{NoTrademarkUse, NoLiability, NoWarranty}},
+	"App-s2p":                              {Identifier: "App-s2p", Family: "App-s2p", Name: "App::s2p License", ShortName: "App::s2p License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/App-s2p.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Arphic-1999":                          {Identifier: "Arphic-1999", Family: "Arphic", Name: "Arphic Public License", ShortName: "Arphic Public License", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/Arphic-1999.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Artistic-1.0":                         {Identifier: "Artistic-1.0", Family: "Artistic", Name: "Artistic License 1.0", ShortName: "Artistic 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/Artistic-1.0.html", Owner: "Perl Foundation", OwnerURL: "http://www.perlfoundation.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Artistic-1.0-Perl":                    {Identifier: "Artistic-1.0-Perl", Family: "Artistic", Name: "Artistic License 1.0 (Perl)", ShortName: "Artistic-Perl-1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/Artistic-1.0-Perl.html", Owner: "Perl Foundation", OwnerURL: "http://www.perlfoundation.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Artistic-1.0-cl8":                     {Identifier: "Artistic-1.0-cl8", Family: "Artistic", Name: "Artistic License 1.0 w/clause 8", ShortName: "Artistic 1.0 w/clause 8", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/Artistic-1.0-cl8.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Artistic-2.0":                         {Identifier: "Artistic-2.0", Family: "Artistic", Name: "Artistic License 2.0", ShortName: "Artistic 2.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/Artistic-2.0.html", Owner: "Perl Foundation", OwnerURL: "http://www.perlfoundation.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{IncludeCopyright, DocumentChanges}, Limitations: []Limitation{NoTrademarkUse, NoLiability, NoWarranty}},
+	"BSD-1-Clause":                         {Identifier: "BSD-1-Clause", Family: "BSD", Name: "BSD 1-Clause License", ShortName: "BSD 1-Clause", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-1-Clause.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-2-Clause":                         {Identifier: "BSD-2-Clause", Family: "BSD", Name: "BSD 2-Clause \"Simplified\" License", ShortName: "BSD-2-Clause", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-2-Clause.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"BSD-2-Clause-Darwin":                  {Identifier: "BSD-2-Clause-Darwin", Family: "BSD", Name: "BSD 2-Clause - Ian Darwin variant", ShortName: "BSD 2-Clause - Ian Darwin variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-2-Clause-Darwin.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-2-Clause-FreeBSD":                 {Identifier: "BSD-2-Clause-FreeBSD", Family: "BSD", Name: "BSD 2-Clause FreeBSD License", ShortName: "BSD 2-clause \"FreeBSD\"", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-2-Clause-FreeBSD.html", Owner: "FreeBSD", OwnerURL: "http://www.freebsd.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: true, IsSPDXListed: true},
+	"BSD-2-Clause-NetBSD":                  {Identifier: "BSD-2-Clause-NetBSD", Family: "BSD", Name: "BSD 2-Clause NetBSD License", ShortName: "BSD 2-clause \"NetBSD\"", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-2-Clause-NetBSD.html", Owner: "NetBSD", OwnerURL: "http://www.netbsd.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: true, IsSPDXListed: true},
+	"BSD-2-Clause-Patent":                  {Identifier: "BSD-2-Clause-Patent", Family: "BSD", Name: "BSD-2-Clause Plus Patent License", ShortName: "BSD+Patent", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-2-Clause-Patent.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-2-Clause-Views":                   {Identifier: "BSD-2-Clause-Views", Family: "BSD", Name: "BSD 2-Clause with views sentence", ShortName: "BSD 2-Clause with views sentence", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-2-Clause-Views.html", Owner: "FreeBSD", OwnerURL: "http://www.freebsd.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-3-Clause":                         {Identifier: "BSD-3-Clause", Family: "BSD", Name: "BSD 3-Clause \"New\" or \"Revised\" License", ShortName: "BSD-3-Clause", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-3-Clause.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"BSD-3-Clause-Attribution":             {Identifier: "BSD-3-Clause-Attribution", Family: "BSD", Name: "BSD with attribution", ShortName: "BSD Acknowledgment License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-3-Clause-Attribution.html", Owner: "Universidad de Palermo", OwnerURL: "http://www.palermo.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-3-Clause-Clear":                   {Identifier: "BSD-3-Clause-Clear", Family: "BSD", Name: "BSD 3-Clause Clear License", ShortName: "Clear BSD License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-3-Clause-Clear.html", Owner: "MetaCarta", OwnerURL: "http://www.metacarta.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright}, Limitations: []Limitation{NoLiability, NoPatentUse, NoWarranty}},
+	"BSD-3-Clause-HP":                      {Identifier: "BSD-3-Clause-HP", Family: "BSD", Name: "Hewlett-Packard BSD variant license", ShortName: "Hewlett-Packard BSD variant license", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-3-Clause-HP.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-3-Clause-LBNL":                    {Identifier: "BSD-3-Clause-LBNL", Family: "BSD", Name: "Lawrence Berkeley National Labs BSD variant license", ShortName: "LBNL BSD Variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-3-Clause-LBNL.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-3-Clause-Modification":            {Identifier: "BSD-3-Clause-Modification", Family: "BSD", Name: "BSD 3-Clause Modification", ShortName: "BSD 3-Clause Modification", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-3-Clause-Modification.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-3-Clause-No-Military-License":     {Identifier: "BSD-3-Clause-No-Military-License", Family: "BSD", Name: "BSD 3-Clause No Military License", ShortName: "BSD 3-Clause No Military License", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-3-Clause-No-Military-License.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-3-Clause-No-Nuclear-License":      {Identifier: "BSD-3-Clause-No-Nuclear-License", Family: "BSD", Name: "BSD 3-Clause No Nuclear License", ShortName: "BSD 3-Clause No Nuclear License", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-3-Clause-No-Nuclear-License.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-3-Clause-No-Nuclear-License-2014": {Identifier: "BSD-3-Clause-No-Nuclear-License-2014", Family: "BSD", Name: "BSD 3-Clause No Nuclear License 2014", ShortName: "BSD 3-Clause No Nuclear License 2014", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-3-Clause-No-Nuclear-License-2014.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-3-Clause-No-Nuclear-Warranty":     {Identifier: "BSD-3-Clause-No-Nuclear-Warranty", Family: "BSD", Name: "BSD 3-Clause No Nuclear Warranty", ShortName: "BSD 3-Clause No Nuclear Warranty", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-3-Clause-No-Nuclear-Warranty.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-3-Clause-Open-MPI":                {Identifier: "BSD-3-Clause-Open-MPI", Family: "BSD", Name: "BSD 3-Clause Open MPI variant", ShortName: "BSD 3-Clause Open MPI variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-3-Clause-Open-MPI.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-3-Clause-Sun":                     {Identifier: "BSD-3-Clause-Sun", Family: "BSD", Name: "BSD 3-Clause Sun Microsystems", ShortName: "BSD 3-Clause Sun Microsystems", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-3-Clause-Sun.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-3-Clause-acpica":                  {Identifier: "BSD-3-Clause-acpica", Family: "BSD", Name: "BSD 3-Clause acpica variant", ShortName: "BSD 3-Clause acpica variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-3-Clause-acpica.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-3-Clause-flex":                    {Identifier: "BSD-3-Clause-flex", Family: "BSD", Name: "BSD 3-Clause Flex variant", ShortName: "BSD 3-Clause Flex variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-3-Clause-flex.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-4-Clause":                         {Identifier: "BSD-4-Clause", Family: "BSD", Name: "BSD 4-Clause \"Original\" or \"Old\" License", ShortName: "BSD-Original", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-4-Clause.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"BSD-4-Clause-Shortened":               {Identifier: "BSD-4-Clause-Shortened", Family: "BSD", Name: "BSD 4 Clause Shortened", ShortName: "BSD 4 Clause Shortened", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-4-Clause-Shortened.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-4-Clause-UC":                      {Identifier: "BSD-4-Clause-UC", Family: "BSD", Name: "BSD-4-Clause (University of California-Specific)", ShortName: "BSD-Original-UC", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-4-Clause-UC.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-4.3RENO":                          {Identifier: "BSD-4.3RENO", Family: "BSD", Name: "BSD 4.3 RENO License", ShortName: "BSD 4.3 RENO License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-4.3RENO.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-4.3TAHOE":                         {Identifier: "BSD-4.3TAHOE", Family: "BSD", Name: "BSD 4.3 TAHOE License", ShortName: "BSD 4.3 TAHOE License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-4.3TAHOE.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-Advertising-Acknowledgement":      {Identifier: "BSD-Advertising-Acknowledgement", Family: "BSD-Advertising-Acknowledgement", Name: "BSD Advertising Acknowledgement License", ShortName: "BSD Advertising Acknowledgement License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-Advertising-Acknowledgement.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-Attribution-HPND-disclaimer":      {Identifier: "BSD-Attribution-HPND-disclaimer", Family: "BSD-Attribution-HPND-disclaimer", Name: "BSD with Attribution and HPND disclaimer", ShortName: "BSD with Attribution and HPND disclaimer", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-Attribution-HPND-disclaimer.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-Inferno-Nettverk":                 {Identifier: "BSD-Inferno-Nettverk", Family: "BSD-Inferno-Nettverk", Name: "BSD-Inferno-Nettverk", ShortName: "BSD-Inferno-Nettverk", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-Inferno-Nettverk.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-Protection":                       {Identifier: "BSD-Protection", Family: "BSD-Protection", Name: "BSD Protection License", ShortName: "BSD Protection License", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-Protection.html", Owner: "FreeBSD", OwnerURL: "http://www.freebsd.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-Source-Code":                      {Identifier: "BSD-Source-Code", Family: "BSD-Source-Code", Name: "BSD Source Code Attribution", ShortName: "BSD Source Code Attribution", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-Source-Code.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-Source-beginning-file":            {Identifier: "BSD-Source-beginning-file", Family: "BSD-Source-beginning-file", Name: "BSD Source Code Attribution - beginning of file variant", ShortName: "BSD Source Code Attribution - beginning of file variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-Source-beginning-file.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-Systemics":                        {Identifier: "BSD-Systemics", Family: "BSD-Systemics", Name: "Systemics BSD variant license", ShortName: "Systemics BSD variant license", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-Systemics.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSD-Systemics-W3Works":                {Identifier: "BSD-Systemics-W3Works", Family: "BSD-Systemics-W3Works", Name: "Systemics W3Works BSD variant license", ShortName: "Systemics W3Works BSD variant license", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSD-Systemics-W3Works.html", Owner: "Regents of the University of California", OwnerURL: "http://regents.universityofcalifornia.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BSL-1.0":                              {Identifier: "BSL-1.0", Family: "BSL", Name: "Boost Software License 1.0", ShortName: "Boost 1.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BSL-1.0.html", Owner: "Boost", OwnerURL: "http://www.boost.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyrightSource}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"BUSL-1.1":                             {Identifier: "BUSL-1.1", Family: "BUSL", Name: "Business Source License 1.1", ShortName: "BSL 1.1", Category: FreeRestricted, Type: Proprietary, URL: "https://spdx.org/licenses/BUSL-1.1.html", Owner: "MariaDB", OwnerURL: "https://mariadb.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Baekmuk":                              {Identifier: "Baekmuk", Family: "Baekmuk", Name: "Baekmuk License", ShortName: "Baekmuk License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Baekmuk.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Bahyph":                               {Identifier: "Bahyph", Family: "Bahyph", Name: "Bahyph License", ShortName: "Bahyph License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Bahyph.html", Owner: "GMV", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Barr":                                 {Identifier: "Barr", Family: "Barr", Name: "Barr License", ShortName: "Barr TeX License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Barr.html", Owner: "Michael Barr", OwnerURL: "", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Beerware":                             {Identifier: "Beerware", Family: "Beerware", Name: "Beerware License", ShortName: "Beerware License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Beerware.html", Owner: "Poul-Henning Kamp", OwnerURL: "", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BitTorrent-1.0":                       {Identifier: "BitTorrent-1.0", Family: "BitTorrent", Name: "BitTorrent Open Source License v1.0", ShortName: "BitTorrent 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/BitTorrent-1.0.html", Owner: "BitTorrent, Inc.", OwnerURL: "http://www.bittorrent.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BitTorrent-1.1":                       {Identifier: "BitTorrent-1.1", Family: "BitTorrent", Name: "BitTorrent Open Source License v1.1", ShortName: "BitTorrent 1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/BitTorrent-1.1.html", Owner: "BitTorrent, Inc.", OwnerURL: "http://www.bittorrent.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"Bitstream-Charter":                    {Identifier: "Bitstream-Charter", Family: "Bitstream-Charter", Name: "Bitstream Charter Font License", ShortName: "Bitstream Charter Font License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Bitstream-Charter.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Bitstream-Vera":                       {Identifier: "Bitstream-Vera", Family: "Bitstream-Vera", Name: "Bitstream Vera Font License", ShortName: "Bitstream Vera Font License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Bitstream-Vera.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"BlueOak-1.0.0":                        {Identifier: "BlueOak-1.0.0", Family: "BlueOak", Name: "Blue Oak Model License 1.0.0", ShortName: "Blue Oak Model License 1.0.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/BlueOak-1.0.0.html", Owner: "Blue Oak Council", OwnerURL: "https://blueoakcouncil.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Boehm-GC":                             {Identifier: "Boehm-GC", Family: "Boehm-GC", Name: "Boehm-Demers-Weiser GC License", ShortName: "Boehm-Demers-Weiser GC License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Boehm-GC.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Borceux":                              {Identifier: "Borceux", Family: "Borceux", Name: "Borceux license", ShortName: "Borceux License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Borceux.html", Owner: "Francis Borceux", OwnerURL: "", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Brian-Gladman-2-Clause":               {Identifier: "Brian-Gladman-2-Clause", Family: "Brian-Gladman", Name: "Brian Gladman 2-Clause License", ShortName: "Brian Gladman 2-Clause License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Brian-Gladman-2-Clause.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Brian-Gladman-3-Clause":               {Identifier: "Brian-Gladman-3-Clause", Family: "Brian-Gladman", Name: "Brian Gladman 3-Clause License", ShortName: "Brian Gladman 3-Clause License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Brian-Gladman-3-Clause.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"C-UDA-1.0":                            {Identifier: "C-UDA-1.0", Family: "C-UDA", Name: "Computational Use of Data Agreement v1.0", ShortName: "Computational Use of Data Agreement v1.0", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/C-UDA-1.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CAL-1.0":                              {Identifier: "CAL-1.0", Family: "CAL", Name: "Cryptographic Autonomy License 1.0", ShortName: "Cryptographic Autonomy License 1.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/CAL-1.0.html", Owner: "Holochain", OwnerURL: "https://www.holochain.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CAL-1.0-Combined-Work-Exception":      {Identifier: "CAL-1.0-Combined-Work-Exception", Family: "CAL", Name: "Cryptographic Autonomy License 1.0 (Combined Work Exception)", ShortName: "Cryptographic Autonomy License 1.0 (Combined Work Exception)", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/CAL-1.0-Combined-Work-Exception.html", Owner: "Holochain", OwnerURL: "https://www.holochain.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CATOSL-1.1":                           {Identifier: "CATOSL-1.1", Family: "CATOSL", Name: "Computer Associates Trusted Open Source License 1.1", ShortName: "CA Trusted Open Source License 1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/CATOSL-1.1.html", Owner: "Computer Associates", OwnerURL: "http://www.ca.com/us/default.aspx", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-1.0":                            {Identifier: "CC-BY-1.0", Family: "CC-BY", Name: "Creative Commons Attribution 1.0 Generic", ShortName: "CC-BY-1.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-1.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-2.0":                            {Identifier: "CC-BY-2.0", Family: "CC-BY", Name: "Creative Commons Attribution 2.0 Generic", ShortName: "CC-BY-2.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-2.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-2.5":                            {Identifier: "CC-BY-2.5", Family: "CC-BY", Name: "Creative Commons Attribution 2.5 Generic", ShortName: "CC-BY-2.5", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-2.5.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-2.5-AU":                         {Identifier: "CC-BY-2.5-AU", Family: "CC-BY", Name: "Creative Commons Attribution 2.5 Australia", ShortName: "Creative Commons Attribution 2.5 Australia", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-2.5-AU.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-3.0":                            {Identifier: "CC-BY-3.0", Family: "CC-BY", Name: "Creative Commons Attribution 3.0 Unported", ShortName: "CC-BY-3.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-3.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-3.0-AT":                         {Identifier: "CC-BY-3.0-AT", Family: "CC-BY", Name: "Creative Commons Attribution 3.0 Austria", ShortName: "Creative Commons Attribution 3.0 Austria", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-3.0-AT.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-3.0-AU":                         {Identifier: "CC-BY-3.0-AU", Family: "CC-BY", Name: "Creative Commons Attribution 3.0 Australia", ShortName: "Creative Commons Attribution 3.0 Australia", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-3.0-AU.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-3.0-DE":                         {Identifier: "CC-BY-3.0-DE", Family: "CC-BY", Name: "Creative Commons Attribution 3.0 Germany", ShortName: "Creative Commons Attribution 3.0 Germany", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-3.0-DE.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-3.0-IGO":                        {Identifier: "CC-BY-3.0-IGO", Family: "CC-BY", Name: "Creative Commons Attribution 3.0 IGO", ShortName: "Creative Commons Attribution 3.0 IGO", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-3.0-IGO.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-3.0-NL":                         {Identifier: "CC-BY-3.0-NL", Family: "CC-BY", Name: "Creative Commons Attribution 3.0 Netherlands", ShortName: "Creative Commons Attribution 3.0 Netherlands", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-3.0-NL.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-3.0-US":                         {Identifier: "CC-BY-3.0-US", Family: "CC-BY", Name: "Creative Commons Attribution 3.0 United States", ShortName: "Creative Commons Attribution 3.0 United States", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-3.0-US.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-4.0":                            {Identifier: "CC-BY-4.0", Family: "CC-BY", Name: "Creative Commons Attribution 4.0 International", ShortName: "CC-BY-4.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-4.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright, DocumentChanges}, Limitations: []Limitation{NoLiability, NoTrademarkUse, NoPatentUse, NoWarranty}},
+	"CC-BY-NC-1.0":                         {Identifier: "CC-BY-NC-1.0", Family: "CC-BY-NC", Name: "Creative Commons Attribution Non Commercial 1.0 Generic", ShortName: "CC-BY-NC-1.0", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-NC-1.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-NC-2.0":                         {Identifier: "CC-BY-NC-2.0", Family: "CC-BY-NC", Name: "Creative Commons Attribution Non Commercial 2.0 Generic", ShortName: "CC-BY-NC-2.0", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-NC-2.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-NC-2.5":                         {Identifier: "CC-BY-NC-2.5", Family: "CC-BY-NC", Name: "Creative Commons Attribution Non Commercial 2.5 Generic", ShortName: "CC-BY-NC-2.5", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-NC-2.5.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-NC-3.0":                         {Identifier: "CC-BY-NC-3.0", Family: "CC-BY-NC", Name: "Creative Commons Attribution Non Commercial 3.0 Unported", ShortName: "CC-BY-NC-3.0", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-NC-3.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-NC-3.0-DE":                      {Identifier: "CC-BY-NC-3.0-DE", Family: "CC-BY-NC", Name: "Creative Commons Attribution Non Commercial 3.0 Germany", ShortName: "Creative Commons Attribution Non Commercial 3.0 Germany", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-NC-3.0-DE.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-NC-4.0":                         {Identifier: "CC-BY-NC-4.0", Family: "CC-BY-NC", Name: "Creative Commons Attribution Non Commercial 4.0 International", ShortName: "CC-BY-NC-4.0", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-NC-4.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-NC-ND-1.0":                      {Identifier: "CC-BY-NC-ND-1.0", Family: "CC-BY-NC-ND", Name: "Creative Commons Attribution Non Commercial No Derivatives 1.0 Generic", ShortName: "CC-BY-NC-ND-1.0", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-NC-ND-1.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-NC-ND-2.0":                      {Identifier: "CC-BY-NC-ND-2.0", Family: "CC-BY-NC-ND", Name: "Creative Commons Attribution Non Commercial No Derivatives 2.0 Generic", ShortName: "CC-BY-NC-ND-2.0", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-NC-ND-2.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-NC-ND-2.5":                      {Identifier: "CC-BY-NC-ND-2.5", Family: "CC-BY-NC-ND", Name: "Creative Commons Attribution Non Commercial No Derivatives 2.5 Generic", ShortName: "CC-BY-NC-ND-2.5", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-NC-ND-2.5.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-NC-ND-3.0":                      {Identifier: "CC-BY-NC-ND-3.0", Family: "CC-BY-NC-ND", Name: "Creative Commons Attribution Non Commercial No Derivatives 3.0 Unported", ShortName: "CC-BY-NC-ND-3.0", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-NC-ND-3.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-NC-ND-3.0-DE":                   {Identifier: "CC-BY-NC-ND-3.0-DE", Family: "CC-BY-NC-ND", Name: "Creative Commons Attribution Non Commercial No Derivatives 3.0 Germany", ShortName: "Creative Commons Attribution Non Commercial No Derivatives 3.0 Germany", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-NC-ND-3.0-DE.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-NC-ND-3.0-IGO":                  {Identifier: "CC-BY-NC-ND-3.0-IGO", Family: "CC-BY-NC-ND", Name: "Creative Commons Attribution Non Commercial No Derivatives 3.0 IGO", ShortName: "Creative Commons Attribution Non Commercial No Derivatives 3.0 IGO", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-NC-ND-3.0-IGO.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-NC-ND-4.0":                      {Identifier: "CC-BY-NC-ND-4.0", Family: "CC-BY-NC-ND", Name: "Creative Commons Attribution Non Commercial No Derivatives 4.0 International", ShortName: "CC-BY-NC-ND-4.0", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-NC-ND-4.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-NC-SA-1.0":                      {Identifier: "CC-BY-NC-SA-1.0", Family: "CC-BY-NC-SA", Name: "Creative Commons Attribution Non Commercial Share Alike 1.0 Generic", ShortName: "CC-BY-NC-SA-1.0", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-NC-SA-1.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-NC-SA-2.0":                      {Identifier: "CC-BY-NC-SA-2.0", Family: "CC-BY-NC-SA", Name: "Creative Commons Attribution Non Commercial Share Alike 2.0 Generic", ShortName: "CC-BY-NC-SA-2.0", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-NC-SA-2.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-NC-SA-2.0-DE":                   {Identifier: "CC-BY-NC-SA-2.0-DE", Family: "CC-BY-NC-SA", Name: "Creative Commons Attribution Non Commercial Share Alike 2.0 Germany", ShortName: "Creative Commons Attribution Non Commercial Share Alike 2.0 Germany", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-NC-SA-2.0-DE.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-NC-SA-2.0-FR":                   {Identifier: "CC-BY-NC-SA-2.0-FR", Family: "CC-BY-NC-SA", Name: "Creative Commons Attribution-NonCommercial-ShareAlike 2.0 France", ShortName: "Creative Commons Attribution-NonCommercial-ShareAlike 2.0 France", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-NC-SA-2.0-FR.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-NC-SA-2.0-UK":                   {Identifier: "CC-BY-NC-SA-2.0-UK", Family: "CC-BY-NC-SA", Name: "Creative Commons Attribution Non Commercial Share Alike 2.0 England and Wales", ShortName: "Creative Commons Attribution Non Commercial Share Alike 2.0 England and Wales", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-NC-SA-2.0-UK.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-NC-SA-2.5":                      {Identifier: "CC-BY-NC-SA-2.5", Family: "CC-BY-NC-SA", Name: "Creative Commons Attribution Non Commercial Share Alike 2.5 Generic", ShortName: "CC-BY-NC-SA-2.5", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-NC-SA-2.5.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-NC-SA-3.0":                      {Identifier: "CC-BY-NC-SA-3.0", Family: "CC-BY-NC-SA", Name: "Creative Commons Attribution Non Commercial Share Alike 3.0 Unported", ShortName: "CC-BY-NC-SA-3.0", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-NC-SA-3.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-NC-SA-3.0-DE":                   {Identifier: "CC-BY-NC-SA-3.0-DE", Family: "CC-BY-NC-SA", Name: "Creative Commons Attribution Non Commercial Share Alike 3.0 Germany", ShortName: "Creative Commons Attribution Non Commercial Share Alike 3.0 Germany", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-NC-SA-3.0-DE.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-NC-SA-3.0-IGO":                  {Identifier: "CC-BY-NC-SA-3.0-IGO", Family: "CC-BY-NC-SA", Name: "Creative Commons Attribution Non Commercial Share Alike 3.0 IGO", ShortName: "Creative Commons Attribution Non Commercial Share Alike 3.0 IGO", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-NC-SA-3.0-IGO.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-NC-SA-4.0":                      {Identifier: "CC-BY-NC-SA-4.0", Family: "CC-BY-NC-SA", Name: "Creative Commons Attribution Non Commercial Share Alike 4.0 International", ShortName: "CC-BY-NC-SA-4.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-NC-SA-4.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-ND-1.0":                         {Identifier: "CC-BY-ND-1.0", Family: "CC-BY-ND", Name: "Creative Commons Attribution No Derivatives 1.0 Generic", ShortName: "CC-BY-ND-1.0", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-ND-1.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-ND-2.0":                         {Identifier: "CC-BY-ND-2.0", Family: "CC-BY-ND", Name: "Creative Commons Attribution No Derivatives 2.0 Generic", ShortName: "CC-BY-ND-2.0", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-ND-2.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-ND-2.5":                         {Identifier: "CC-BY-ND-2.5", Family: "CC-BY-ND", Name: "Creative Commons Attribution No Derivatives 2.5 Generic", ShortName: "CC-BY-ND-2.5", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-ND-2.5.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-ND-3.0":                         {Identifier: "CC-BY-ND-3.0", Family: "CC-BY-ND", Name: "Creative Commons Attribution No Derivatives 3.0 Unported", ShortName: "CC-BY-ND-3.0", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-ND-3.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-ND-3.0-DE":                      {Identifier: "CC-BY-ND-3.0-DE", Family: "CC-BY-ND", Name: "Creative Commons Attribution No Derivatives 3.0 Germany", ShortName: "Creative Commons Attribution No Derivatives 3.0 Germany", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-ND-3.0-DE.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-ND-4.0":                         {Identifier: "CC-BY-ND-4.0", Family: "CC-BY-ND", Name: "Creative Commons Attribution No Derivatives 4.0 International", ShortName: "CC-BY-ND-4.0", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-ND-4.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-SA-1.0":                         {Identifier: "CC-BY-SA-1.0", Family: "CC-BY-SA", Name: "Creative Commons Attribution Share Alike 1.0 Generic", ShortName: "CC-BY-SA-1.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-SA-1.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-SA-2.0":                         {Identifier: "CC-BY-SA-2.0", Family: "CC-BY-SA", Name: "Creative Commons Attribution Share Alike 2.0 Generic", ShortName: "CC-BY-SA-2.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-SA-2.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-SA-2.0-UK":                      {Identifier: "CC-BY-SA-2.0-UK", Family: "CC-BY-SA", Name: "Creative Commons Attribution Share Alike 2.0 England and Wales", ShortName: "Creative Commons Attribution Share Alike 2.0 England and Wales", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-SA-2.0-UK.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-SA-2.1-JP":                      {Identifier: "CC-BY-SA-2.1-JP", Family: "CC-BY-SA", Name: "Creative Commons Attribution Share Alike 2.1 Japan", ShortName: "Creative Commons Attribution Share Alike 2.1 Japan", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-SA-2.1-JP.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-SA-2.5":                         {Identifier: "CC-BY-SA-2.5", Family: "CC-BY-SA", Name: "Creative Commons Attribution Share Alike 2.5 Generic", ShortName: "CC-BY-SA-2.5", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-SA-2.5.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-SA-3.0":                         {Identifier: "CC-BY-SA-3.0", Family: "CC-BY-SA", Name: "Creative Commons Attribution Share Alike 3.0 Unported", ShortName: "CC-BY-SA-3.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-SA-3.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-SA-3.0-AT":                      {Identifier: "CC-BY-SA-3.0-AT", Family: "CC-BY-SA", Name: "Creative Commons Attribution Share Alike 3.0 Austria", ShortName: "Creative Commons Attribution Share Alike 3.0 Austria", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-SA-3.0-AT.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-SA-3.0-DE":                      {Identifier: "CC-BY-SA-3.0-DE", Family: "CC-BY-SA", Name: "Creative Commons Attribution Share Alike 3.0 Germany", ShortName: "Creative Commons Attribution Share Alike 3.0 Germany", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-SA-3.0-DE.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-SA-3.0-IGO":                     {Identifier: "CC-BY-SA-3.0-IGO", Family: "CC-BY-SA", Name: "Creative Commons Attribution-ShareAlike 3.0 IGO", ShortName: "Creative Commons Attribution-ShareAlike 3.0 IGO", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-SA-3.0-IGO.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC-BY-SA-4.0":                         {Identifier: "CC-BY-SA-4.0", Family: "CC-BY-SA", Name: "Creative Commons Attribution Share Alike 4.0 International", ShortName: "CC-BY-SA-4.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/CC-BY-SA-4.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright, DocumentChanges, SameLicense}, Limitations: []Limitation{NoLiability, NoTrademarkUse, NoPatentUse, NoWarranty}},
+	"CC-PDDC":                              {Identifier: "CC-PDDC", Family: "CC-PDDC", Name: "Creative Commons Public Domain Dedication and Certification", ShortName: "CC Public Domain Dedication", Category: PublicDomain, Type: OpenSource, URL: "https://spdx.org/licenses/CC-PDDC.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CC0-1.0":                              {Identifier: "CC0-1.0", Family: "CC0", Name: "Creative Commons Zero v1.0 Universal", ShortName: "CC0-1.0", Category: PublicDomain, Type: OpenSource, URL: "https://spdx.org/licenses/CC0-1.0.html", Owner: "Creative Commons", OwnerURL: "http://creativecommons.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Limitations: []Limitation{NoLiability, NoTrademarkUse, NoPatentUse, NoWarranty}},
+	"CDDL-1.0":                             {Identifier: "CDDL-1.0", Family: "CDDL", Name: "Common Development and Distribution License 1.0", ShortName: "CDDL 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/CDDL-1.0.html", Owner: "Oracle Corporation", OwnerURL: "http://www.oracle.com/index.html", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"CDDL-1.1":                             {Identifier: "CDDL-1.1", Family: "CDDL", Name: "Common Development and Distribution License 1.1", ShortName: "CDDL 1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/CDDL-1.1.html", Owner: "Oracle Corporation", OwnerURL: "http://www.oracle.com/index.html", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CDL-1.0":                              {Identifier: "CDL-1.0", Family: "CDL", Name: "Common Documentation License 1.0", ShortName: "Common Documentation License 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/CDL-1.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CDLA-Permissive-1.0":                  {Identifier: "CDLA-Permissive-1.0", Family: "CDLA-Permissive", Name: "Community Data License Agreement Permissive 1.0", ShortName: "Community Data License Agreement Permissive 1.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/CDLA-Permissive-1.0.html", Owner: "The Linux Foundation", OwnerURL: "https://www.linuxfoundation.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CDLA-Permissive-2.0":                  {Identifier: "CDLA-Permissive-2.0", Family: "CDLA-Permissive", Name: "Community Data License Agreement Permissive 2.0", ShortName: "CDLA Permissive 2.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/CDLA-Permissive-2.0.html", Owner: "The Linux Foundation", OwnerURL: "https://www.linuxfoundation.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CDLA-Sharing-1.0":                     {Identifier: "CDLA-Sharing-1.0", Family: "CDLA-Sharing", Name: "Community Data License Agreement Sharing 1.0", ShortName: "Community Data License Agreement Sharing 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/CDLA-Sharing-1.0.html", Owner: "The Linux Foundation", OwnerURL: "https://www.linuxfoundation.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CECILL-1.0":                           {Identifier: "CECILL-1.0", Family: "CECILL", Name: "CeCILL Free Software License Agreement v1.0", ShortName: "CeCILL 1.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/CECILL-1.0.html", Owner: "CeCILL", OwnerURL: "http://www.cecill.info/licences.en.html", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CECILL-1.1":                           {Identifier: "CECILL-1.1", Family: "CECILL", Name: "CeCILL Free Software License Agreement v1.1", ShortName: "CeCILL 1.1 English", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/CECILL-1.1.html", Owner: "CeCILL", OwnerURL: "http://www.cecill.info/licences.en.html", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CECILL-2.0":                           {Identifier: "CECILL-2.0", Family: "CECILL", Name: "CeCILL Free Software License Agreement v2.0", ShortName: "CeCILL 2.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/CECILL-2.0.html", Owner: "CeCILL", OwnerURL: "http://www.cecill.info/licences.en.html", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"CECILL-2.1":                           {Identifier: "CECILL-2.1", Family: "CECILL", Name: "CeCILL Free Software License Agreement v2.1", ShortName: "CeCILL 2.1", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/CECILL-2.1.html", Owner: "CeCILL", OwnerURL: "http://www.cecill.info/licences.en.html", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright, DiscloseSource, SameLicense}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"CECILL-B":                             {Identifier: "CECILL-B", Family: "CECILL-B", Name: "CeCILL-B Free Software License Agreement", ShortName: "CeCILL-B License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/CECILL-B.html", Owner: "CeCILL", OwnerURL: "http://www.cecill.info/licences.en.html", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"CECILL-C":                             {Identifier: "CECILL-C", Family: "CECILL-C", Name: "CeCILL-C Free Software License Agreement", ShortName: "CeCILL-C License", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/CECILL-C.html", Owner: "CeCILL", OwnerURL: "http://www.cecill.info/licences.en.html", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"CERN-OHL-1.1":                         {Identifier: "CERN-OHL-1.1", Family: "CERN-OHL", Name: "CERN Open Hardware Licence v1.1", ShortName: "CERN Open Hardware Licence v1.1", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/CERN-OHL-1.1.html", Owner: "CERN", OwnerURL: "https://home.cern/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CERN-OHL-1.2":                         {Identifier: "CERN-OHL-1.2", Family: "CERN-OHL", Name: "CERN Open Hardware Licence v1.2", ShortName: "CERN Open Hardware Licence v1.2", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/CERN-OHL-1.2.html", Owner: "CERN", OwnerURL: "https://home.cern/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CERN-OHL-P-2.0":                       {Identifier: "CERN-OHL-P-2.0", Family: "CERN-OHL-P", Name: "CERN Open Hardware Licence Version 2 - Permissive", ShortName: "CERN Open Hardware Licence Version 2 - Permissive", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/CERN-OHL-P-2.0.html", Owner: "CERN", OwnerURL: "https://home.cern/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CERN-OHL-S-2.0":                       {Identifier: "CERN-OHL-S-2.0", Family: "CERN-OHL-S", Name: "CERN Open Hardware Licence Version 2 - Strongly Reciprocal", ShortName: "CERN Open Hardware Licence Version 2 - Strongly Reciprocal", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/CERN-OHL-S-2.0.html", Owner: "CERN", OwnerURL: "https://home.cern/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CERN-OHL-W-2.0":                       {Identifier: "CERN-OHL-W-2.0", Family: "CERN-OHL-W", Name: "CERN Open Hardware Licence Version 2 - Weakly Reciprocal", ShortName: "CERN Open Hardware Licence Version 2 - Weakly Reciprocal", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/CERN-OHL-W-2.0.html", Owner: "CERN", OwnerURL: "https://home.cern/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CFITSIO":                              {Identifier: "CFITSIO", Family: "CFITSIO", Name: "CFITSIO License", ShortName: "CFITSIO License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/CFITSIO.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CMU-Mach":                             {Identifier: "CMU-Mach", Family: "CMU-Mach", Name: "CMU Mach License", ShortName: "CMU Mach License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/CMU-Mach.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CMU-Mach-nodoc":                       {Identifier: "CMU-Mach-nodoc", Family: "CMU-Mach-nodoc", Name: "CMU    Mach - no notices-in-documentation variant", ShortName: "CMU Mach - no notices-in-documentation variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/CMU-Mach-nodoc.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CNRI-Jython":                          {Identifier: "CNRI-Jython", Family: "CNRI-Jython", Name: "CNRI Jython License", ShortName: "CNRI Jython License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/CNRI-Jython.html", Owner: "CNRI", OwnerURL: "http://www.cnri.reston.va.us/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CNRI-Python":                          {Identifier: "CNRI-Python", Family: "CNRI-Python", Name: "CNRI Python License", ShortName: "CNRI Python 1.6", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/CNRI-Python.html", Owner: "CNRI", OwnerURL: "http://www.cnri.reston.va.us/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CNRI-Python-GPL-Compatible":           {Identifier: "CNRI-Python-GPL-Compatible", Family: "CNRI-Python-GPL-Compatible", Name: "CNRI Python Open Source GPL Compatible License Agreement", ShortName: "CNRI Python 1.6.1", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/CNRI-Python-GPL-Compatible.html", Owner: "CNRI", OwnerURL: "http://www.cnri.reston.va.us/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"COIL-1.0":                             {Identifier: "COIL-1.0", Family: "COIL", Name: "Copyfree Open Innovation License", ShortName: "Copyfree Open Innovation License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/COIL-1.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CPAL-1.0":                             {Identifier: "CPAL-1.0", Family: "CPAL", Name: "Common Public Attribution License 1.0", ShortName: "CPAL 1.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/CPAL-1.0.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"CPL-1.0":                              {Identifier: "CPL-1.0", Family: "CPL", Name: "Common Public License 1.0", ShortName: "CPL 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/CPL-1.0.html", Owner: "IBM", OwnerURL: "http://www.ibm.com/developerworks/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"CPOL-1.02":                            {Identifier: "CPOL-1.02", Family: "CPOL", Name: "Code Project Open License 1.02", ShortName: "CPOL 1.02", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/CPOL-1.02.html", Owner: "Code Project", OwnerURL: "http://www.codeproject.com/", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CUA-OPL-1.0":                          {Identifier: "CUA-OPL-1.0", Family: "CUA-OPL", Name: "CUA Office Public License v1.0", ShortName: "CUA-OPL-1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/CUA-OPL-1.0.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Caldera":                              {Identifier: "Caldera", Family: "Caldera", Name: "Caldera License", ShortName: "Caldera License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Caldera.html", Owner: "Caldera", OwnerURL: "http://www.caldera.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Caldera-no-preamble":                  {Identifier: "Caldera-no-preamble", Family: "Caldera-no-preamble", Name: "Caldera License (without preamble)", ShortName: "Caldera License (without preamble)", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Caldera-no-preamble.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"ClArtistic":                           {Identifier: "ClArtistic", Family: "ClArtistic", Name: "Clarified Artistic License", ShortName: "Clarified Artistic License", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/ClArtistic.html", Owner: "Fedora", OwnerURL: "http://fedoraproject.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"Clips":                                {Identifier: "Clips", Family: "Clips", Name: "Clips License", ShortName: "Clips License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Clips.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Community-Spec-1.0":                   {Identifier: "Community-Spec-1.0", Family: "Community-Spec", Name: "Community Specification License 1.0", ShortName: "Community Specification License 1.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Community-Spec-1.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Condor-1.1":                           {Identifier: "Condor-1.1", Family: "Condor", Name: "Condor Public License v1.1", ShortName: "Condor Public License 1.1", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Condor-1.1.html", Owner: "Condor Project", OwnerURL: "http://www.cs.wisc.edu/condor/", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"Cornell-Lossless-JPEG":                {Identifier: "Cornell-Lossless-JPEG", Family: "Cornell-Lossless-JPEG", Name: "Cornell Lossless JPEG License", ShortName: "Cornell Lossless JPEG License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Cornell-Lossless-JPEG.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Cronyx":                               {Identifier: "Cronyx", Family: "Cronyx", Name: "Cronyx License", ShortName: "Cronyx License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Cronyx.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Crossword":                            {Identifier: "Crossword", Family: "Crossword", Name: "Crossword License", ShortName: "Crossword License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Crossword.html", Owner: "Gerd Neugebauer", OwnerURL: "", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"CrystalStacker":                       {Identifier: "CrystalStacker", Family: "CrystalStacker", Name: "CrystalStacker License", ShortName: "Crystal Stacker License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/CrystalStacker.html", Owner: "NewCreature Design", OwnerURL: "http://www.ncdgames.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Cube":                                 {Identifier: "Cube", Family: "Cube", Name: "Cube License", ShortName: "Cube License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Cube.html", Owner: "Wouter van Oortmerssen", OwnerURL: "", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"D-FSL-1.0":                            {Identifier: "D-FSL-1.0", Family: "D-FSL", Name: "Deutsche Freie Software Lizenz", ShortName: "Deutsche Freie Software Lizenz", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/D-FSL-1.0.html", Owner: "Institute for Legal Issues On Free and Open Source Software", OwnerURL: "http://www.ifross.de/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"D-FSL-1.0-DE":                         {Identifier: "D-FSL-1.0-DE", Family: "D-FSL", Name: "Deutsche Freie Software Lizenz", ShortName: "Deutsche Freie Software Lizenz", Category: CopyLeft, Type: OpenSource, URL: "http://www.dipp.nrw.de/d-fsl/index_html/lizenzen/de/D-FSL-1_0_de.txt", Owner: "Institute for Legal Issues On Free and Open Source Software", OwnerURL: "http://www.ifross.de/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: false},
+	"DEC-3-Clause":                         {Identifier: "DEC-3-Clause", Family: "DEC", Name: "DEC 3-Clause License", ShortName: "DEC 3-Clause License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/DEC-3-Clause.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"DL-DE-BY-2.0":                         {Identifier: "DL-DE-BY-2.0", Family: "DL-DE-BY", Name: "Data licence Germany – attribution – version 2.0", ShortName: "Data licence Germany – attribution – version 2.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/DL-DE-BY-2.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"DL-DE-ZERO-2.0":                       {Identifier: "DL-DE-ZERO-2.0", Family: "DL-DE-ZERO", Name: "Data licence Germany – zero – version 2.0", ShortName: "Data licence Germany – zero – version 2.0", Category: PublicDomain, Type: OpenSource, URL: "https://spdx.org/licenses/DL-DE-ZERO-2.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"DOC":                                  {Identifier: "DOC", Family: "DOC", Name: "DOC License", ShortName: "ACE TAO License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/DOC.html", Owner: "Douglas Schmidt", OwnerURL: "http://www.cs.wustl.edu/~schmidt/", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"DRL-1.0":                              {Identifier: "DRL-1.0", Family: "DRL", Name: "Detection Rule License 1.0", ShortName: "Detection Rule License 1.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/DRL-1.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"DRL-1.1":                              {Identifier: "DRL-1.1", Family: "DRL", Name: "Detection Rule License 1.1", ShortName: "Detection Rule License 1.1", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/DRL-1.1.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"DSDP":                                 {Identifier: "DSDP", Family: "DSDP", Name: "DSDP License", ShortName: "DSDP License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/DSDP.html", Owner: "University of Chicago", OwnerURL: "http://www.uchicago.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Dotseqn":                              {Identifier: "Dotseqn", Family: "Dotseqn", Name: "Dotseqn License", ShortName: "Dotseqn License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Dotseqn.html", Owner: "Donald Arsenau", OwnerURL: "", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"ECL-1.0":                              {Identifier: "ECL-1.0", Family: "ECL", Name: "Educational Community License v1.0", ShortName: "ECL 1.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/ECL-1.0.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"ECL-2.0":                              {Identifier: "ECL-2.0", Family: "ECL", Name: "Educational Community License v2.0", ShortName: "ECL 2.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/ECL-2.0.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{IncludeCopyright, DocumentChanges}, Limitations: []Limitation{NoTrademarkUse, NoLiability, NoWarranty}},
+	"EFL-1.0":                              {Identifier: "EFL-1.0", Family: "EFL", Name: "Eiffel Forum License v1.0", ShortName: "EFL 1.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/EFL-1.0.html", Owner: "Eiffel NICE", OwnerURL: "http://www.eiffel-nice.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"EFL-2.0":                              {Identifier: "EFL-2.0", Family: "EFL", Name: "Eiffel Forum License v2.0", ShortName: "EFL 2.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/EFL-2.0.html", Owner: "Eiffel NICE", OwnerURL: "http://www.eiffel-nice.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"EPICS":                                {Identifier: "EPICS", Family: "EPICS", Name: "EPICS Open License", ShortName: "EPICS Open License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/EPICS.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"EPL-1.0":                              {Identifier: "EPL-1.0", Family: "EPL", Name: "Eclipse Public License 1.0", ShortName: "EPL 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/EPL-1.0.html", Owner: "Eclipse Foundation", OwnerURL: "http://www.eclipse.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{DiscloseSource, IncludeCopyright, SameLicense}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"EPL-2.0":                              {Identifier: "EPL-2.0", Family: "EPL", Name: "Eclipse Public License 2.0", ShortName: "EPL 2.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/EPL-2.0.html", Owner: "Eclipse Foundation", OwnerURL: "http://www.eclipse.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{DiscloseSource, IncludeCopyright, SameLicense}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"EUDatagrid":                           {Identifier: "EUDatagrid", Family: "EUDatagrid", Name: "EU DataGrid Software License", ShortName: "EU DataGrid Software License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/EUDatagrid.html", Owner: "DataGrid Project", OwnerURL: "http://eu-datagrid.web.cern.ch/eu-datagrid/default.htm", OwnerType: Project, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"EUPL-1.0":                             {Identifier: "EUPL-1.0", Family: "EUPL", Name: "European Union Public License 1.0", ShortName: "EUPL 1.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/EUPL-1.0.html", Owner: "OSOR.eu", OwnerURL: "http://www.osor.eu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"EUPL-1.1":                             {Identifier: "EUPL-1.1", Family: "EUPL", Name: "European Union Public License 1.1", ShortName: "EUPL 1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/EUPL-1.1.html", Owner: "OSOR.eu", OwnerURL: "http://www.osor.eu/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{DiscloseSource, IncludeCopyright, DocumentChanges, NetworkUseDisclose, SameLicense}, Limitations: []Limitation{NoLiability, NoTrademarkUse, NoWarranty}},
+	"EUPL-1.2":                             {Identifier: "EUPL-1.2", Family: "EUPL", Name: "European Union Public License 1.2", ShortName: "EUPL 1.2", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/EUPL-1.2.html", Owner: "OSOR.eu", OwnerURL: "http://www.osor.eu/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{DiscloseSource, IncludeCopyright, DocumentChanges, NetworkUseDisclose, SameLicense}, Limitations: []Limitation{NoLiability, NoTrademarkUse, NoWarranty}},
+	"Elastic-2.0":                          {Identifier: "Elastic-2.0", Family: "Elastic", Name: "Elastic License 2.0", ShortName: "Elastic License 2.0", Category: FreeRestricted, Type: Proprietary, URL: "https://spdx.org/licenses/Elastic-2.0.html", Owner: "Elastic", OwnerURL: "https://www.elastic.co/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Entessa":                              {Identifier: "Entessa", Family: "Entessa", Name: "Entessa Public License v1.0", ShortName: "Entessa 1.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Entessa.html", Owner: "Entessa", OwnerURL: "http://esi.entessa.com/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"ErlPL-1.1":                            {Identifier: "ErlPL-1.1", Family: "ErlPL", Name: "Erlang Public License v1.1", ShortName: "Erlang Public License 1.1", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/ErlPL-1.1.html", Owner: "Erlang", OwnerURL: "http://www.erlang.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Eurosym":                              {Identifier: "Eurosym", Family: "Eurosym", Name: "Eurosym License", ShortName: "Eurosym License", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/Eurosym.html", Owner: "Henrik Theiling", OwnerURL: "", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"FBM":                                  {Identifier: "FBM", Family: "FBM", Name: "Fuzzy Bitmap License", ShortName: "Fuzzy Bitmap License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/FBM.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"FDK-AAC":                              {Identifier: "FDK-AAC", Family: "FDK-AAC", Name: "Fraunhofer FDK AAC Codec Library", ShortName: "Fraunhofer FDK AAC Codec Library", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/FDK-AAC.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"FSFAP":                                {Identifier: "FSFAP", Family: "FSFAP", Name: "FSF All Permissive License", ShortName: "FSF All Permissive License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/FSFAP.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"FSFAP-no-warranty-disclaimer":         {Identifier: "FSFAP-no-warranty-disclaimer", Family: "FSFAP-no-warranty-disclaimer", Name: "FSF All Permissive License (without Warranty)", ShortName: "FSF All Permissive License (without Warranty)", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/FSFAP-no-warranty-disclaimer.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"FSFUL":                                {Identifier: "FSFUL", Family: "FSFUL", Name: "FSF Unlimited License", ShortName: "FSF Free Software License", Category: PublicDomain, Type: OpenSource, URL: "https://spdx.org/licenses/FSFUL.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"FSFULLR":                              {Identifier: "FSFULLR", Family: "FSFULLR", Name: "FSF Unlimited License (with License Retention)", ShortName: "FSF-Unlimited", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/FSFULLR.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"FSFULLRWD":                            {Identifier: "FSFULLRWD", Family: "FSFULLRWD", Name: "FSF Unlimited License (With License Retention and Warranty Disclaimer)", ShortName: "FSF Unlimited License (With License Retention and Warranty Disclaimer)", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/FSFULLRWD.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"FTL":                                  {Identifier: "FTL", Family: "FTL", Name: "Freetype Project License", ShortName: "FreeType Project License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/FTL.html", Owner: "FreeType Project", OwnerURL: "http://www.freetype.org/index2.html", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"Fair":                                 {Identifier: "Fair", Family: "Fair", Name: "Fair License", ShortName: "Fair License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Fair.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Ferguson-Twofish":                     {Identifier: "Ferguson-Twofish", Family: "Ferguson-Twofish", Name: "Ferguson Twofish License", ShortName: "Ferguson Twofish License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Ferguson-Twofish.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Frameworx-1.0":                        {Identifier: "Frameworx-1.0", Family: "Frameworx", Name: "Frameworx Open License 1.0", ShortName: "Frameworx 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/Frameworx-1.0.html", Owner: "Frameworx Company", OwnerURL: "http://investing.businessweek.com/research/stocks/private/snapshot.asp?privcapId=691225", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"FreeBSD-DOC":                          {Identifier: "FreeBSD-DOC", Family: "FreeBSD-DOC", Name: "FreeBSD Documentation License", ShortName: "FreeBSD Documentation License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/FreeBSD-DOC.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"FreeImage":                            {Identifier: "FreeImage", Family: "FreeImage", Name: "FreeImage Public License v1.0", ShortName: "FreeImage Public License 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/FreeImage.html", Owner: "FreeImage Project", OwnerURL: "http://freeimage.sourceforge.net/", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Furuseth":                             {Identifier: "Furuseth", Family: "Furuseth", Name: "Furuseth License", ShortName: "Furuseth License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Furuseth.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"GCR-docs":                             {Identifier: "GCR-docs", Family: "GCR-docs", Name: "Gnome GCR Documentation License", ShortName: "Gnome GCR Documentation License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/GCR-docs.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"GD":                                   {Identifier: "GD", Family: "GD", Name: "GD License", ShortName: "GD License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/GD.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"GFDL-1.1":                             {Identifier: "GFDL-1.1", Family: "GFDL", Name: "GNU Free Documentation License v1.1", ShortName: "GFDL 1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GFDL-1.1.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: true, IsSPDXListed: true},
+	"GFDL-1.1-invariants-only":             {Identifier: "GFDL-1.1-invariants-only", Family: "GFDL", Name: "GNU Free Documentation License v1.1 only - invariants", ShortName: "GNU Free Documentation License v1.1 only - invariants", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GFDL-1.1-invariants-only.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"GFDL-1.1-invariants-or-later":         {Identifier: "GFDL-1.1-invariants-or-later", Family: "GFDL", Name: "GNU Free Documentation License v1.1 or later - invariants", ShortName: "GNU Free Documentation License v1.1 or later - invariants", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GFDL-1.1-invariants-or-later.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"GFDL-1.1-no-invariants-only":          {Identifier: "GFDL-1.1-no-invariants-only", Family: "GFDL", Name: "GNU Free Documentation License v1.1 only - no invariants", ShortName: "GNU Free Documentation License v1.1 only - no invariants", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GFDL-1.1-no-invariants-only.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"GFDL-1.1-no-invariants-or-later":      {Identifier: "GFDL-1.1-no-invariants-or-later", Family: "GFDL", Name: "GNU Free Documentation License v1.1 or later - no invariants", ShortName: "GNU Free Documentation License v1.1 or later - no invariants", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GFDL-1.1-no-invariants-or-later.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"GFDL-1.1-only":                        {Identifier: "GFDL-1.1-only", Family: "GFDL", Name: "GNU Free Documentation License v1.1 only", ShortName: "GFDL 1.1 only", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GFDL-1.1-only.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"GFDL-1.1-or-later":                    {Identifier: "GFDL-1.1-or-later", Family: "GFDL", Name: "GNU Free Documentation License v1.1 or later", ShortName: "GFDL 1.1 or later", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GFDL-1.1-or-later.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"GFDL-1.2":                             {Identifier: "GFDL-1.2", Family: "GFDL", Name: "GNU Free Documentation License v1.2", ShortName: "GFDL 1.2", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GFDL-1.2.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: true, IsSPDXListed: true},
+	"GFDL-1.2-invariants-only":             {Identifier: "GFDL-1.2-invariants-only", Family: "GFDL", Name: "GNU Free Documentation License v1.2 only - invariants", ShortName: "GNU Free Documentation License v1.2 only - invariants", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GFDL-1.2-invariants-only.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"GFDL-1.2-invariants-or-later":         {Identifier: "GFDL-1.2-invariants-or-later", Family: "GFDL", Name: "GNU Free Documentation License v1.2 or later - invariants", ShortName: "GNU Free Documentation License v1.2 or later - invariants", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GFDL-1.2-invariants-or-later.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"GFDL-1.2-no-invariants-only":          {Identifier: "GFDL-1.2-no-invariants-only", Family: "GFDL", Name: "GNU Free Documentation License v1.2 only - no invariants", ShortName: "GNU Free Documentation License v1.2 only - no invariants", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GFDL-1.2-no-invariants-only.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"GFDL-1.2-no-invariants-or-later":      {Identifier: "GFDL-1.2-no-invariants-or-later", Family: "GFDL", Name: "GNU Free Documentation License v1.2 or later - no invariants", ShortName: "GNU Free Documentation License v1.2 or later - no invariants", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GFDL-1.2-no-invariants-or-later.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"GFDL-1.2-only":                        {Identifier: "GFDL-1.2-only", Family: "GFDL", Name: "GNU Free Documentation License v1.2 only", ShortName: "GFDL 1.2 only", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GFDL-1.2-only.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"GFDL-1.2-or-later":                    {Identifier: "GFDL-1.2-or-later", Family: "GFDL", Name: "GNU Free Documentation License v1.2 or later", ShortName: "GFDL 1.2 or later", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GFDL-1.2-or-later.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"GFDL-1.3":                             {Identifier: "GFDL-1.3", Family: "GFDL", Name: "GNU Free Documentation License v1.3", ShortName: "GFDL 1.3", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GFDL-1.3.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: true, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright, DiscloseSource, DocumentChanges, SameLicense}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"GFDL-1.3-invariants-only":             {Identifier: "GFDL-1.3-invariants-only", Family: "GFDL", Name: "GNU Free Documentation License v1.3 only - invariants", ShortName: "GNU Free Documentation License v1.3 only - invariants", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GFDL-1.3-invariants-only.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"GFDL-1.3-invariants-or-later":         {Identifier: "GFDL-1.3-invariants-or-later", Family: "GFDL", Name: "GNU Free Documentation License v1.3 or later - invariants", ShortName: "GNU Free Documentation License v1.3 or later - invariants", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GFDL-1.3-invariants-or-later.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"GFDL-1.3-no-invariants-only":          {Identifier: "GFDL-1.3-no-invariants-only", Family: "GFDL", Name: "GNU Free Documentation License v1.3 only - no invariants", ShortName: "GNU Free Documentation License v1.3 only - no invariants", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GFDL-1.3-no-invariants-only.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"GFDL-1.3-no-invariants-or-later":      {Identifier: "GFDL-1.3-no-invariants-or-later", Family: "GFDL", Name: "GNU Free Documentation License v1.3 or later - no invariants", ShortName: "GNU Free Documentation License v1.3 or later - no invariants", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GFDL-1.3-no-invariants-or-later.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"GFDL-1.3-only":                        {Identifier: "GFDL-1.3-only", Family: "GFDL", Name: "GNU Free Documentation License v1.3 only", ShortName: "GFDL 1.3 only", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GFDL-1.3-only.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright, DiscloseSource, DocumentChanges, SameLicense}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"GFDL-1.3-or-later":                    {Identifier: "GFDL-1.3-or-later", Family: "GFDL", Name: "GNU Free Documentation License v1.3 or later", ShortName: "GFDL 1.3 or later", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GFDL-1.3-or-later.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright, DiscloseSource, DocumentChanges, SameLicense}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"GL2PS":                                {Identifier: "GL2PS", Family: "GL2PS", Name: "GL2PS License", ShortName: "GL2PS License", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GL2PS.html", Owner: "Christophe Geuzaine", OwnerURL: "", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"GLWTPL":                               {Identifier: "GLWTPL", Family: "GLWTPL", Name: "Good Luck With That Public License", ShortName: "Good Luck With That Public License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/GLWTPL.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"GPL-1.0":                              {Identifier: "GPL-1.0", Family: "GPL", Name: "GNU General Public License v1.0 only", ShortName: "GPL 1.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/GPL-1.0.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: true, IsSPDXListed: true},
+	"GPL-1.0+":                             {Identifier: "GPL-1.0+", Family: "GPL", Name: "GNU General Public License v1.0 or later", ShortName: "GPL 1.0 or later", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/GPL-1.0+.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"GPL-1.0-only":                         {Identifier: "GPL-1.0-only", Family: "GPL", Name: "GNU General Public License v1.0 only", ShortName: "GPL 1.0 only", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/GPL-1.0-only.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"GPL-1.0-or-later":                     {Identifier: "GPL-1.0-or-later", Family: "GPL", Name: "GNU General Public License v1.0 or later", ShortName: "GPL 1.0 or later", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/GPL-1.0-or-later.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"GPL-2.0":                              {Identifier: "GPL-2.0", Family: "GPL", Name: "GNU General Public License v2.0 only", ShortName: "GPL 2.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/GPL-2.0.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: true, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright, DocumentChanges, DiscloseSource, SameLicense}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"GPL-2.0+":                             {Identifier: "GPL-2.0+", Family: "GPL", Name: "GNU General Public License v2.0 or later", ShortName: "GPL 2.0 or later", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/GPL-2.0+.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright, DocumentChanges, DiscloseSource, SameLicense}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"GPL-2.0-only":                         {Identifier: "GPL-2.0-only", Family: "GPL", Name: "GNU General Public License v2.0 only", ShortName: "GPL 2.0 only", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/GPL-2.0-only.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright, DocumentChanges, DiscloseSource, SameLicense}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"GPL-2.0-or-later":                     {Identifier: "GPL-2.0-or-later", Family: "GPL", Name: "GNU General Public License v2.0 or later", ShortName: "GPL 2.0 or later", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/GPL-2.0-or-later.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright, DocumentChanges, DiscloseSource, SameLicense}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"GPL-2.0-with-GCC-exception":           {Identifier: "GPL-2.0-with-GCC-exception", Family: "GPL", Name: "GNU General Public License v2.0 w/GCC Runtime Library exception", ShortName: "GPL 2.0 with GCC exception", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GPL-2.0-with-GCC-exception.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: true, IsSPDXListed: true},
+	"GPL-2.0-with-autoconf-exception":      {Identifier: "GPL-2.0-with-autoconf-exception", Family: "GPL", Name: "GNU General Public License v2.0 w/Autoconf exception", ShortName: "GPL 2.0 with autoconf exception", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GPL-2.0-with-autoconf-exception.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: true, IsSPDXListed: true},
+	"GPL-2.0-with-bison-exception":         {Identifier: "GPL-2.0-with-bison-exception", Family: "GPL", Name: "GNU General Public License v2.0 w/Bison exception", ShortName: "GPL 2.0 with Bison exception", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GPL-2.0-with-bison-exception.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: true, IsSPDXListed: true},
+	"GPL-2.0-with-classpath-exception":     {Identifier: "GPL-2.0-with-classpath-exception", Family: "GPL", Name: "GNU General Public License v2.0 w/Classpath exception", ShortName: "GPL 2.0 with classpath exception", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GPL-2.0-with-classpath-exception.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: true, IsSPDXListed: true},
+	"GPL-2.0-with-font-exception":          {Identifier: "GPL-2.0-with-font-exception", Family: "GPL", Name: "GNU General Public License v2.0 w/Font exception", ShortName: "GPL 2.0 with font exception", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GPL-2.0-with-font-exception.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: true, IsSPDXListed: true},
+	"GPL-3.0":                              {Identifier: "GPL-3.0", Family: "GPL", Name: "GNU General Public License v3.0 only", ShortName: "GPL 3.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/GPL-3.0.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: true, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{IncludeCopyright, DocumentChanges, DiscloseSource, SameLicense}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"GPL-3.0+":                             {Identifier: "GPL-3.0+", Family: "GPL", Name: "GNU General Public License v3.0 or later", ShortName: "GPL 3.0 or later", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/GPL-3.0+.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{IncludeCopyright, DocumentChanges, DiscloseSource, SameLicense}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"GPL-3.0-only":                         {Identifier: "GPL-3.0-only", Family: "GPL", Name: "GNU General Public License v3.0 only", ShortName: "GPL 3.0 only", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/GPL-3.0-only.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{IncludeCopyright, DocumentChanges, DiscloseSource, SameLicense}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"GPL-3.0-or-later":                     {Identifier: "GPL-3.0-or-later", Family: "GPL", Name: "GNU General Public License v3.0 or later", ShortName: "GPL 3.0 or later", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/GPL-3.0-or-later.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{IncludeCopyright, DocumentChanges, DiscloseSource, SameLicense}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"GPL-3.0-with-GCC-exception":           {Identifier: "GPL-3.0-with-GCC-exception", Family: "GPL", Name: "GNU General Public License v3.0 w/GCC Runtime Library exception", ShortName: "GCC Runtime Library Exception 3.1", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GPL-3.0-with-GCC-exception.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: true, IsSPDXListed: true},
+	"GPL-3.0-with-autoconf-exception":      {Identifier: "GPL-3.0-with-autoconf-exception", Family: "GPL", Name: "GNU General Public License v3.0 w/Autoconf exception", ShortName: "GPL 3.0 with autoconf exception", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/GPL-3.0-with-autoconf-exception.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: true, IsSPDXListed: true},
+	"Giftware":                             {Identifier: "Giftware", Family: "Giftware", Name: "Giftware License", ShortName: "Allegro 4 License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Giftware.html", Owner: "Allegro Project", OwnerURL: "http://alleg.sourceforge.net//readme.html", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Glide":                                {Identifier: "Glide", Family: "Glide", Name: "3dfx Glide License", ShortName: "3DFX GLIDE", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/Glide.html", Owner: "NVIDIA", OwnerURL: "http://www.nvidia.com/page/home.html", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Glulxe":                               {Identifier: "Glulxe", Family: "Glulxe", Name: "Glulxe License", ShortName: "Glulxe License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Glulxe.html", Owner: "Andrew Plotkin", OwnerURL: "", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Graphics-Gems":                        {Identifier: "Graphics-Gems", Family: "Graphics-Gems", Name: "Graphics Gems License", ShortName: "Graphics Gems License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Graphics-Gems.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"HP-1986":                              {Identifier: "HP-1986", Family: "HP", Name: "Hewlett-Packard 1986 License", ShortName: "Hewlett-Packard 1986 License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/HP-1986.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"HP-1989":                              {Identifier: "HP-1989", Family: "HP", Name: "Hewlett-Packard 1989 License", ShortName: "Hewlett-Packard 1989 License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/HP-1989.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"HPND":                                 {Identifier: "HPND", Family: "HPND", Name: "Historical Permission Notice and Disclaimer", ShortName: "Historical Permission Notice and Disclaimer", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/HPND.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"HPND-DEC":                             {Identifier: "HPND-DEC", Family: "HPND-DEC", Name: "Historical Permission Notice and Disclaimer - DEC variant", ShortName: "Historical Permission Notice and Disclaimer - DEC variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/HPND-DEC.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"HPND-Fenneberg-Livingston":            {Identifier: "HPND-Fenneberg-Livingston", Family: "HPND-Fenneberg-Livingston", Name: "Historical Permission Notice and Disclaimer - Fenneberg-Livingston variant", ShortName: "Historical Permission Notice and Disclaimer - Fenneberg-Livingston variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/HPND-Fenneberg-Livingston.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"HPND-INRIA-IMAG":                      {Identifier: "HPND-INRIA-IMAG", Family: "HPND-INRIA-IMAG", Name: "Historical Permission Notice and Disclaimer    - INRIA-IMAG variant", ShortName: "Historical Permission Notice and Disclaimer - INRIA-IMAG variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/HPND-INRIA-IMAG.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"HPND-Kevlin-Henney":                   {Identifier: "HPND-Kevlin-Henney", Family: "HPND-Kevlin-Henney", Name: "Historical Permission Notice and Disclaimer - Kevlin Henney variant", ShortName: "Historical Permission Notice and Disclaimer - Kevlin Henney variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/HPND-Kevlin-Henney.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"HPND-MIT-disclaimer":                  {Identifier: "HPND-MIT-disclaimer", Family: "HPND-MIT-disclaimer", Name: "Historical Permission Notice and Disclaimer with MIT disclaimer", ShortName: "Historical Permission Notice and Disclaimer with MIT disclaimer", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/HPND-MIT-disclaimer.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"HPND-Markus-Kuhn":                     {Identifier: "HPND-Markus-Kuhn", Family: "HPND-Markus-Kuhn", Name: "Historical Permission Notice and Disclaimer - Markus Kuhn variant", ShortName: "Historical Permission Notice and Disclaimer - Markus Kuhn variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/HPND-Markus-Kuhn.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"HPND-Pbmplus":                         {Identifier: "HPND-Pbmplus", Family: "HPND-Pbmplus", Name: "Historical Permission Notice and Disclaimer - Pbmplus variant", ShortName: "Historical Permission Notice and Disclaimer - Pbmplus variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/HPND-Pbmplus.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"HPND-UC":                              {Identifier: "HPND-UC", Family: "HPND-UC", Name: "Historical Permission Notice and Disclaimer - University of California variant", ShortName: "Historical Permission Notice and Disclaimer - University of California variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/HPND-UC.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"HPND-doc":                             {Identifier: "HPND-doc", Family: "HPND-doc", Name: "Historical Permission Notice and Disclaimer - documentation variant", ShortName: "Historical Permission Notice and Disclaimer - documentation variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/HPND-doc.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"HPND-doc-sell":                        {Identifier: "HPND-doc-sell", Family: "HPND-doc-sell", Name: "Historical Permission Notice and Disclaimer - documentation sell variant", ShortName: "Historical Permission Notice and Disclaimer - documentation sell variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/HPND-doc-sell.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"HPND-export-US":                       {Identifier: "HPND-export-US", Family: "HPND-export-US", Name: "HPND with US Government export control warning", ShortName: "HPND with US Government export control warning", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/HPND-export-US.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"HPND-export-US-modify":                {Identifier: "HPND-export-US-modify", Family: "HPND-export-US-modify", Name: "HPND with US Government export control warning and modification rqmt", ShortName: "HPND with US Government export control warning and modification rqmt", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/HPND-export-US-modify.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"HPND-sell-MIT-disclaimer-xserver":     {Identifier: "HPND-sell-MIT-disclaimer-xserver", Family: "HPND-sell-MIT-disclaimer-xserver", Name: "Historical Permission Notice and Disclaimer - sell xserver variant with MIT disclaimer", ShortName: "Historical Permission Notice and Disclaimer - sell xserver variant with MIT disclaimer", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/HPND-sell-MIT-disclaimer-xserver.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"HPND-sell-regexpr":                    {Identifier: "HPND-sell-regexpr", Family: "HPND-sell-regexpr", Name: "Historical Permission Notice and Disclaimer - sell regexpr variant", ShortName: "Historical Permission Notice and Disclaimer - sell regexpr variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/HPND-sell-regexpr.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"HPND-sell-variant":                    {Identifier: "HPND-sell-variant", Family: "HPND-sell-variant", Name: "Historical Permission Notice and Disclaimer - sell variant", ShortName: "Historical Permission Notice and Disclaimer - sell variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/HPND-sell-variant.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"HPND-sell-variant-MIT-disclaimer":     {Identifier: "HPND-sell-variant-MIT-disclaimer", Family: "HPND-sell-variant-MIT-disclaimer", Name: "HPND sell variant with MIT disclaimer", ShortName: "HPND sell variant with MIT disclaimer", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/HPND-sell-variant-MIT-disclaimer.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"HTMLTIDY":                             {Identifier: "HTMLTIDY", Family: "HTMLTIDY", Name: "HTML Tidy License", ShortName: "HTML Tidy License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/HTMLTIDY.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"HaskellReport":                        {Identifier: "HaskellReport", Family: "HaskellReport", Name: "Haskell Language Report License", ShortName: "Haskell Report License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/HaskellReport.html", Owner: "Simon Marlow", OwnerURL: "", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Hippocratic-2.1":                      {Identifier: "Hippocratic-2.1", Family: "Hippocratic", Name: "Hippocratic License 2.1", ShortName: "Hippocratic License 2.1", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/Hippocratic-2.1.html", Owner: "Organization for Ethical Source", OwnerURL: "https://ethicalsource.dev/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"IBM-pibs":                             {Identifier: "IBM-pibs", Family: "IBM-pibs", Name: "IBM PowerPC Initialization and Boot Software", ShortName: "IBM PowerPC Software", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/IBM-pibs.html", Owner: "IBM", OwnerURL: "http://www.ibm.com/developerworks/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"ICU":                                  {Identifier: "ICU", Family: "ICU", Name: "ICU License", ShortName: "ICU Composite License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/ICU.html", Owner: "IBM", OwnerURL: "http://www.ibm.com/developerworks/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"IEC-Code-Components-EULA":             {Identifier: "IEC-Code-Components-EULA", Family: "IEC-Code-Components-EULA", Name: "IEC    Code Components End-user licence agreement", ShortName: "IEC Code Components End-user licence agreement", Category: ProprietaryFree, Type: Proprietary, URL: "https://spdx.org/licenses/IEC-Code-Components-EULA.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"IJG":                                  {Identifier: "IJG", Family: "IJG", Name: "Independent JPEG Group License", ShortName: "JPEG License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/IJG.html", Owner: "IJG - Independent JPEG Group", OwnerURL: "http://www.ijg.org/", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"IJG-short":                            {Identifier: "IJG-short", Family: "IJG-short", Name: "Independent JPEG Group License - short", ShortName: "Independent JPEG Group License - short", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/IJG-short.html", Owner: "IJG - Independent JPEG Group", OwnerURL: "http://www.ijg.org/", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"IPA":                                  {Identifier: "IPA", Family: "IPA", Name: "IPA Font License", ShortName: "IPA Font License 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/IPA.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"IPL-1.0":                              {Identifier: "IPL-1.0", Family: "IPL", Name: "IBM Public License v1.0", ShortName: "IPL 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/IPL-1.0.html", Owner: "IBM", OwnerURL: "http://www.ibm.com/developerworks/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"ISC":                                  {Identifier: "ISC", Family: "ISC", Name: "ISC License", ShortName: "ISC License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/ISC.html", Owner: "ISC - Internet Systems Consortium", OwnerURL: "https://www.isc.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"ISC-Veillard":                         {Identifier: "ISC-Veillard", Family: "ISC-Veillard", Name: "ISC Veillard variant", ShortName: "ISC Veillard variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/ISC-Veillard.html", Owner: "ISC - Internet Systems Consortium", OwnerURL: "https://www.isc.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"ImageMagick":                          {Identifier: "ImageMagick", Family: "ImageMagick", Name: "ImageMagick License", ShortName: "ImageMagick License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/ImageMagick.html", Owner: "ImageMagick", OwnerURL: "http://www.imagemagick.org/script/index.php", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Imlib2":                               {Identifier: "Imlib2", Family: "Imlib2", Name: "Imlib2 License", ShortName: "Imlib2 License", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/Imlib2.html", Owner: "Enlightenment", OwnerURL: "http://www.enlightenment.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"Info-ZIP":                             {Identifier: "Info-ZIP", Family: "Info-ZIP", Name: "Info-ZIP License", ShortName: "Info-Zip License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Info-ZIP.html", Owner: "info-zip", OwnerURL: "http://www.info-zip.org/", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Inner-Net-2.0":                        {Identifier: "Inner-Net-2.0", Family: "Inner-Net", Name: "Inner Net License v2.0", ShortName: "Inner Net License v2.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Inner-Net-2.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Intel":                                {Identifier: "Intel", Family: "Intel", Name: "Intel Open Source License", ShortName: "Intel BSD - Export Control", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Intel.html", Owner: "Intel Corporation", OwnerURL: "http://www.intel.com/about/index.htm?iid=gg_about-en_US+intel_aboutintel", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"Intel-ACPI":                           {Identifier: "Intel-ACPI", Family: "Intel-ACPI", Name: "Intel ACPI Software License Agreement", ShortName: "Intel ACPI SLA", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Intel-ACPI.html", Owner: "Intel Corporation", OwnerURL: "http://www.intel.com/about/index.htm?iid=gg_about-en_US+intel_aboutintel", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Interbase-1.0":                        {Identifier: "Interbase-1.0", Family: "Interbase", Name: "Interbase Public License v1.0", ShortName: "Interbase Public License 1.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/Interbase-1.0.html", Owner: "Borland", OwnerURL: "http://www.borland.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"JPL-image":                            {Identifier: "JPL-image", Family: "JPL-image", Name: "JPL Image Use Policy", ShortName: "JPL Image Use Policy", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/JPL-image.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"JPNIC":                                {Identifier: "JPNIC", Family: "JPNIC", Name: "Japan Network Information Center License", ShortName: "Japan Network Information Center License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/JPNIC.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"JSON":                                 {Identifier: "JSON", Family: "JSON", Name: "JSON License", ShortName: "JSON License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/JSON.html", Owner: "JSON.org", OwnerURL: "http://json.org/", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Jam":                                  {Identifier: "Jam", Family: "Jam", Name: "Jam License", ShortName: "Jam License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Jam.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"JasPer-2.0":                           {Identifier: "JasPer-2.0", Family: "JasPer", Name: "JasPer License", ShortName: "Jasper 2.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/JasPer-2.0.html", Owner: "JasperSoft", OwnerURL: "http://www.jaspersoft.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Kastrup":                              {Identifier: "Kastrup", Family: "Kastrup", Name: "Kastrup License", ShortName: "Kastrup License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Kastrup.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Kazlib":                               {Identifier: "Kazlib", Family: "Kazlib", Name: "Kazlib License", ShortName: "Kazlib License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Kazlib.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Knuth-CTAN":                           {Identifier: "Knuth-CTAN", Family: "Knuth-CTAN", Name: "Knuth CTAN License", ShortName: "Knuth CTAN License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Knuth-CTAN.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"LAL-1.2":                              {Identifier: "LAL-1.2", Family: "LAL", Name: "Licence Art Libre 1.2", ShortName: "Licence Art Libre 1.2", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/LAL-1.2.html", Owner: "Licence Art Libre", OwnerURL: "http://artlibre.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"LAL-1.3":                              {Identifier: "LAL-1.3", Family: "LAL", Name: "Licence Art Libre 1.3", ShortName: "Licence Art Libre 1.3", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/LAL-1.3.html", Owner: "Licence Art Libre", OwnerURL: "http://artlibre.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"LGPL-2.0":                             {Identifier: "LGPL-2.0", Family: "LGPL", Name: "GNU Library General Public License v2 only", ShortName: "LGPL 2.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/LGPL-2.0.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: true, IsSPDXListed: true},
+	"LGPL-2.0+":                            {Identifier: "LGPL-2.0+", Family: "LGPL", Name: "GNU Library General Public License v2 or later", ShortName: "LGPL 2.0 or later", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/LGPL-2.0+.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"LGPL-2.0-only":                        {Identifier: "LGPL-2.0-only", Family: "LGPL", Name: "GNU Library General Public License v2 only", ShortName: "LGPL 2.0 only", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/LGPL-2.0-only.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"LGPL-2.0-or-later":                    {Identifier: "LGPL-2.0-or-later", Family: "LGPL", Name: "GNU Library General Public License v2 or later", ShortName: "LGPL 2.0 or later", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/LGPL-2.0-or-later.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"LGPL-2.1":                             {Identifier: "LGPL-2.1", Family: "LGPL", Name: "GNU Lesser General Public License v2.1 only", ShortName: "LGPL 2.1", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/LGPL-2.1.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: true, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright, DiscloseSource, DocumentChanges, SameLicenseLibrary}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"LGPL-2.1+":                            {Identifier: "LGPL-2.1+", Family: "LGPL", Name: "GNU Lesser General Public License v2.1 or later", ShortName: "LGPL 2.1 or later", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/LGPL-2.1+.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright, DiscloseSource, DocumentChanges, SameLicenseLibrary}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"LGPL-2.1-only":                        {Identifier: "LGPL-2.1-only", Family: "LGPL", Name: "GNU Lesser General Public License v2.1 only", ShortName: "LGPL 2.1 only", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/LGPL-2.1-only.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright, DiscloseSource, DocumentChanges, SameLicenseLibrary}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"LGPL-2.1-or-later":                    {Identifier: "LGPL-2.1-or-later", Family: "LGPL", Name: "GNU Lesser General Public License v2.1 or later", ShortName: "LGPL 2.1 or later", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/LGPL-2.1-or-later.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright, DiscloseSource, DocumentChanges, SameLicenseLibrary}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"LGPL-3.0":                             {Identifier: "LGPL-3.0", Family: "LGPL", Name: "GNU Lesser General Public License v3.0 only", ShortName: "LGPL 3.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/LGPL-3.0.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: true, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{IncludeCopyright, DiscloseSource, DocumentChanges, SameLicenseLibrary}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"LGPL-3.0+":                            {Identifier: "LGPL-3.0+", Family: "LGPL", Name: "GNU Lesser General Public License v3.0 or later", ShortName: "LGPL 3.0 or later", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/LGPL-3.0+.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{IncludeCopyright, DiscloseSource, DocumentChanges, SameLicenseLibrary}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"LGPL-3.0-only":                        {Identifier: "LGPL-3.0-only", Family: "LGPL", Name: "GNU Lesser General Public License v3.0 only", ShortName: "LGPL 3.0 only", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/LGPL-3.0-only.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{IncludeCopyright, DiscloseSource, DocumentChanges, SameLicenseLibrary}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"LGPL-3.0-or-later":                    {Identifier: "LGPL-3.0-or-later", Family: "LGPL", Name: "GNU Lesser General Public License v3.0 or later", ShortName: "LGPL 3.0 or later", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/LGPL-3.0-or-later.html", Owner: "Free Software Foundation (FSF)", OwnerURL: "http://www.fsf.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{IncludeCopyright, DiscloseSource, DocumentChanges, SameLicenseLibrary}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"LGPLLR":                               {Identifier: "LGPLLR", Family: "LGPLLR", Name: "Lesser General Public License For Linguistic Resources", ShortName: "LGPLLR", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/LGPLLR.html", Owner: "Unitex GramLab", OwnerURL: "http://www-igm.univ-mlv.fr/~unitex/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"LOOP":                                 {Identifier: "LOOP", Family: "LOOP", Name: "Common Lisp LOOP License", ShortName: "Common Lisp LOOP License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/LOOP.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"LPD-document":                         {Identifier: "LPD-document", Family: "LPD-document", Name: "LPD Documentation License", ShortName: "LPD Documentation License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/LPD-document.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"LPL-1.0":                              {Identifier: "LPL-1.0", Family: "LPL", Name: "Lucent Public License Version 1.0", ShortName: "Lucent Public License 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/LPL-1.0.html", Owner: "Alcatel-Lucent", OwnerURL: "http://www.alcatel-lucent.com/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"LPL-1.02":                             {Identifier: "LPL-1.02", Family: "LPL", Name: "Lucent Public License v1.02", ShortName: "Lucent Public License 1.02", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/LPL-1.02.html", Owner: "Alcatel-Lucent", OwnerURL: "http://www.alcatel-lucent.com/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"LPPL-1.0":                             {Identifier: "LPPL-1.0", Family: "LPPL", Name: "LaTeX Project Public License v1.0", ShortName: "LPPL 1.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/LPPL-1.0.html", Owner: "LaTeX", OwnerURL: "http://www.latex-project.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"LPPL-1.1":                             {Identifier: "LPPL-1.1", Family: "LPPL", Name: "LaTeX Project Public License v1.1", ShortName: "LPPL 1.1", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/LPPL-1.1.html", Owner: "LaTeX", OwnerURL: "http://www.latex-project.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"LPPL-1.2":                             {Identifier: "LPPL-1.2", Family: "LPPL", Name: "LaTeX Project Public License v1.2", ShortName: "LPPL 1.2", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/LPPL-1.2.html", Owner: "LaTeX", OwnerURL: "http://www.latex-project.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"LPPL-1.3a":                            {Identifier: "LPPL-1.3a", Family: "LPPL", Name: "LaTeX Project Public License v1.3a", ShortName: "LPPL 1.3a", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/LPPL-1.3a.html", Owner: "LaTeX", OwnerURL: "http://www.latex-project.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"LPPL-1.3c":                            {Identifier: "LPPL-1.3c", Family: "LPPL", Name: "LaTeX Project Public License v1.3c", ShortName: "LPPL 1.3c", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/LPPL-1.3c.html", Owner: "LaTeX", OwnerURL: "http://www.latex-project.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright, DocumentChanges, DiscloseSource}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"LZMA-SDK-9.11-to-9.20":                {Identifier: "LZMA-SDK-9.11-to-9.20", Family: "LZMA-SDK", Name: "LZMA SDK License (versions 9.11 to 9.20)", ShortName: "LZMA SDK License (versions 9.11 to 9.20)", Category: PublicDomain, Type: OpenSource, URL: "https://spdx.org/licenses/LZMA-SDK-9.11-to-9.20.html", Owner: "Igor Pavlov", OwnerURL: "https://www.7-zip.org/sdk.html", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"LZMA-SDK-9.22":                        {Identifier: "LZMA-SDK-9.22", Family: "LZMA-SDK", Name: "LZMA SDK License (versions 9.22 and beyond)", ShortName: "LZMA SDK License (versions 9.22 and beyond)", Category: PublicDomain, Type: OpenSource, URL: "https://spdx.org/licenses/LZMA-SDK-9.22.html", Owner: "Igor Pavlov", OwnerURL: "https://www.7-zip.org/sdk.html", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Latex2e":                              {Identifier: "Latex2e", Family: "Latex2e", Name: "Latex2e License", ShortName: "Latex2e License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Latex2e.html", Owner: "LaTeX", OwnerURL: "http://www.latex-project.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Latex2e-translated-notice":            {Identifier: "Latex2e-translated-notice", Family: "Latex2e-translated-notice", Name: "Latex2e with translated notice permission", ShortName: "Latex2e with translated notice permission", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Latex2e-translated-notice.html", Owner: "LaTeX", OwnerURL: "http://www.latex-project.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Leptonica":                            {Identifier: "Leptonica", Family: "Leptonica", Name: "Leptonica License", ShortName: "Leptonica License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Leptonica.html", Owner: "Leptonica", OwnerURL: "http://www.leptonica.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"LiLiQ-P-1.1":                          {Identifier: "LiLiQ-P-1.1", Family: "LiLiQ-P", Name: "Licence Libre du Québec – Permissive version 1.1", ShortName: "LiLiQ-P-1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/LiLiQ-P-1.1.html", Owner: "Quebec", OwnerURL: "", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"LiLiQ-R-1.1":                          {Identifier: "LiLiQ-R-1.1", Family: "LiLiQ-R", Name: "Licence Libre du Québec – Réciprocité version 1.1", ShortName: "LiLiQ-R-1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/LiLiQ-R-1.1.html", Owner: "Quebec", OwnerURL: "", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"LiLiQ-Rplus-1.1":                      {Identifier: "LiLiQ-Rplus-1.1", Family: "LiLiQ-Rplus", Name: "Licence Libre du Québec – Réciprocité forte version 1.1", ShortName: "LiLiQ-Rplus-1.1", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/LiLiQ-Rplus-1.1.html", Owner: "Quebec", OwnerURL: "", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Libpng":                               {Identifier: "Libpng", Family: "Libpng", Name: "libpng License", ShortName: "Libpng License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Libpng.html", Owner: "libpng", OwnerURL: "http://www.libpng.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Linux-OpenIB":                         {Identifier: "Linux-OpenIB", Family: "Linux-OpenIB", Name: "Linux Kernel Variant of OpenIB.org license", ShortName: "Linux Kernel Variant of OpenIB.org license", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Linux-OpenIB.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Linux-man-pages-1-para":               {Identifier: "Linux-man-pages-1-para", Family: "Linux-man-pages", Name: "Linux man-pages - 1 paragraph", ShortName: "Linux man-pages - 1 paragraph", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Linux-man-pages-1-para.html", Owner: "Linux man-pages project", OwnerURL: "https://www.kernel.org/doc/man-pages/", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Linux-man-pages-copyleft":             {Identifier: "Linux-man-pages-copyleft", Family: "Linux-man-pages-copyleft", Name: "Linux man-pages Copyleft", ShortName: "Linux man-pages Copyleft", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/Linux-man-pages-copyleft.html", Owner: "Linux man-pages project", OwnerURL: "https://www.kernel.org/doc/man-pages/", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Linux-man-pages-copyleft-2-para":      {Identifier: "Linux-man-pages-copyleft-2-para", Family: "Linux-man-pages-copyleft", Name: "Linux man-pages Copyleft - 2 paragraphs", ShortName: "Linux man-pages Copyleft - 2 paragraphs", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/Linux-man-pages-copyleft-2-para.html", Owner: "Linux man-pages project", OwnerURL: "https://www.kernel.org/doc/man-pages/", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Linux-man-pages-copyleft-var":         {Identifier: "Linux-man-pages-copyleft-var", Family: "Linux-man-pages-copyleft-var", Name: "Linux man-pages Copyleft Variant", ShortName: "Linux man-pages Copyleft Variant", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/Linux-man-pages-copyleft-var.html", Owner: "Linux man-pages project", OwnerURL: "https://www.kernel.org/doc/man-pages/", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Lucida-Bitmap-Fonts":                  {Identifier: "Lucida-Bitmap-Fonts", Family: "Lucida-Bitmap-Fonts", Name: "Lucida Bitmap Fonts License", ShortName: "Lucida Bitmap Fonts License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Lucida-Bitmap-Fonts.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"MIT":                                  {Identifier: "MIT", Family: "MIT", Name: "MIT License", ShortName: "MIT License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/MIT.html", Owner: "MIT", OwnerURL: "http://web.mit.edu/aboutmit/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"MIT-0":                                {Identifier: "MIT-0", Family: "MIT", Name: "MIT No Attribution", ShortName: "MIT No Attribution", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/MIT-0.html", Owner: "Amazon Web Services", OwnerURL: "http://aws.amazon.com/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"MIT-CMU":                              {Identifier: "MIT-CMU", Family: "MIT-CMU", Name: "CMU License", ShortName: "CMU UC Regents License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/MIT-CMU.html", Owner: "Carnegie Mellon University", OwnerURL: "http://www.cmu.edu/about/index.shtml", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"MIT-Festival":                         {Identifier: "MIT-Festival", Family: "MIT-Festival", Name: "MIT Festival Variant", ShortName: "MIT Festival Variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/MIT-Festival.html", Owner: "MIT", OwnerURL: "http://web.mit.edu/aboutmit/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"MIT-Modern-Variant":                   {Identifier: "MIT-Modern-Variant", Family: "MIT-Modern-Variant", Name: "MIT License Modern Variant", ShortName: "MIT License Modern Variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/MIT-Modern-Variant.html", Owner: "MIT", OwnerURL: "http://web.mit.edu/aboutmit/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"MIT-Wu":                               {Identifier: "MIT-Wu", Family: "MIT-Wu", Name: "MIT Tom Wu Variant", ShortName: "MIT Tom Wu Variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/MIT-Wu.html", Owner: "MIT", OwnerURL: "http://web.mit.edu/aboutmit/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"MIT-advertising":                      {Identifier: "MIT-advertising", Family: "MIT-advertising", Name: "Enlightenment License (e16)", ShortName: "EFL MIT-Style License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/MIT-advertising.html", Owner: "Enlightenment", OwnerURL: "http://www.enlightenment.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"MIT-enna":                             {Identifier: "MIT-enna", Family: "MIT-enna", Name: "enna License", ShortName: "enna License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/MIT-enna.html", Owner: "MIT", OwnerURL: "http://web.mit.edu/aboutmit/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"MIT-feh":                              {Identifier: "MIT-feh", Family: "MIT-feh", Name: "feh License", ShortName: "MIT Acknowledgment License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/MIT-feh.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"MIT-open-group":                       {Identifier: "MIT-open-group", Family: "MIT-open-group", Name: "MIT Open Group variant", ShortName: "MIT Open Group variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/MIT-open-group.html", Owner: "MIT", OwnerURL: "http://web.mit.edu/aboutmit/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"MIT-testregex":                        {Identifier: "MIT-testregex", Family: "MIT-testregex", Name: "MIT testregex Variant", ShortName: "MIT testregex Variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/MIT-testregex.html", Owner: "MIT", OwnerURL: "http://web.mit.edu/aboutmit/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"MITNFA":                               {Identifier: "MITNFA", Family: "MITNFA", Name: "MIT +no-false-attribs license", ShortName: "MIT no false attribution License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/MITNFA.html", Owner: "npm Registry", OwnerURL: "https://npmjs.org/", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"MMIXware":                             {Identifier: "MMIXware", Family: "MMIXware", Name: "MMIXware License", ShortName: "MMIXware License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/MMIXware.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"MPEG-SSG":                             {Identifier: "MPEG-SSG", Family: "MPEG-SSG", Name: "MPEG Software Simulation", ShortName: "MPEG Software Simulation", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/MPEG-SSG.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"MPL-1.0":                              {Identifier: "MPL-1.0", Family: "MPL", Name: "Mozilla Public License 1.0", ShortName: "MPL 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/MPL-1.0.html", Owner: "Mozilla", OwnerURL: "http://www.mozilla.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"MPL-1.1":                              {Identifier: "MPL-1.1", Family: "MPL", Name: "Mozilla Public License 1.1", ShortName: "MPL 1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/MPL-1.1.html", Owner: "Mozilla", OwnerURL: "http://www.mozilla.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"MPL-2.0":                              {Identifier: "MPL-2.0", Family: "MPL", Name: "Mozilla Public License 2.0", ShortName: "MPL 2.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/MPL-2.0.html", Owner: "Mozilla", OwnerURL: "http://www.mozilla.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{DiscloseSource, IncludeCopyright, SameLicenseFile}, Limitations: []Limitation{NoLiability, NoTrademarkUse, NoWarranty}},
+	"MPL-2.0-no-copyleft-exception":        {Identifier: "MPL-2.0-no-copyleft-exception", Family: "MPL", Name: "Mozilla Public License 2.0 (no copyleft exception)", ShortName: "MPL 2.0 with no copyleft exception", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/MPL-2.0-no-copyleft-exception.html", Owner: "Mozilla", OwnerURL: "http://www.mozilla.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"MS-LPL":                               {Identifier: "MS-LPL", Family: "MS-LPL", Name: "Microsoft Limited Public License", ShortName: "Microsoft Limited Public License", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/MS-LPL.html", Owner: "Microsoft", OwnerURL: "http://msdn.microsoft.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"MS-PL":                                {Identifier: "MS-PL", Family: "MS-PL", Name: "Microsoft Public License", ShortName: "MS-PL", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/MS-PL.html", Owner: "Microsoft", OwnerURL: "http://msdn.microsoft.com/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{IncludeCopyright}, Limitations: []Limitation{NoTrademarkUse, NoWarranty}},
+	"MS-RL":                                {Identifier: "MS-RL", Family: "MS-RL", Name: "Microsoft Reciprocal License", ShortName: "MS-RL", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/MS-RL.html", Owner: "Microsoft", OwnerURL: "http://msdn.microsoft.com/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{DiscloseSource, IncludeCopyright, SameLicenseFile}, Limitations: []Limitation{NoTrademarkUse, NoWarranty}},
+	"MTLL":                                 {Identifier: "MTLL", Family: "MTLL", Name: "Matrix Template Library License", ShortName: "Matrix Template Library License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/MTLL.html", Owner: "Indiana University", OwnerURL: "http://www.extreme.indiana.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Mackerras-3-Clause":                   {Identifier: "Mackerras-3-Clause", Family: "Mackerras", Name: "Mackerras 3-Clause License", ShortName: "Mackerras 3-Clause License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Mackerras-3-Clause.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Mackerras-3-Clause-acknowledgment":    {Identifier: "Mackerras-3-Clause-acknowledgment", Family: "Mackerras", Name: "Mackerras 3-Clause - acknowledgment variant", ShortName: "Mackerras 3-Clause - acknowledgment variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Mackerras-3-Clause-acknowledgment.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"MakeIndex":                            {Identifier: "MakeIndex", Family: "MakeIndex", Name: "MakeIndex License", ShortName: "MakeIndex Distribution Notice", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/MakeIndex.html", Owner: "MakeIndex Project", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Martin-Birgmeier":                     {Identifier: "Martin-Birgmeier", Family: "Martin-Birgmeier", Name: "Martin Birgmeier License", ShortName: "Martin Birgmeier License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Martin-Birgmeier.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"McPhee-slideshow":                     {Identifier: "McPhee-slideshow", Family: "McPhee-slideshow", Name: "McPhee Slideshow License", ShortName: "McPhee Slideshow License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/McPhee-slideshow.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Minpack":                              {Identifier: "Minpack", Family: "Minpack", Name: "Minpack License", ShortName: "Minpack License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Minpack.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"MirOS":                                {Identifier: "MirOS", Family: "MirOS", Name: "The MirOS Licence", ShortName: "MirOS License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/MirOS.html", Owner: "MirOS Project", OwnerURL: "https://www.mirbsd.org/", OwnerType: Project, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Motosoto":                             {Identifier: "Motosoto", Family: "Motosoto", Name: "Motosoto License", ShortName: "Motosoto 0.9.1", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/Motosoto.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"MulanPSL-1.0":                         {Identifier: "MulanPSL-1.0", Family: "MulanPSL", Name: "Mulan Permissive Software License, Version 1", ShortName: "Mulan Permissive Software License, Version 1", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/MulanPSL-1.0.html", Owner: "Mulan Open Source Community", OwnerURL: "http://license.coscl.org.cn/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"MulanPSL-2.0":                         {Identifier: "MulanPSL-2.0", Family: "MulanPSL", Name: "Mulan Permissive Software License, Version 2", ShortName: "Mulan PSL v2", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/MulanPSL-2.0.html", Owner: "Mulan Open Source Community", OwnerURL: "http://license.coscl.org.cn/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{IncludeCopyright}, Limitations: []Limitation{NoTrademarkUse, NoLiability, NoWarranty}},
+	"Multics":                              {Identifier: "Multics", Family: "Multics", Name: "Multics License", ShortName: "Multics License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Multics.html", Owner: "Multics", OwnerURL: "http://www.multicians.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Mup":                                  {Identifier: "Mup", Family: "Mup", Name: "Mup License", ShortName: "Mup License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Mup.html", Owner: "Arkkra Enterprises", OwnerURL: "http://www.arkkra.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"NAIST-2003":                           {Identifier: "NAIST-2003", Family: "NAIST", Name: "Nara Institute of Science and Technology License (2003)", ShortName: "Nara Institute of Science and Technology License (2003)", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/NAIST-2003.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"NASA-1.3":                             {Identifier: "NASA-1.3", Family: "NASA", Name: "NASA Open Source Agreement 1.3", ShortName: "NASA 1.3", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/NASA-1.3.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"NBPL-1.0":                             {Identifier: "NBPL-1.0", Family: "NBPL", Name: "Net Boolean Public License v1", ShortName: "NBPL-1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/NBPL-1.0.html", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"NCGL-UK-2.0":                          {Identifier: "NCGL-UK-2.0", Family: "NCGL-UK", Name: "Non-Commercial Government Licence", ShortName: "Non-Commercial Government Licence", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/NCGL-UK-2.0.html", Owner: "The National Archives", OwnerURL: "https://www.nationalarchives.gov.uk/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"NCSA":                                 {Identifier: "NCSA", Family: "NCSA", Name: "University of Illinois/NCSA Open Source License", ShortName: "NCSA Open Source License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/NCSA.html", Owner: "NCSA - University of Illinois", OwnerURL: "http://www.ncsa.illinois.edu/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"NGPL":                                 {Identifier: "NGPL", Family: "NGPL", Name: "Nethack General Public License", ShortName: "Nethack General Public License", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/NGPL.html", Owner: "NetHack", OwnerURL: "http://www.nethack.org/", OwnerType: Project, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"NICTA-1.0":                            {Identifier: "NICTA-1.0", Family: "NICTA", Name: "NICTA Public Software License, Version 1.0", ShortName: "NICTA Public Software License, Version 1.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/NICTA-1.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"NIST-PD":                              {Identifier: "NIST-PD", Family: "NIST-PD", Name: "NIST Public Domain Notice", ShortName: "NIST Public Domain Notice", Category: PublicDomain, Type: OpenSource, URL: "https://spdx.org/licenses/NIST-PD.html", Owner: "National Institute of Standards and Technology", OwnerURL: "https://www.nist.gov/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"NIST-PD-fallback":                     {Identifier: "NIST-PD-fallback", Family: "NIST-PD-fallback", Name: "NIST Public Domain Notice with license fallback", ShortName: "NIST Public Domain Notice with license fallback", Category: PublicDomain, Type: OpenSource, URL: "https://spdx.org/licenses/NIST-PD-fallback.html", Owner: "National Institute of Standards and Technology", OwnerURL: "https://www.nist.gov/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"NIST-Software":                        {Identifier: "NIST-Software", Family: "NIST-Software", Name: "NIST Software License", ShortName: "NIST Software License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/NIST-Software.html", Owner: "National Institute of Standards and Technology", OwnerURL: "https://www.nist.gov/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"NLOD-1.0":                             {Identifier: "NLOD-1.0", Family: "NLOD", Name: "Norwegian Licence for Open Government Data (NLOD) 1.0", ShortName: "NLOD-1.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/NLOD-1.0.html", Owner: "Norway", OwnerURL: "http://data.norge.no/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"NLOD-2.0":                             {Identifier: "NLOD-2.0", Family: "NLOD", Name: "Norwegian Licence for Open Government Data (NLOD) 2.0", ShortName: "Norwegian Licence for Open Government Data (NLOD) 2.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/NLOD-2.0.html", Owner: "Norway", OwnerURL: "http://data.norge.no/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"NLPL":                                 {Identifier: "NLPL", Family: "NLPL", Name: "No Limit Public License", ShortName: "NLPL", Category: PublicDomain, Type: OpenSource, URL: "https://spdx.org/licenses/NLPL.html", Owner: "Gilles Lamiral", OwnerURL: "", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"NOSL":                                 {Identifier: "NOSL", Family: "NOSL", Name: "Netizen Open Source License", ShortName: "NOSL 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/NOSL.html", Owner: "Netizen", OwnerURL: "http://www.netizen.com.au/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"NPL-1.0":                              {Identifier: "NPL-1.0", Family: "NPL", Name: "Netscape Public License v1.0", ShortName: "NPL 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/NPL-1.0.html", Owner: "Mozilla", OwnerURL: "http://www.mozilla.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"NPL-1.1":                              {Identifier: "NPL-1.1", Family: "NPL", Name: "Netscape Public License v1.1", ShortName: "NPL 1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/NPL-1.1.html", Owner: "Mozilla", OwnerURL: "http://www.mozilla.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"NPOSL-3.0":                            {Identifier: "NPOSL-3.0", Family: "NPOSL", Name: "Non-Profit Open Software License 3.0", ShortName: "Non-Profit OSL 3.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/NPOSL-3.0.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"NRL":                                  {Identifier: "NRL", Family: "NRL", Name: "NRL License", ShortName: "NRL License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/NRL.html", Owner: "NRL", OwnerURL: "http://www.nrl.navy.mil/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"NTP":                                  {Identifier: "NTP", Family: "NTP", Name: "NTP License", ShortName: "NTP License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/NTP.html", Owner: "University of Delaware", OwnerURL: "http://www.eecis.udel.edu/~mills/ntp/html/copyright.html", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"NTP-0":                                {Identifier: "NTP-0", Family: "NTP", Name: "NTP No Attribution", ShortName: "NTP No Attribution", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/NTP-0.html", Owner: "University of Delaware", OwnerURL: "http://www.eecis.udel.edu/~mills/ntp/html/copyright.html", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Naumen":                               {Identifier: "Naumen", Family: "Naumen", Name: "Naumen Public License", ShortName: "NAUMEN Public License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Naumen.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Net-SNMP":                             {Identifier: "Net-SNMP", Family: "Net-SNMP", Name: "Net-SNMP License", ShortName: "Net-SNMP License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Net-SNMP.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"NetCDF":                               {Identifier: "NetCDF", Family: "NetCDF", Name: "NetCDF license", ShortName: "NetCDF License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/NetCDF.html", Owner: "Unidata", OwnerURL: "http://www.unidata.ucar.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Newsletr":                             {Identifier: "Newsletr", Family: "Newsletr", Name: "Newsletr License", ShortName: "Newsletr License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Newsletr.html", Owner: "Hunter Goatley", OwnerURL: "", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Nokia":                                {Identifier: "Nokia", Family: "Nokia", Name: "Nokia Open Source License", ShortName: "NOKOS License 1.0a", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/Nokia.html", Owner: "Nokia", OwnerURL: "http://www.nokia.com/about-nokia", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"Noweb":                                {Identifier: "Noweb", Family: "Noweb", Name: "Noweb License", ShortName: "Noweb License", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/Noweb.html", Owner: "Norman Ramsey", OwnerURL: "", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Nunit":                                {Identifier: "Nunit", Family: "Nunit", Name: "Nunit License", ShortName: "NUnit v2 License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Nunit.html", Owner: "Charlie Poole", OwnerURL: "", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: true, IsSPDXListed: true},
+	"O-UDA-1.0":                            {Identifier: "O-UDA-1.0", Family: "O-UDA", Name: "Open Use of Data Agreement v1.0", ShortName: "Open Use of Data Agreement v1.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/O-UDA-1.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OCCT-PL":                              {Identifier: "OCCT-PL", Family: "OCCT-PL", Name: "Open CASCADE Technology Public License", ShortName: "OCCT-PL", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/OCCT-PL.html", Owner: "Open Cascade", OwnerURL: "http://www.opencascade.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OCLC-2.0":                             {Identifier: "OCLC-2.0", Family: "OCLC", Name: "OCLC Research Public License 2.0", ShortName: "OCLC Research Public License 2.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/OCLC-2.0.html", Owner: "OCLC Research", OwnerURL: "http://www.oclc.org/research/default.htm", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"ODC-By-1.0":                           {Identifier: "ODC-By-1.0", Family: "ODC-By", Name: "Open Data Commons Attribution License v1.0", ShortName: "Open Data Commons Attribution License v1.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/ODC-By-1.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"ODbL-1.0":                             {Identifier: "ODbL-1.0", Family: "ODbL", Name: "Open Data Commons Open Database License v1.0", ShortName: "ODbL 1.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/ODbL-1.0.html", Owner: "Open Data Commons", OwnerURL: "http://opendatacommons.org/about/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright, SameLicense}, Limitations: []Limitation{NoLiability, NoPatentUse, NoTrademarkUse, NoWarranty}},
+	"OFFIS":                                {Identifier: "OFFIS", Family: "OFFIS", Name: "OFFIS License", ShortName: "OFFIS License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OFFIS.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OFL-1.0":                              {Identifier: "OFL-1.0", Family: "OFL", Name: "SIL Open Font License 1.0", ShortName: "OFL 1.0", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/OFL-1.0.html", Owner: "SIL International", OwnerURL: "http://scripts.sil.org/cms/scripts/page.php?item_id=OFL_web", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"OFL-1.0-RFN":                          {Identifier: "OFL-1.0-RFN", Family: "OFL", Name: "SIL Open Font License 1.0 with Reserved Font Name", ShortName: "SIL Open Font License 1.0 with Reserved Font Name", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/OFL-1.0-RFN.html", Owner: "SIL International", OwnerURL: "http://scripts.sil.org/cms/scripts/page.php?item_id=OFL_web", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OFL-1.0-no-RFN":                       {Identifier: "OFL-1.0-no-RFN", Family: "OFL", Name: "SIL Open Font License 1.0 with no Reserved Font Name", ShortName: "SIL Open Font License 1.0 with no Reserved Font Name", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/OFL-1.0-no-RFN.html", Owner: "SIL International", OwnerURL: "http://scripts.sil.org/cms/scripts/page.php?item_id=OFL_web", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OFL-1.1":                              {Identifier: "OFL-1.1", Family: "OFL", Name: "SIL Open Font License 1.1", ShortName: "OFL 1.1", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/OFL-1.1.html", Owner: "SIL International", OwnerURL: "http://scripts.sil.org/cms/scripts/page.php?item_id=OFL_web", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright, SameLicense}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"OFL-1.1-RFN":                          {Identifier: "OFL-1.1-RFN", Family: "OFL", Name: "SIL Open Font License 1.1 with Reserved Font Name", ShortName: "SIL Open Font License 1.1 with Reserved Font Name", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/OFL-1.1-RFN.html", Owner: "SIL International", OwnerURL: "http://scripts.sil.org/cms/scripts/page.php?item_id=OFL_web", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OFL-1.1-no-RFN":                       {Identifier: "OFL-1.1-no-RFN", Family: "OFL", Name: "SIL Open Font License 1.1 with no Reserved Font Name", ShortName: "SIL Open Font License 1.1 with no Reserved Font Name", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/OFL-1.1-no-RFN.html", Owner: "SIL International", OwnerURL: "http://scripts.sil.org/cms/scripts/page.php?item_id=OFL_web", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OGC-1.0":                              {Identifier: "OGC-1.0", Family: "OGC", Name: "OGC Software License, Version 1.0", ShortName: "OGC Software License, Version 1.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OGC-1.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OGDL-Taiwan-1.0":                      {Identifier: "OGDL-Taiwan-1.0", Family: "OGDL-Taiwan", Name: "Taiwan Open Government Data License, version 1.0", ShortName: "Taiwan Open Government Data License, version 1.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OGDL-Taiwan-1.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OGL-Canada-2.0":                       {Identifier: "OGL-Canada-2.0", Family: "OGL-Canada", Name: "Open Government Licence - Canada", ShortName: "Open Government Licence - Canada", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OGL-Canada-2.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OGL-UK-1.0":                           {Identifier: "OGL-UK-1.0", Family: "OGL-UK", Name: "Open Government Licence v1.0", ShortName: "Open Government Licence v1.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OGL-UK-1.0.html", Owner: "The National Archives", OwnerURL: "https://www.nationalarchives.gov.uk/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OGL-UK-2.0":                           {Identifier: "OGL-UK-2.0", Family: "OGL-UK", Name: "Open Government Licence v2.0", ShortName: "Open Government Licence v2.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OGL-UK-2.0.html", Owner: "The National Archives", OwnerURL: "https://www.nationalarchives.gov.uk/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OGL-UK-3.0":                           {Identifier: "OGL-UK-3.0", Family: "OGL-UK", Name: "Open Government Licence v3.0", ShortName: "Open Government Licence v3.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OGL-UK-3.0.html", Owner: "The National Archives", OwnerURL: "https://www.nationalarchives.gov.uk/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OGTSL":                                {Identifier: "OGTSL", Family: "OGTSL", Name: "Open Group Test Suite License", ShortName: "Open Group Test Suite License", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/OGTSL.html", Owner: "Open Group", OwnerURL: "http://www.opengroup.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OLDAP-1.1":                            {Identifier: "OLDAP-1.1", Family: "OLDAP", Name: "Open LDAP Public License v1.1", ShortName: "OpenLDAP Public License 1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/OLDAP-1.1.html", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OLDAP-1.2":                            {Identifier: "OLDAP-1.2", Family: "OLDAP", Name: "Open LDAP Public License v1.2", ShortName: "OpenLDAP Public License 1.2", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/OLDAP-1.2.html", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OLDAP-1.3":                            {Identifier: "OLDAP-1.3", Family: "OLDAP", Name: "Open LDAP Public License v1.3", ShortName: "OpenLDAP Public License 1.3", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/OLDAP-1.3.html", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OLDAP-1.4":                            {Identifier: "OLDAP-1.4", Family: "OLDAP", Name: "Open LDAP Public License v1.4", ShortName: "OpenLDAP Public License 1.4", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/OLDAP-1.4.html", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OLDAP-2.0":                            {Identifier: "OLDAP-2.0", Family: "OLDAP", Name: "Open LDAP Public License v2.0 (or possibly 2.0A and 2.0B)", ShortName: "OpenLDAP Public License 2.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OLDAP-2.0.html", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OLDAP-2.0.1":                          {Identifier: "OLDAP-2.0.1", Family: "OLDAP", Name: "Open LDAP Public License v2.0.1", ShortName: "OpenLDAP Public License 2.0.1", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OLDAP-2.0.1.html", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OLDAP-2.1":                            {Identifier: "OLDAP-2.1", Family: "OLDAP", Name: "Open LDAP Public License v2.1", ShortName: "OpenLDAP Public License 2.1", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OLDAP-2.1.html", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OLDAP-2.2":                            {Identifier: "OLDAP-2.2", Family: "OLDAP", Name: "Open LDAP Public License v2.2", ShortName: "OpenLDAP Public License 2.2", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OLDAP-2.2.html", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OLDAP-2.2.1":                          {Identifier: "OLDAP-2.2.1", Family: "OLDAP", Name: "Open LDAP Public License v2.2.1", ShortName: "OpenLDAP Public License 2.2.1", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OLDAP-2.2.1.html", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OLDAP-2.2.2":                          {Identifier: "OLDAP-2.2.2", Family: "OLDAP", Name: "Open LDAP Public License 2.2.2", ShortName: "OpenLDAP Public License 2.2.2", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OLDAP-2.2.2.html", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OLDAP-2.3":                            {Identifier: "OLDAP-2.3", Family: "OLDAP", Name: "Open LDAP Public License v2.3", ShortName: "OpenLDAP Public License 2.3", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OLDAP-2.3.html", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"OLDAP-2.4":                            {Identifier: "OLDAP-2.4", Family: "OLDAP", Name: "Open LDAP Public License v2.4", ShortName: "OpenLDAP Public License 2.4", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OLDAP-2.4.html", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OLDAP-2.5":                            {Identifier: "OLDAP-2.5", Family: "OLDAP", Name: "Open LDAP Public License v2.5", ShortName: "OpenLDAP Public License 2.5", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OLDAP-2.5.html", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OLDAP-2.6":                            {Identifier: "OLDAP-2.6", Family: "OLDAP", Name: "Open LDAP Public License v2.6", ShortName: "OpenLDAP Public License 2.6", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OLDAP-2.6.html", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OLDAP-2.7":                            {Identifier: "OLDAP-2.7", Family: "OLDAP", Name: "Open LDAP Public License v2.7", ShortName: "OpenLDAP Public License 2.7", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OLDAP-2.7.html", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"OLDAP-2.8":                            {Identifier: "OLDAP-2.8", Family: "OLDAP", Name: "Open LDAP Public License v2.8", ShortName: "OpenLDAP Public License 2.8", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OLDAP-2.8.html", Owner: "OpenLDAP Foundation", OwnerURL: "http://www.openldap.org/foundation/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OLFL-1.3":                             {Identifier: "OLFL-1.3", Family: "OLFL", Name: "Open Logistics Foundation License Version 1.3", ShortName: "Open Logistics Foundation License Version 1.3", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OLFL-1.3.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OML":                                  {Identifier: "OML", Family: "OML", Name: "Open Market License", ShortName: "FastCGI DevKit", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OML.html", Owner: "OpenMarket", OwnerURL: "http://www.openmarket.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OPL-1.0":                              {Identifier: "OPL-1.0", Family: "OPL", Name: "Open Public License v1.0", ShortName: "Open Public License 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/OPL-1.0.html", Owner: "Lutris Technologies, Inc.", OwnerURL: "http://www.lutris.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OPL-UK-3.0":                           {Identifier: "OPL-UK-3.0", Family: "OPL-UK", Name: "United    Kingdom Open Parliament Licence v3.0", ShortName: "United Kingdom Open Parliament Licence v3.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OPL-UK-3.0.html", Owner: "The National Archives", OwnerURL: "https://www.nationalarchives.gov.uk/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OPUBL-1.0":                            {Identifier: "OPUBL-1.0", Family: "OPUBL", Name: "Open Publication License v1.0", ShortName: "Open Publication License v1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/OPUBL-1.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OSET-PL-2.1":                          {Identifier: "OSET-PL-2.1", Family: "OSET-PL", Name: "OSET Public License version 2.1", ShortName: "OSET-PL-2.1", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/OSET-PL-2.1.html", Owner: "OSET Foundation", OwnerURL: "http://www.osetfoundation.org/about", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OSL-1.0":                              {Identifier: "OSL-1.0", Family: "OSL", Name: "Open Software License 1.0", ShortName: "OSL 1.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/OSL-1.0.html", Owner: "Lawrence Rosen", OwnerURL: "http://www.rosenlaw.com/rosen.htm", OwnerType: Person, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"OSL-1.1":                              {Identifier: "OSL-1.1", Family: "OSL", Name: "Open Software License 1.1", ShortName: "OSL 1.1", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/OSL-1.1.html", Owner: "Lawrence Rosen", OwnerURL: "http://www.rosenlaw.com/rosen.htm", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"OSL-2.0":                              {Identifier: "OSL-2.0", Family: "OSL", Name: "Open Software License 2.0", ShortName: "OSL 2.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/OSL-2.0.html", Owner: "Lawrence Rosen", OwnerURL: "http://www.rosenlaw.com/rosen.htm", OwnerType: Person, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"OSL-2.1":                              {Identifier: "OSL-2.1", Family: "OSL", Name: "Open Software License 2.1", ShortName: "OSL 2.1", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/OSL-2.1.html", Owner: "Lawrence Rosen", OwnerURL: "http://www.rosenlaw.com/rosen.htm", OwnerType: Person, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"OSL-3.0":                              {Identifier: "OSL-3.0", Family: "OSL", Name: "Open Software License 3.0", ShortName: "OSL 3.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/OSL-3.0.html", Owner: "Lawrence Rosen", OwnerURL: "http://www.rosenlaw.com/rosen.htm", OwnerType: Person, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{IncludeCopyright, DiscloseSource, DocumentChanges, NetworkUseDisclose, SameLicense}, Limitations: []Limitation{NoTrademarkUse, NoLiability, NoWarranty}},
+	"OpenPBS-2.3":                          {Identifier: "OpenPBS-2.3", Family: "OpenPBS", Name: "OpenPBS v2.3 Software License", ShortName: "OpenPBS v2.3 Software License", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/OpenPBS-2.3.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OpenSSL":                              {Identifier: "OpenSSL", Family: "OpenSSL", Name: "OpenSSL License", ShortName: "OpenSSL/SSLeay License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OpenSSL.html", Owner: "OpenSSL", OwnerURL: "http://www.openssl.org/about/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"OpenSSL-standalone":                   {Identifier: "OpenSSL-standalone", Family: "OpenSSL-standalone", Name: "OpenSSL License - standalone", ShortName: "OpenSSL License - standalone", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OpenSSL-standalone.html", Owner: "OpenSSL", OwnerURL: "http://www.openssl.org/about/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"OpenVision":                           {Identifier: "OpenVision", Family: "OpenVision", Name: "OpenVision License", ShortName: "OpenVision License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/OpenVision.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"PADL":                                 {Identifier: "PADL", Family: "PADL", Name: "PADL License", ShortName: "PADL License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/PADL.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"PDDL-1.0":                             {Identifier: "PDDL-1.0", Family: "PDDL", Name: "Open Data Commons Public Domain Dedication & License 1.0", ShortName: "PDDL 1.0", Category: PublicDomain, Type: OpenSource, URL: "https://spdx.org/licenses/PDDL-1.0.html", Owner: "Open Data Commons", OwnerURL: "http://opendatacommons.org/about/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"PHP-3.0":                              {Identifier: "PHP-3.0", Family: "PHP", Name: "PHP License v3.0", ShortName: "PHP License 3.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/PHP-3.0.html", Owner: "PHP Project", OwnerURL: "http://www.php.net/", OwnerType: Project, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"PHP-3.01":                             {Identifier: "PHP-3.01", Family: "PHP", Name: "PHP License v3.01", ShortName: "PHP License 3.01", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/PHP-3.01.html", Owner: "PHP Project", OwnerURL: "http://www.php.net/", OwnerType: Project, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"PSF-2.0":                              {Identifier: "PSF-2.0", Family: "PSF", Name: "Python Software Foundation License 2.0", ShortName: "PSF 2.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/PSF-2.0.html", Owner: "Python Software Foundation", OwnerURL: "http://www.python.org/psf/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Parity-6.0.0":                         {Identifier: "Parity-6.0.0", Family: "Parity", Name: "The Parity Public License 6.0.0", ShortName: "The Parity Public License 6.0.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/Parity-6.0.0.html", Owner: "License Zero", OwnerURL: "https://licensezero.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Parity-7.0.0":                         {Identifier: "Parity-7.0.0", Family: "Parity", Name: "The Parity Public License 7.0.0", ShortName: "The Parity Public License 7.0.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/Parity-7.0.0.html", Owner: "License Zero", OwnerURL: "https://licensezero.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Pixar":                                {Identifier: "Pixar", Family: "Pixar", Name: "Pixar License", ShortName: "Pixar License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Pixar.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Plexus":                               {Identifier: "Plexus", Family: "Plexus", Name: "Plexus Classworlds License", ShortName: "Classworlds License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Plexus.html", Owner: "Codehaus", OwnerURL: "http://codehaus.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"PolyForm-Noncommercial-1.0.0":         {Identifier: "PolyForm-Noncommercial-1.0.0", Family: "PolyForm-Noncommercial", Name: "PolyForm Noncommercial License 1.0.0", ShortName: "PolyForm Noncommercial 1.0.0", Category: FreeRestricted, Type: Proprietary, URL: "https://spdx.org/licenses/PolyForm-Noncommercial-1.0.0.html", Owner: "PolyForm Project", OwnerURL: "https://polyformproject.org/", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"PolyForm-Small-Business-1.0.0":        {Identifier: "PolyForm-Small-Business-1.0.0", Family: "PolyForm-Small-Business", Name: "PolyForm Small Business License 1.0.0", ShortName: "PolyForm Small Business 1.0.0", Category: FreeRestricted, Type: Proprietary, URL: "https://spdx.org/licenses/PolyForm-Small-Business-1.0.0.html", Owner: "PolyForm Project", OwnerURL: "https://polyformproject.org/", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"PostgreSQL":                           {Identifier: "PostgreSQL", Family: "PostgreSQL", Name: "PostgreSQL License", ShortName: "PostgreSQL License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/PostgreSQL.html", Owner: "PostgreSQL", OwnerURL: "http://www.postgresql.org/about/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyright}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"Python-2.0":                           {Identifier: "Python-2.0", Family: "Python", Name: "Python License 2.0", ShortName: "Python License 2.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Python-2.0.html", Owner: "Python Software Foundation (PSF)", OwnerURL: "http://www.python.org/psf/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"Python-2.0.1":                         {Identifier: "Python-2.0.1", Family: "Python", Name: "Python License 2.0.1", ShortName: "Python License 2.0.1", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Python-2.0.1.html", Owner: "Python Software Foundation (PSF)", OwnerURL: "http://www.python.org/psf/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"QPL-1.0":                              {Identifier: "QPL-1.0", Family: "QPL", Name: "Q Public License 1.0", ShortName: "QPL 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/QPL-1.0.html", Owner: "Trolltech", OwnerURL: "http://doc.trolltech.com/4.0/trolltech.html", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"QPL-1.0-INRIA-2004":                   {Identifier: "QPL-1.0-INRIA-2004", Family: "QPL", Name: "Q Public License 1.0 - INRIA 2004 variant", ShortName: "Q Public License 1.0 - INRIA 2004 variant", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/QPL-1.0-INRIA-2004.html", Owner: "Trolltech", OwnerURL: "http://doc.trolltech.com/4.0/trolltech.html", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Qhull":                                {Identifier: "Qhull", Family: "Qhull", Name: "Qhull License", ShortName: "Qhull License", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/Qhull.html", Owner: "Geometry Center", OwnerURL: "", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"RHeCos-1.1":                           {Identifier: "RHeCos-1.1", Family: "RHeCos", Name: "Red Hat eCos Public License v1.1", ShortName: "Red Hat eCos Public License 1.1", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/RHeCos-1.1.html", Owner: "Red Hat", OwnerURL: "http://www.redhat.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"RPL-1.1":                              {Identifier: "RPL-1.1", Family: "RPL", Name: "Reciprocal Public License 1.1", ShortName: "RPL 1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/RPL-1.1.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"RPL-1.5":                              {Identifier: "RPL-1.5", Family: "RPL", Name: "Reciprocal Public License 1.5", ShortName: "RPL 1.5", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/RPL-1.5.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"RPSL-1.0":                             {Identifier: "RPSL-1.0", Family: "RPSL", Name: "RealNetworks Public Source License v1.0", ShortName: "RealNetworks Public Source License 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/RPSL-1.0.html", Owner: "RealNetworks", OwnerURL: "http://www.realnetworks.com/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"RSA-MD":                               {Identifier: "RSA-MD", Family: "RSA-MD", Name: "RSA Message-Digest License", ShortName: "RSA-MD5 License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/RSA-MD.html", Owner: "RSA (the Security Division of EMC)", OwnerURL: "http://www.rsa.com/rsalabs/node.asp?id=2253", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"RSCPL":                                {Identifier: "RSCPL", Family: "RSCPL", Name: "Ricoh Source Code Public License", ShortName: "Ricoh Source Code Public License 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/RSCPL.html", Owner: "Ricoh Global", OwnerURL: "http://www.ricoh.com/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Rdisc":                                {Identifier: "Rdisc", Family: "Rdisc", Name: "Rdisc License", ShortName: "Rdisc License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Rdisc.html", Owner: "Oracle (Sun)", OwnerURL: "http://www.oracle.com/us/sun/index.html", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Ruby":                                 {Identifier: "Ruby", Family: "Ruby", Name: "Ruby License", ShortName: "Ruby License", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/Ruby.html", Owner: "Ruby", OwnerURL: "http://www.ruby-lang.org/en/", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"SAX-PD":                               {Identifier: "SAX-PD", Family: "SAX-PD", Name: "Sax Public Domain Notice", ShortName: "SAX-PD", Category: PublicDomain, Type: OpenSource, URL: "https://spdx.org/licenses/SAX-PD.html", Owner: "SAX Project", OwnerURL: "http://www.saxproject.org/", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"SAX-PD-2.0":                           {Identifier: "SAX-PD-2.0", Family: "SAX-PD", Name: "Sax Public Domain Notice 2.0", ShortName: "Sax Public Domain Notice 2.0", Category: PublicDomain, Type: OpenSource, URL: "https://spdx.org/licenses/SAX-PD-2.0.html", Owner: "SAX Project", OwnerURL: "http://www.saxproject.org/", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"SCEA":                                 {Identifier: "SCEA", Family: "SCEA", Name: "SCEA Shared Source License", ShortName: "SCEA Shared Source License 1.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/SCEA.html", Owner: "Sony Computer Entertainment", OwnerURL: "http://www.playstation.com/en-us/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"SGI-B-1.0":                            {Identifier: "SGI-B-1.0", Family: "SGI-B", Name: "SGI Free Software License B v1.0", ShortName: "SGI Free Software License B 1.0", Category: FreeRestricted, Type: OpenSource, URL: "https://spdx.org/licenses/SGI-B-1.0.html", Owner: "SGI - Silicon Graphics", OwnerURL: "http://www.sgi.com/company_info/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"SGI-B-1.1":                            {Identifier: "SGI-B-1.1", Family: "SGI-B", Name: "SGI Free Software License B v1.1", ShortName: "SGI Free Software License B 1.1", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/SGI-B-1.1.html", Owner: "SGI - Silicon Graphics", OwnerURL: "http://www.sgi.com/company_info/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"SGI-B-2.0":                            {Identifier: "SGI-B-2.0", Family: "SGI-B", Name: "SGI Free Software License B v2.0", ShortName: "SGI Free Software License B 2.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/SGI-B-2.0.html", Owner: "SGI - Silicon Graphics", OwnerURL: "http://www.sgi.com/company_info/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"SGI-OpenGL":                           {Identifier: "SGI-OpenGL", Family: "SGI-OpenGL", Name: "SGI OpenGL License", ShortName: "SGI OpenGL License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/SGI-OpenGL.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"SGP4":                                 {Identifier: "SGP4", Family: "SGP4", Name: "SGP4 Permission Notice", ShortName: "SGP4 Permission Notice", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/SGP4.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"SHL-0.5":                              {Identifier: "SHL-0.5", Family: "SHL", Name: "Solderpad Hardware License v0.5", ShortName: "Solderpad Hardware License v0.5", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/SHL-0.5.html", Owner: "Solderpad", OwnerURL: "http://solderpad.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"SHL-0.51":                             {Identifier: "SHL-0.51", Family: "SHL", Name: "Solderpad Hardware License, Version 0.51", ShortName: "Solderpad Hardware License, Version 0.51", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/SHL-0.51.html", Owner: "Solderpad", OwnerURL: "http://solderpad.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"SISSL":                                {Identifier: "SISSL", Family: "SISSL", Name: "Sun Industry Standards Source License v1.1", ShortName: "Sun Industry Standards Source License 1.1", Category: ProprietaryFree, Type: Proprietary, URL: "https://spdx.org/licenses/SISSL.html", Owner: "Oracle (Sun)", OwnerURL: "http://www.oracle.com/us/sun/index.html", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"SISSL-1.2":                            {Identifier: "SISSL-1.2", Family: "SISSL", Name: "Sun Industry Standards Source License v1.2", ShortName: "Sun Industry Standards Source License 1.2", Category: ProprietaryFree, Type: Proprietary, URL: "https://spdx.org/licenses/SISSL-1.2.html", Owner: "Oracle (Sun)", OwnerURL: "http://www.oracle.com/us/sun/index.html", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"SL":                                   {Identifier: "SL", Family: "SL", Name: "SL License", ShortName: "SL License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/SL.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"SMLNJ":                                {Identifier: "SMLNJ", Family: "SMLNJ", Name: "Standard ML of New Jersey License", ShortName: "Standard ML of New Jersey License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/SMLNJ.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"SMPPL":                                {Identifier: "SMPPL", Family: "SMPPL", Name: "Secure Messaging Protocol Public License", ShortName: "SMPPL", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/SMPPL.html", Owner: "National Security Agency", OwnerURL: "", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"SNIA":                                 {Identifier: "SNIA", Family: "SNIA", Name: "SNIA Public License 1.1", ShortName: "SNIA Public License 1.1", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/SNIA.html", Owner: "SNIA", OwnerURL: "http://www.snia.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"SPL-1.0":                              {Identifier: "SPL-1.0", Family: "SPL", Name: "Sun Public License v1.0", ShortName: "SPL 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/SPL-1.0.html", Owner: "Oracle (Sun)", OwnerURL: "http://www.oracle.com/us/sun/index.html", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"SSH-OpenSSH":                          {Identifier: "SSH-OpenSSH", Family: "SSH-OpenSSH", Name: "SSH OpenSSH license", ShortName: "SSH OpenSSH license", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/SSH-OpenSSH.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"SSH-short":                            {Identifier: "SSH-short", Family: "SSH-short", Name: "SSH short notice", ShortName: "SSH short notice", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/SSH-short.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"SSLeay-standalone":                    {Identifier: "SSLeay-standalone", Family: "SSLeay-standalone", Name: "SSLeay License - standalone", ShortName: "SSLeay License - standalone", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/SSLeay-standalone.html", Owner: "OpenSSL", OwnerURL: "http://www.openssl.org/about/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"SSPL-1.0":                             {Identifier: "SSPL-1.0", Family: "SSPL", Name: "Server Side Public License, v 1", ShortName: "SSPL 1.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/SSPL-1.0.html", Owner: "MongoDB", OwnerURL: "https://www.mongodb.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"SWL":                                  {Identifier: "SWL", Family: "SWL", Name: "Scheme Widget Library (SWL) Software License Agreement", ShortName: "Scheme Widget Library (SWL) Software License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/SWL.html", Owner: "Cadence Research Systems", OwnerURL: "http://www.scheme.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Saxpath":                              {Identifier: "Saxpath", Family: "Saxpath", Name: "Saxpath License", ShortName: "Saxpath License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Saxpath.html", Owner: "Codehaus", OwnerURL: "http://codehaus.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"SchemeReport":                         {Identifier: "SchemeReport", Family: "SchemeReport", Name: "Scheme Language Report License", ShortName: "Scheme Language Report License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/SchemeReport.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Sendmail":                             {Identifier: "Sendmail", Family: "Sendmail", Name: "Sendmail License", ShortName: "Sendmail License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Sendmail.html", Owner: "Sendmail", OwnerURL: "http://www.sendmail.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Sendmail-8.23":                        {Identifier: "Sendmail-8.23", Family: "Sendmail", Name: "Sendmail License 8.23", ShortName: "Sendmail License 8.23", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Sendmail-8.23.html", Owner: "Sendmail", OwnerURL: "http://www.sendmail.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"SimPL-2.0":                            {Identifier: "SimPL-2.0", Family: "SimPL", Name: "Simple Public License 2.0", ShortName: "SimPL 2.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/SimPL-2.0.html", Owner: "OSI - Open Source Initiative", OwnerURL: "http://www.opensource.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Sleepycat":                            {Identifier: "Sleepycat", Family: "Sleepycat", Name: "Sleepycat License", ShortName: "Sleepycat License", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/Sleepycat.html", Owner: "Oracle Corporation", OwnerURL: "http://www.oracle.com/index.html", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"Soundex":                              {Identifier: "Soundex", Family: "Soundex", Name: "Soundex License", ShortName: "Soundex License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Soundex.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Spencer-86":                           {Identifier: "Spencer-86", Family: "Spencer", Name: "Spencer License 86", ShortName: "Regexp License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Spencer-86.html", Owner: "Henry Spencer", OwnerURL: "http://en.wikipedia.org/wiki/Henry_Spencer", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Spencer-94":                           {Identifier: "Spencer-94", Family: "Spencer", Name: "Spencer License 94", ShortName: "Henry Spencer Regexp License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Spencer-94.html", Owner: "Henry Spencer", OwnerURL: "http://en.wikipedia.org/wiki/Henry_Spencer", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Spencer-99":                           {Identifier: "Spencer-99", Family: "Spencer", Name: "Spencer License 99", ShortName: "Henry Spencer License 1999", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Spencer-99.html", Owner: "Henry Spencer", OwnerURL: "http://en.wikipedia.org/wiki/Henry_Spencer", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"StandardML-NJ":                        {Identifier: "StandardML-NJ", Family: "StandardML-NJ", Name: "Standard ML of New Jersey License", ShortName: "Standard ML of New Jersey", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/StandardML-NJ.html", Owner: "Alcatel-Lucent", OwnerURL: "http://www.alcatel-lucent.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: true, IsSPDXListed: true},
+	"SugarCRM-1.1.3":                       {Identifier: "SugarCRM-1.1.3", Family: "SugarCRM", Name: "SugarCRM Public License v1.1.3", ShortName: "SugarCRM Public License 1.1.3", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/SugarCRM-1.1.3.html", Owner: "SugarCRM", OwnerURL: "http://www.sugarcrm.com/crm/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Sun-PPP":                              {Identifier: "Sun-PPP", Family: "Sun-PPP", Name: "Sun PPP License", ShortName: "Sun PPP License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Sun-PPP.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"SunPro":                               {Identifier: "SunPro", Family: "SunPro", Name: "SunPro License", ShortName: "SunPro License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/SunPro.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Symlinks":                             {Identifier: "Symlinks", Family: "Symlinks", Name: "Symlinks License", ShortName: "Symlinks License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Symlinks.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"TAPR-OHL-1.0":                         {Identifier: "TAPR-OHL-1.0", Family: "TAPR-OHL", Name: "TAPR Open Hardware License v1.0", ShortName: "TAPR Open Hardware License v1.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/TAPR-OHL-1.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"TCL":                                  {Identifier: "TCL", Family: "TCL", Name: "TCL/TK License", ShortName: "TCL/TK License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/TCL.html", Owner: "Tcl Developer Xchange", OwnerURL: "http://www.tcl.tk/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"TCP-wrappers":                         {Identifier: "TCP-wrappers", Family: "TCP-wrappers", Name: "TCP Wrappers License", ShortName: "TCP Wrappers License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/TCP-wrappers.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"TGPPL-1.0":                            {Identifier: "TGPPL-1.0", Family: "TGPPL", Name: "Transitive Grace Period Public Licence 1.0", ShortName: "Transitive Grace Period Public Licence 1.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/TGPPL-1.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"TMate":                                {Identifier: "TMate", Family: "TMate", Name: "TMate Open Source License", ShortName: "TMate Open Source License", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/TMate.html", Owner: "SVNKit (TMate)", OwnerURL: "http://svnkit.com/index.html", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"TORQUE-1.1":                           {Identifier: "TORQUE-1.1", Family: "TORQUE", Name: "TORQUE v2.5+ Software License v1.1", ShortName: "TORQUE 1.1", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/TORQUE-1.1.html", Owner: "Adaptive Computing Enterprises", OwnerURL: "http://www.adaptivecomputing.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"TOSL":                                 {Identifier: "TOSL", Family: "TOSL", Name: "Trusster Open Source License", ShortName: "TOSL 1.0a", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/TOSL.html", Owner: "Trusster", OwnerURL: "http://www.trusster.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"TPDL":                                 {Identifier: "TPDL", Family: "TPDL", Name: "Time::ParseDate License", ShortName: "Time::ParseDate License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/TPDL.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"TPL-1.0":                              {Identifier: "TPL-1.0", Family: "TPL", Name: "THOR Public License 1.0", ShortName: "THOR Public License 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/TPL-1.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"TTWL":                                 {Identifier: "TTWL", Family: "TTWL", Name: "Text-Tabs+Wrap License", ShortName: "Text-Tabs+Wrap License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/TTWL.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"TTYP0":                                {Identifier: "TTYP0", Family: "TTYP0", Name: "TTYP0 License", ShortName: "TTYP0 License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/TTYP0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"TU-Berlin-1.0":                        {Identifier: "TU-Berlin-1.0", Family: "TU-Berlin", Name: "Technische Universitaet Berlin License 1.0", ShortName: "Technische Universitaet Berlin License 1.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/TU-Berlin-1.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"TU-Berlin-2.0":                        {Identifier: "TU-Berlin-2.0", Family: "TU-Berlin", Name: "Technische Universitaet Berlin License 2.0", ShortName: "Technische Universitaet Berlin License 2.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/TU-Berlin-2.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"TermReadKey":                          {Identifier: "TermReadKey", Family: "TermReadKey", Name: "TermReadKey License", ShortName: "TermReadKey License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/TermReadKey.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"UCAR":                                 {Identifier: "UCAR", Family: "UCAR", Name: "UCAR License", ShortName: "UCAR License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/UCAR.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"UCL-1.0":                              {Identifier: "UCL-1.0", Family: "UCL", Name: "Upstream Compatibility License v1.0", ShortName: "Upstream Compatibility License v1.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/UCL-1.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"UMich-Merit":                          {Identifier: "UMich-Merit", Family: "UMich-Merit", Name: "Michigan/Merit Networks License", ShortName: "Michigan/Merit Networks License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/UMich-Merit.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"UPL-1.0":                              {Identifier: "UPL-1.0", Family: "UPL", Name: "Universal Permissive License v1.0", ShortName: "UPL 1.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/UPL-1.0.html", Owner: "Oracle Corporation", OwnerURL: "http://www.oracle.com/index.html", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse, PatentUse}, Obligations: []Obligation{IncludeCopyright}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"URT-RLE":                              {Identifier: "URT-RLE", Family: "URT-RLE", Name: "Utah Raster Toolkit Run Length Encoded License", ShortName: "Utah Raster Toolkit Run Length Encoded License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/URT-RLE.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Unicode-3.0":                          {Identifier: "Unicode-3.0", Family: "Unicode", Name: "Unicode License v3", ShortName: "Unicode 3.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Unicode-3.0.html", Owner: "Unicode, Inc.", OwnerURL: "http://www.unicode.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Unicode-DFS-2015":                     {Identifier: "Unicode-DFS-2015", Family: "Unicode-DFS", Name: "Unicode License Agreement - Data Files and Software (2015)", ShortName: "Unicode License Agreement - Data Files and Software (2015)", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Unicode-DFS-2015.html", Owner: "Unicode, Inc.", OwnerURL: "http://www.unicode.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Unicode-DFS-2016":                     {Identifier: "Unicode-DFS-2016", Family: "Unicode-DFS", Name: "Unicode License Agreement - Data Files and Software (2016)", ShortName: "Unicode DFS 2016", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Unicode-DFS-2016.html", Owner: "Unicode, Inc.", OwnerURL: "http://www.unicode.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Unicode-TOU":                          {Identifier: "Unicode-TOU", Family: "Unicode-TOU", Name: "Unicode Terms of Use", ShortName: "Unicode Terms of Use", Category: ProprietaryFree, Type: Proprietary, URL: "https://spdx.org/licenses/Unicode-TOU.html", Owner: "Unicode Consortium", OwnerURL: "http://unicode.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"UnixCrypt":                            {Identifier: "UnixCrypt", Family: "UnixCrypt", Name: "UnixCrypt License", ShortName: "UnixCrypt License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/UnixCrypt.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Unlicense":                            {Identifier: "Unlicense", Family: "Unlicense", Name: "The Unlicense", ShortName: "Unlicense", Category: PublicDomain, Type: OpenSource, URL: "https://spdx.org/licenses/Unlicense.html", Owner: "Unlicense", OwnerURL: "http://unlicense.org/", OwnerType: Project, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"VOSTROM":                              {Identifier: "VOSTROM", Family: "VOSTROM", Name: "VOSTROM Public License for Open Source", ShortName: "VOSTROM Public License", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/VOSTROM.html", Owner: "VOSTROM", OwnerURL: "http://vostrom.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"VSL-1.0":                              {Identifier: "VSL-1.0", Family: "VSL", Name: "Vovida Software License v1.0", ShortName: "Vovida Software License 1.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/VSL-1.0.html", Owner: "Vovida", OwnerURL: "http://www.vovida.org/Main_Page", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Vim":                                  {Identifier: "Vim", Family: "Vim", Name: "Vim License", ShortName: "VIM License", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/Vim.html", Owner: "VIM", OwnerURL: "http://www.vim.org/about.php", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"W3C":                                  {Identifier: "W3C", Family: "W3C", Name: "W3C Software Notice and License (2002-12-31)", ShortName: "W3C Software Notice and License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/W3C.html", Owner: "W3C - World Wide Web Consortium", OwnerURL: "http://www.w3.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"W3C-19980720":                         {Identifier: "W3C-19980720", Family: "W3C", Name: "W3C Software Notice and License (1998-07-20)", ShortName: "W3C-SOFTWARE-19980720", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/W3C-19980720.html", Owner: "W3C - World Wide Web Consortium", OwnerURL: "http://www.w3.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"W3C-20150513":                         {Identifier: "W3C-20150513", Family: "W3C", Name: "W3C Software Notice and Document License (2015-05-13)", ShortName: "W3C-SOFTWARE-DOC-20150513", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/W3C-20150513.html", Owner: "W3C - World Wide Web Consortium", OwnerURL: "http://www.w3.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"WTFPL":                                {Identifier: "WTFPL", Family: "WTFPL", Name: "Do What The F*ck You Want To Public License", ShortName: "WTFPL 2.0", Category: PublicDomain, Type: OpenSource, URL: "https://spdx.org/licenses/WTFPL.html", Owner: "Sam Hocevar", OwnerURL: "http://sam.zoy.org/", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}},
+	"WXwindows":                            {Identifier: "WXwindows", Family: "WXwindows", Name: "wxWindows Library Licence 3.1", ShortName: "wxWindows Library Licence 3.1", Category: CopyLeftLimited, Type: OpenSource, URL: "http://www.wxwidgets.org/about/newlicen.htm", Owner: "wxWidgets", OwnerURL: "http://www.wxwidgets.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: false},
+	"Watcom-1.0":                           {Identifier: "Watcom-1.0", Family: "Watcom", Name: "Sybase Open Watcom Public License 1.0", ShortName: "Open Watcom 1.0", Category: ProprietaryFree, Type: Proprietary, URL: "https://spdx.org/licenses/Watcom-1.0.html", Owner: "Sybase, Inc. (an SAP subsidiary)", OwnerURL: "http://www.sybase.com/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Widget-Workshop":                      {Identifier: "Widget-Workshop", Family: "Widget-Workshop", Name: "Widget Workshop License", ShortName: "Widget Workshop License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Widget-Workshop.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Wsuipa":                               {Identifier: "Wsuipa", Family: "Wsuipa", Name: "Wsuipa License", ShortName: "Wsuipa License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Wsuipa.html", Owner: "Washington State University", OwnerURL: "http://wsu.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"X11":                                  {Identifier: "X11", Family: "X11", Name: "X11 License", ShortName: "X11-Style (X Consortium)", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/X11.html", Owner: "X Consortium", OwnerURL: "http://www.x.org/wiki/XConsortium", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"X11-distribute-modifications-variant": {Identifier: "X11-distribute-modifications-variant", Family: "X11-distribute-modifications-variant", Name: "X11 License Distribution Modification Variant", ShortName: "X11 License Distribution Modification Variant", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/X11-distribute-modifications-variant.html", Owner: "X Consortium", OwnerURL: "http://www.x.org/wiki/XConsortium", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"XFree86-1.1":                          {Identifier: "XFree86-1.1", Family: "XFree86", Name: "XFree86 License 1.1", ShortName: "XFree86 License 1.1", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/XFree86-1.1.html", Owner: "XFree86 Project, Inc", OwnerURL: "http://www.xfree86.org/", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"XSkat":                                {Identifier: "XSkat", Family: "XSkat", Name: "XSkat License", ShortName: "XSkat License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/XSkat.html", Owner: "XSkat", OwnerURL: "http://www.xskat.de/xskat.html", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Xdebug-1.03":                          {Identifier: "Xdebug-1.03", Family: "Xdebug", Name: "Xdebug License v 1.03", ShortName: "Xdebug License v 1.03", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Xdebug-1.03.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Xerox":                                {Identifier: "Xerox", Family: "Xerox", Name: "Xerox License", ShortName: "MIT with Export Control", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Xerox.html", Owner: "Xerox PARC", OwnerURL: "http://www.parc.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Xfig":                                 {Identifier: "Xfig", Family: "Xfig", Name: "Xfig License", ShortName: "Xfig License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Xfig.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Xnet":                                 {Identifier: "Xnet", Family: "Xnet", Name: "X.Net License", ShortName: "Altera License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Xnet.html", Owner: "Altera Corporation", OwnerURL: "http://www.altera.com/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"YPL-1.0":                              {Identifier: "YPL-1.0", Family: "YPL", Name: "Yahoo! Public License v1.0", ShortName: "YPL 1.0", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/YPL-1.0.html", Owner: "Yahoo", OwnerURL: "http://www.yahoo.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"YPL-1.1":                              {Identifier: "YPL-1.1", Family: "YPL", Name: "Yahoo! Public License v1.1", ShortName: "YPL 1.1", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/YPL-1.1.html", Owner: "Yahoo", OwnerURL: "http://www.yahoo.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"ZPL-1.1":                              {Identifier: "ZPL-1.1", Family: "ZPL", Name: "Zope Public License 1.1", ShortName: "ZPL 1.1", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/ZPL-1.1.html", Owner: "Zope Community", OwnerURL: "http://www.zope.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"ZPL-2.0":                              {Identifier: "ZPL-2.0", Family: "ZPL", Name: "Zope Public License 2.0", ShortName: "ZPL 2.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/ZPL-2.0.html", Owner: "Zope Community", OwnerURL: "http://www.zope.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"ZPL-2.1":                              {Identifier: "ZPL-2.1", Family: "ZPL", Name: "Zope Public License 2.1", ShortName: "ZPL 2.1", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/ZPL-2.1.html", Owner: "Zope Community", OwnerURL: "http://www.zope.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"Zed":                                  {Identifier: "Zed", Family: "Zed", Name: "Zed License", ShortName: "Zed License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Zed.html", Owner: "Jim Davies", OwnerURL: "", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Zeeff":                                {Identifier: "Zeeff", Family: "Zeeff", Name: "Zeeff License", ShortName: "Zeeff License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Zeeff.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Zend-2.0":                             {Identifier: "Zend-2.0", Family: "Zend", Name: "Zend License v2.0", ShortName: "Zend Engine License 2.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Zend-2.0.html", Owner: "Zend Technologies Ltd.", OwnerURL: "http://www.zend.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"Zimbra-1.3":                           {Identifier: "Zimbra-1.3", Family: "Zimbra", Name: "Zimbra Public License v1.3", ShortName: "ZPL 1.3", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/Zimbra-1.3.html", Owner: "Zimbra", OwnerURL: "http://www.zimbra.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"Zimbra-1.4":                           {Identifier: "Zimbra-1.4", Family: "Zimbra", Name: "Zimbra Public License v1.4", ShortName: "ZPL 1.4", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/Zimbra-1.4.html", Owner: "Zimbra", OwnerURL: "http://www.zimbra.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"Zlib":                                 {Identifier: "Zlib", Family: "Zlib", Name: "zlib License", ShortName: "ZLIB License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/Zlib.html", Owner: "zlib", OwnerURL: "http://www.zlib.net/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true, Permissions: []Permission{CommercialUse, Modification, Distribution, PrivateUse}, Obligations: []Obligation{IncludeCopyrightSource, DocumentChanges}, Limitations: []Limitation{NoLiability, NoWarranty}},
+	"bcrypt-Solar-Designer":                {Identifier: "bcrypt-Solar-Designer", Family: "bcrypt-Solar-Designer", Name: "bcrypt Solar Designer License", ShortName: "bcrypt Solar Designer License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/bcrypt-Solar-Designer.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"blessing":                             {Identifier: "blessing", Family: "blessing", Name: "SQLite Blessing", ShortName: "SQLite Blessing", Category: PublicDomain, Type: OpenSource, URL: "https://spdx.org/licenses/blessing.html", Owner: "SQLite", OwnerURL: "https://www.sqlite.org/", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"bzip2-1.0.5":                          {Identifier: "bzip2-1.0.5", Family: "bzip2", Name: "bzip2 and libbzip2 License v1.0.5", ShortName: "bzip2 License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/bzip2-1.0.5.html", Owner: "bzip", OwnerURL: "http://www.bzip.org/", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: true, IsSPDXListed: true},
+	"bzip2-1.0.6":                          {Identifier: "bzip2-1.0.6", Family: "bzip2", Name: "bzip2 and libbzip2 License v1.0.6", ShortName: "bzip2 License 2010", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/bzip2-1.0.6.html", Owner: "bzip", OwnerURL: "http://www.bzip.org/", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"check-cvs":                            {Identifier: "check-cvs", Family: "check-cvs", Name: "check-cvs License", ShortName: "check-cvs License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/check-cvs.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"checkmk":                              {Identifier: "checkmk", Family: "checkmk", Name: "Checkmk License", ShortName: "Checkmk License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/checkmk.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"copyleft-next-0.3.0":                  {Identifier: "copyleft-next-0.3.0", Family: "copyleft-next", Name: "copyleft-next 0.3.0", ShortName: "copyleft-next 0.3.0", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/copyleft-next-0.3.0.html", Owner: "copyleft-next", OwnerURL: "https://github.com/copyleft-next/copyleft-next", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"copyleft-next-0.3.1":                  {Identifier: "copyleft-next-0.3.1", Family: "copyleft-next", Name: "copyleft-next 0.3.1", ShortName: "copyleft-next 0.3.1", Category: CopyLeft, Type: OpenSource, URL: "https://spdx.org/licenses/copyleft-next-0.3.1.html", Owner: "copyleft-next", OwnerURL: "https://github.com/copyleft-next/copyleft-next", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"curl":                                 {Identifier: "curl", Family: "curl", Name: "curl License", ShortName: "cURL License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/curl.html", Owner: "cURL", OwnerURL: "http://curl.haxx.se/", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"diffmark":                             {Identifier: "diffmark", Family: "diffmark", Name: "diffmark license", ShortName: "diffmark License", Category: PublicDomain, Type: OpenSource, URL: "https://spdx.org/licenses/diffmark.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"dtoa":                                 {Identifier: "dtoa", Family: "dtoa", Name: "David M. Gay dtoa License", ShortName: "David M. Gay dtoa License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/dtoa.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"dvipdfm":                              {Identifier: "dvipdfm", Family: "dvipdfm", Name: "dvipdfm License", ShortName: "dvipdfm License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/dvipdfm.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"eCos-2.0":                             {Identifier: "eCos-2.0", Family: "eCos", Name: "eCos license version 2.0", ShortName: "GPL 2.0 with eCos Exception", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/eCos-2.0.html", Owner: "eCos", OwnerURL: "http://ecos.sourceware.org/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: true, IsSPDXListed: true},
+	"eGenix":                               {Identifier: "eGenix", Family: "eGenix", Name: "eGenix.com Public License 1.1.0", ShortName: "eGenix Public License 1.1.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/eGenix.html", Owner: "eGenix", OwnerURL: "http://www.egenix.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"etalab-2.0":                           {Identifier: "etalab-2.0", Family: "etalab", Name: "Etalab Open License 2.0", ShortName: "Etalab Open License 2.0", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/etalab-2.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"fwlw":                                 {Identifier: "fwlw", Family: "fwlw", Name: "fwlw License", ShortName: "fwlw License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/fwlw.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"gSOAP-1.3b":                           {Identifier: "gSOAP-1.3b", Family: "gSOAP", Name: "gSOAP Public License v1.3b", ShortName: "gSOAP Public License v1.3b", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/gSOAP-1.3b.html", Owner: "Genivia", OwnerURL: "http://www.genivia.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"gnuplot":                              {Identifier: "gnuplot", Family: "gnuplot", Name: "gnuplot License", ShortName: "gnuplot License", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/gnuplot.html", Owner: "gnuplot Project", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"gtkbook":                              {Identifier: "gtkbook", Family: "gtkbook", Name: "gtkbook License", ShortName: "gtkbook License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/gtkbook.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"hdparm":                               {Identifier: "hdparm", Family: "hdparm", Name: "hdparm License", ShortName: "hdparm License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/hdparm.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"iMatix":                               {Identifier: "iMatix", Family: "iMatix", Name: "iMatix Standard Function Library Agreement", ShortName: "SFL License Agreement", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/iMatix.html", Owner: "iMatix", OwnerURL: "http://www.imatix.com/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"libpng-2.0":                           {Identifier: "libpng-2.0", Family: "libpng", Name: "PNG Reference Library version 2", ShortName: "PNG Reference Library version 2", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/libpng-2.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"libselinux-1.0":                       {Identifier: "libselinux-1.0", Family: "libselinux", Name: "libselinux public domain notice", ShortName: "libselinux public domain notice", Category: PublicDomain, Type: OpenSource, URL: "https://spdx.org/licenses/libselinux-1.0.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"libtiff":                              {Identifier: "libtiff", Family: "libtiff", Name: "libtiff License", ShortName: "X11-Style (Tiff)", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/libtiff.html", Owner: "SGI - Silicon Graphics", OwnerURL: "http://www.sgi.com/company_info/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"libutil-David-Nugent":                 {Identifier: "libutil-David-Nugent", Family: "libutil-David-Nugent", Name: "libutil David Nugent License", ShortName: "libutil David Nugent License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/libutil-David-Nugent.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"lsof":                                 {Identifier: "lsof", Family: "lsof", Name: "lsof License", ShortName: "lsof License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/lsof.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"magaz":                                {Identifier: "magaz", Family: "magaz", Name: "magaz License", ShortName: "magaz License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/magaz.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"mailprio":                             {Identifier: "mailprio", Family: "mailprio", Name: "mailprio License", ShortName: "mailprio License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/mailprio.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"metamail":                             {Identifier: "metamail", Family: "metamail", Name: "metamail License", ShortName: "metamail License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/metamail.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"mpi-permissive":                       {Identifier: "mpi-permissive", Family: "mpi-permissive", Name: "mpi Permissive License", ShortName: "mpi Permissive License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/mpi-permissive.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"mpich2":                               {Identifier: "mpich2", Family: "mpich2", Name: "mpich2 License", ShortName: "MPICH License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/mpich2.html", Owner: "University of Chicago", OwnerURL: "http://www.uchicago.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"mplus":                                {Identifier: "mplus", Family: "mplus", Name: "mplus Font License", ShortName: "mplus Font License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/mplus.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"pnmstitch":                            {Identifier: "pnmstitch", Family: "pnmstitch", Name: "pnmstitch License", ShortName: "pnmstitch License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/pnmstitch.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"psfrag":                               {Identifier: "psfrag", Family: "psfrag", Name: "psfrag License", ShortName: "psfrag License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/psfrag.html", Owner: "Craig Barratt", OwnerURL: "", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"psutils":                              {Identifier: "psutils", Family: "psutils", Name: "psutils License", ShortName: "PS Utilities License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/psutils.html", Owner: "Angus J. C. Duggan", OwnerURL: "", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"python-ldap":                          {Identifier: "python-ldap", Family: "python-ldap", Name: "Python ldap License", ShortName: "Python ldap License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/python-ldap.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"radvd":                                {Identifier: "radvd", Family: "radvd", Name: "radvd License", ShortName: "radvd License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/radvd.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"snprintf":                             {Identifier: "snprintf", Family: "snprintf", Name: "snprintf License", ShortName: "snprintf License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/snprintf.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"softSurfer":                           {Identifier: "softSurfer", Family: "softSurfer", Name: "softSurfer License", ShortName: "softSurfer License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/softSurfer.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"ssh-keyscan":                          {Identifier: "ssh-keyscan", Family: "ssh-keyscan", Name: "ssh-keyscan License", ShortName: "ssh-keyscan License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/ssh-keyscan.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"swrule":                               {Identifier: "swrule", Family: "swrule", Name: "swrule License", ShortName: "swrule License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/swrule.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"ulem":                                 {Identifier: "ulem", Family: "ulem", Name: "ulem License", ShortName: "ulem License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/ulem.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"w3m":                                  {Identifier: "w3m", Family: "w3m", Name: "w3m License", ShortName: "w3m License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/w3m.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"wxWindows":                            {Identifier: "wxWindows", Family: "wxWindows", Name: "wxWindows Library License", ShortName: "wxWindows Library Licence 3.1", Category: CopyLeftLimited, Type: OpenSource, URL: "https://spdx.org/licenses/wxWindows.html", Owner: "wxWidgets", OwnerURL: "http://www.wxwidgets.org/", OwnerType: Organization, IsOSIApproved: true, IsFSFLibre: false, IsDeprecated: true, IsSPDXListed: true},
+	"xinetd":                               {Identifier: "xinetd", Family: "xinetd", Name: "xinetd License", ShortName: "xinetd License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/xinetd.html", Owner: "Xinetd", OwnerURL: "http://www.xinetd.org/", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: true, IsDeprecated: false, IsSPDXListed: true},
+	"xkeyboard-config-Zinoviev":            {Identifier: "xkeyboard-config-Zinoviev", Family: "xkeyboard-config-Zinoviev", Name: "xkeyboard-config Zinoviev License", ShortName: "xkeyboard-config Zinoviev License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/xkeyboard-config-Zinoviev.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"xlock":                                {Identifier: "xlock", Family: "xlock", Name: "xlock License", ShortName: "xlock License", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/xlock.html", Owner: "Unspecified", OwnerURL: "", OwnerType: Project, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"xpp":                                  {Identifier: "xpp", Family: "xpp", Name: "XPP License", ShortName: "Indiana Extreme License 1.2", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/xpp.html", Owner: "Indiana University", OwnerURL: "http://www.extreme.indiana.edu/", OwnerType: Organization, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+	"zlib-acknowledgement":                 {Identifier: "zlib-acknowledgement", Family: "zlib-acknowledgement", Name: "zlib/libpng License with Acknowledgement", ShortName: "ZLIB License with Acknowledgment", Category: Permissive, Type: OpenSource, URL: "https://spdx.org/licenses/zlib-acknowledgement.html", Owner: "Charlie Poole", OwnerURL: "", OwnerType: Person, IsOSIApproved: false, IsFSFLibre: false, IsDeprecated: false, IsSPDXListed: true},
+}
+
+var exceptionLookup = map[string]LicenseException{
+	"389-exception":                     {Identifier: "389-exception", Name: "389 Directory Server Exception", URL: "https://spdx.org/licenses/389-exception.html", IsDeprecated: false},
+	"Asterisk-exception":                {Identifier: "Asterisk-exception", Name: "Asterisk exception", URL: "https://spdx.org/licenses/Asterisk-exception.html", IsDeprecated: false},
+	"Autoconf-exception-2.0":            {Identifier: "Autoconf-exception-2.0", Name: "Autoconf exception 2.0", URL: "https://spdx.org/licenses/Autoconf-exception-2.0.html", IsDeprecated: false},
+	"Autoconf-exception-3.0":            {Identifier: "Autoconf-exception-3.0", Name: "Autoconf exception 3.0", URL: "https://spdx.org/licenses/Autoconf-exception-3.0.html", IsDeprecated: false},
+	"Autoconf-exception-generic":        {Identifier: "Autoconf-exception-generic", Name: "Autoconf generic exception", URL: "https://spdx.org/licenses/Autoconf-exception-generic.html", IsDeprecated: false},
+	"Autoconf-exception-generic-3.0":    {Identifier: "Autoconf-exception-generic-3.0", Name: "Autoconf generic exception for GPL-3.0", URL: "https://spdx.org/licenses/Autoconf-exception-generic-3.0.html", IsDeprecated: false},
+	"Autoconf-exception-macro":          {Identifier: "Autoconf-exception-macro", Name: "Autoconf macro exception", URL: "https://spdx.org/licenses/Autoconf-exception-macro.html", IsDeprecated: false},
+	"Bison-exception-1.24":              {Identifier: "Bison-exception-1.24", Name: "Bison exception 1.24", URL: "https://spdx.org/licenses/Bison-exception-1.24.html", IsDeprecated: false},
+	"Bison-exception-2.2":               {Identifier: "Bison-exception-2.2", Name: "Bison exception 2.2", URL: "https://spdx.org/licenses/Bison-exception-2.2.html", IsDeprecated: false},
+	"Bootloader-exception":              {Identifier: "Bootloader-exception", Name: "Bootloader Distribution Exception", URL: "https://spdx.org/licenses/Bootloader-exception.html", IsDeprecated: false},
+	"CLISP-exception-2.0":               {Identifier: "CLISP-exception-2.0", Name: "CLISP exception 2.0", URL: "https://spdx.org/licenses/CLISP-exception-2.0.html", IsDeprecated: false},
+	"Classpath-exception-2.0":           {Identifier: "Classpath-exception-2.0", Name: "Classpath exception 2.0", URL: "https://spdx.org/licenses/Classpath-exception-2.0.html", IsDeprecated: false},
+	"DigiRule-FOSS-exception":           {Identifier: "DigiRule-FOSS-exception", Name: "DigiRule FOSS License Exception", URL: "https://spdx.org/licenses/DigiRule-FOSS-exception.html", IsDeprecated: false},
+	"FLTK-exception":                    {Identifier: "FLTK-exception", Name: "FLTK exception", URL: "https://spdx.org/licenses/FLTK-exception.html", IsDeprecated: false},
+	"Fawkes-Runtime-exception":          {Identifier: "Fawkes-Runtime-exception", Name: "Fawkes Runtime Exception", URL: "https://spdx.org/licenses/Fawkes-Runtime-exception.html", IsDeprecated: false},
+	"Font-exception-2.0":                {Identifier: "Font-exception-2.0", Name: "Font exception 2.0", URL: "https://spdx.org/licenses/Font-exception-2.0.html", IsDeprecated: false},
+	"GCC-exception-2.0":                 {Identifier: "GCC-exception-2.0", Name: "GCC Runtime Library exception 2.0", URL: "https://spdx.org/licenses/GCC-exception-2.0.html", IsDeprecated: false},
+	"GCC-exception-2.0-note":            {Identifier: "GCC-exception-2.0-note", Name: "GCC Runtime Library exception 2.0 - note variant", URL: "https://spdx.org/licenses/GCC-exception-2.0-note.html", IsDeprecated: false},
+	"GCC-exception-3.1":                 {Identifier: "GCC-exception-3.1", Name: "GCC Runtime Library exception 3.1", URL: "https://spdx.org/licenses/GCC-exception-3.1.html", IsDeprecated: false},
+	"GNAT-exception":                    {Identifier: "GNAT-exception", Name: "GNAT exception", URL: "https://spdx.org/licenses/GNAT-exception.html", IsDeprecated: false},
+	"GNOME-examples-exception":          {Identifier: "GNOME-examples-exception", Name: "GNOME examples exception", URL: "https://spdx.org/licenses/GNOME-examples-exception.html", IsDeprecated: false},
+	"GNU-compiler-exception":            {Identifier: "GNU-compiler-exception", Name: "GNU Compiler Exception", URL: "https://spdx.org/licenses/GNU-compiler-exception.html", IsDeprecated: false},
+	"GPL-3.0-interface-exception":       {Identifier: "GPL-3.0-interface-exception", Name: "GPL-3.0 Interface Exception", URL: "https://spdx.org/licenses/GPL-3.0-interface-exception.html", IsDeprecated: false},
+	"GPL-3.0-linking-exception":         {Identifier: "GPL-3.0-linking-exception", Name: "GPL-3.0 Linking Exception", URL: "https://spdx.org/licenses/GPL-3.0-linking-exception.html", IsDeprecated: false},
+	"GPL-3.0-linking-source-exception":  {Identifier: "GPL-3.0-linking-source-exception", Name: "GPL-3.0 Linking Exception (with Corresponding Source)", URL: "https://spdx.org/licenses/GPL-3.0-linking-source-exception.html", IsDeprecated: false},
+	"GPL-CC-1.0":                        {Identifier: "GPL-CC-1.0", Name: "GPL Cooperation Commitment 1.0", URL: "https://spdx.org/licenses/GPL-CC-1.0.html", IsDeprecated: false},
+	"GStreamer-exception-2005":          {Identifier: "GStreamer-exception-2005", Name: "GStreamer Exception (2005)", URL: "https://spdx.org/licenses/GStreamer-exception-2005.html", IsDeprecated: false},
+	"GStreamer-exception-2008":          {Identifier: "GStreamer-exception-2008", Name: "GStreamer Exception (2008)", URL: "https://spdx.org/licenses/GStreamer-exception-2008.html", IsDeprecated: false},
+	"Gmsh-exception":                    {Identifier: "Gmsh-exception", Name: "Gmsh exception", URL: "https://spdx.org/licenses/Gmsh-exception.html", IsDeprecated: false},
+	"KiCad-libraries-exception":         {Identifier: "KiCad-libraries-exception", Name: "KiCad Libraries Exception", URL: "https://spdx.org/licenses/KiCad-libraries-exception.html", IsDeprecated: false},
+	"LGPL-3.0-linking-exception":        {Identifier: "LGPL-3.0-linking-exception", Name: "LGPL-3.0 Linking Exception", URL: "https://spdx.org/licenses/LGPL-3.0-linking-exception.html", IsDeprecated: false},
+	"LLGPL":                             {Identifier: "LLGPL", Name: "LLGPL Preamble", URL: "https://spdx.org/licenses/LLGPL.html", IsDeprecated: false},
+	"LLVM-exception":                    {Identifier: "LLVM-exception", Name: "LLVM Exception", URL: "https://spdx.org/licenses/LLVM-exception.html", IsDeprecated: false},
+	"LZMA-exception":                    {Identifier: "LZMA-exception", Name: "LZMA exception", URL: "https://spdx.org/licenses/LZMA-exception.html", IsDeprecated: false},
+	"Libtool-exception":                 {Identifier: "Libtool-exception", Name: "Libtool Exception", URL: "https://spdx.org/licenses/Libtool-exception.html", IsDeprecated: false},
+	"Linux-syscall-note":                {Identifier: "Linux-syscall-note", Name: "Linux Syscall Note", URL: "https://spdx.org/licenses/Linux-syscall-note.html", IsDeprecated: false},
+	"Nokia-Qt-exception-1.1":            {Identifier: "Nokia-Qt-exception-1.1", Name: "Nokia Qt LGPL exception 1.1", URL: "https://spdx.org/licenses/Nokia-Qt-exception-1.1.html", IsDeprecated: true},
+	"OCCT-exception-1.0":                {Identifier: "OCCT-exception-1.0", Name: "Open CASCADE Exception 1.0", URL: "https://spdx.org/licenses/OCCT-exception-1.0.html", IsDeprecated: false},
+	"OCaml-LGPL-linking-exception":      {Identifier: "OCaml-LGPL-linking-exception", Name: "OCaml LGPL Linking Exception", URL: "https://spdx.org/licenses/OCaml-LGPL-linking-exception.html", IsDeprecated: false},
+	"OpenJDK-assembly-exception-1.0":    {Identifier: "OpenJDK-assembly-exception-1.0", Name: "OpenJDK Assembly exception 1.0", URL: "https://spdx.org/licenses/OpenJDK-assembly-exception-1.0.html", IsDeprecated: false},
+	"PS-or-PDF-font-exception-20170817": {Identifier: "PS-or-PDF-font-exception-20170817", Name: "PS/PDF font exception (2017-08-17)", URL: "https://spdx.org/licenses/PS-or-PDF-font-exception-20170817.html", IsDeprecated: false},
+	"QPL-1.0-INRIA-2004-exception":      {Identifier: "QPL-1.0-INRIA-2004-exception", Name: "INRIA QPL 1.0 2004 variant exception", URL: "https://spdx.org/licenses/QPL-1.0-INRIA-2004-exception.html", IsDeprecated: false},
+	"Qt-GPL-exception-1.0":              {Identifier: "Qt-GPL-exception-1.0", Name: "Qt GPL exception 1.0", URL: "https://spdx.org/licenses/Qt-GPL-exception-1.0.html", IsDeprecated: false},
+	"Qt-LGPL-exception-1.1":             {Identifier: "Qt-LGPL-exception-1.1", Name: "Qt LGPL exception 1.1", URL: "https://spdx.org/licenses/Qt-LGPL-exception-1.1.html", IsDeprecated: false},
+	"Qwt-exception-1.0":                 {Identifier: "Qwt-exception-1.0", Name: "Qwt exception 1.0", URL: "https://spdx.org/licenses/Qwt-exception-1.0.html", IsDeprecated: false},
+	"SANE-exception":                    {Identifier: "SANE-exception", Name: "SANE Exception", URL: "https://spdx.org/licenses/SANE-exception.html", IsDeprecated: false},
+	"SHL-2.0":                           {Identifier: "SHL-2.0", Name: "Solderpad Hardware License v2.0", URL: "https://spdx.org/licenses/SHL-2.0.html", IsDeprecated: false},
+	"SHL-2.1":                           {Identifier: "SHL-2.1", Name: "Solderpad Hardware License v2.1", URL: "https://spdx.org/licenses/SHL-2.1.html", IsDeprecated: false},
+	"SWI-exception":                     {Identifier: "SWI-exception", Name: "SWI exception", URL: "https://spdx.org/licenses/SWI-exception.html", IsDeprecated: false},
+	"Swift-exception":                   {Identifier: "Swift-exception", Name: "Swift Exception", URL: "https://spdx.org/licenses/Swift-exception.html", IsDeprecated: false},
+	"Texinfo-exception":                 {Identifier: "Texinfo-exception", Name: "Texinfo exception", URL: "https://spdx.org/licenses/Texinfo-exception.html", IsDeprecated: false},
+	"UBDL-exception":                    {Identifier: "UBDL-exception", Name: "Unmodified Binary Distribution exception", URL: "https://spdx.org/licenses/UBDL-exception.html", IsDeprecated: false},
+	"Universal-FOSS-exception-1.0":      {Identifier: "Universal-FOSS-exception-1.0", Name: "Universal FOSS Exception, Version 1.0", URL: "https://spdx.org/licenses/Universal-FOSS-exception-1.0.html", IsDeprecated: false},
+	"WxWindows-exception-3.1":           {Identifier: "WxWindows-exception-3.1", Name: "WxWindows Library Exception 3.1", URL: "https://spdx.org/licenses/WxWindows-exception-3.1.html", IsDeprecated: false},
+	"cryptsetup-OpenSSL-exception":      {Identifier: "cryptsetup-OpenSSL-exception", Name: "cryptsetup OpenSSL exception", URL: "https://spdx.org/licenses/cryptsetup-OpenSSL-exception.html", IsDeprecated: false},
+	"eCos-exception-2.0":                {Identifier: "eCos-exception-2.0", Name: "eCos exception 2.0", URL: "https://spdx.org/licenses/eCos-exception-2.0.html", IsDeprecated: false},
+	"fmt-exception":                     {Identifier: "fmt-exception", Name: "fmt exception", URL: "https://spdx.org/licenses/fmt-exception.html", IsDeprecated: false},
+	"freertos-exception-2.0":            {Identifier: "freertos-exception-2.0", Name: "FreeRTOS Exception 2.0", URL: "https://spdx.org/licenses/freertos-exception-2.0.html", IsDeprecated: false},
+	"gnu-javamail-exception":            {Identifier: "gnu-javamail-exception", Name: "GNU JavaMail exception", URL: "https://spdx.org/licenses/gnu-javamail-exception.html", IsDeprecated: false},
+	"i2p-gpl-java-exception":            {Identifier: "i2p-gpl-java-exception", Name: "i2p GPL+Java Exception", URL: "https://spdx.org/licenses/i2p-gpl-java-exception.html", IsDeprecated: false},
+	"libpri-OpenH323-exception":         {Identifier: "libpri-OpenH323-exception", Name: "libpri OpenH323 exception", URL: "https://spdx.org/licenses/libpri-OpenH323-exception.html", IsDeprecated: false},
+	"mif-exception":                     {Identifier: "mif-exception", Name: "Macros and Inline Functions Exception", URL: "https://spdx.org/licenses/mif-exception.html", IsDeprecated: false},
+	"openvpn-openssl-exception":         {Identifier: "openvpn-openssl-exception", Name: "OpenVPN OpenSSL Exception", URL: "https://spdx.org/licenses/openvpn-openssl-exception.html", IsDeprecated: false},
+	"stunnel-exception":                 {Identifier: "stunnel-exception", Name: "stunnel Exception", URL: "https://spdx.org/licenses/stunnel-exception.html", IsDeprecated: false},
+	"u-boot-exception-2.0":              {Identifier: "u-boot-exception-2.0", Name: "U-Boot exception 2.0", URL: "https://spdx.org/licenses/u-boot-exception-2.0.html", IsDeprecated: false},
+	"vsftpd-openssl-exception":          {Identifier: "vsftpd-openssl-exception", Name: "vsftpd OpenSSL exception", URL: "https://spdx.org/licenses/vsftpd-openssl-exception.html", IsDeprecated: false},
+	"x11vnc-openssl-exception":          {Identifier: "x11vnc-openssl-exception", Name: "x11vnc OpenSSL Exception", URL: "https://spdx.org/licenses/x11vnc-openssl-exception.html", IsDeprecated: false},
+}
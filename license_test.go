@@ -19,12 +19,15 @@ func testForPropFreeLicenses(t *testing.T, identifierMap map[string]bool) {
 	if _, ok := identifierMap["Unicode-TOU"]; !ok {
 		t.Errorf("expected Unicode-TOU, got %v", identifierMap)
 	}
+	if _, ok := identifierMap["IEC-Code-Components-EULA"]; !ok {
+		t.Errorf("expected IEC-Code-Components-EULA, got %v", identifierMap)
+	}
 }
 
 func TestReplaceCategoriesWithIdentifiers(t *testing.T) {
 	out := diligent.ReplaceCategoriesWithIdentifiers([]string{"MIT", "proprietary-free"})
-	if len(out) != 5 {
-		t.Errorf("expected five identifiers, got %v", len(out))
+	if len(out) != 6 {
+		t.Errorf("expected six identifiers, got %v", len(out))
 	}
 	m := map[string]bool{}
 	for _, o := range out {
@@ -38,8 +41,8 @@ func TestReplaceCategoriesWithIdentifiers(t *testing.T) {
 
 func TestGetCategoryLicenses(t *testing.T) {
 	out := diligent.GetCategoryLicenses(diligent.ProprietaryFree)
-	if len(out) != 4 {
-		t.Errorf("expected four identifiers, got %v", len(out))
+	if len(out) != 5 {
+		t.Errorf("expected five identifiers, got %v", len(out))
 	}
 	m := map[string]bool{}
 	for _, o := range out {
@@ -60,6 +63,14 @@ func TestGetLicenses(t *testing.T) {
 	}
 }
 
+func TestGetLicensesCategorised(t *testing.T) {
+	for _, l := range diligent.GetLicenses() {
+		if l.Category == "" || l.Type == "" || l.Owner == "" {
+			t.Errorf("expected %s to have a category, type and owner, got %+v", l.Identifier, l)
+		}
+	}
+}
+
 func TestGetLicenseFromIdentifier(t *testing.T) {
 	cases := []struct {
 		d             string
@@ -86,3 +97,42 @@ func TestGetLicenseFromIdentifier(t *testing.T) {
 		})
 	}
 }
+
+func TestGetLicenseFromIdentifierSPDXFields(t *testing.T) {
+	cases := []struct {
+		in            string
+		isOSIApproved bool
+		isFSFLibre    bool
+		isDeprecated  bool
+	}{
+		{"MIT", true, true, false},
+		{"GPL-2.0", true, true, true},
+		{"GPL-2.0-only", true, true, false},
+		{"JSON", false, false, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			out, err := diligent.GetLicenseFromIdentifier(c.in)
+			if err != nil {
+				t.Fatalf("did not expect an error, got %v", err)
+			}
+			if out.IsOSIApproved != c.isOSIApproved || out.IsFSFLibre != c.isFSFLibre || out.IsDeprecated != c.isDeprecated {
+				t.Errorf("unexpected SPDX fields, got %+v", out)
+			}
+		})
+	}
+}
+
+func TestGetLicenseExceptionFromIdentifier(t *testing.T) {
+	out, err := diligent.GetLicenseExceptionFromIdentifier("Classpath-exception-2.0")
+	if err != nil {
+		t.Errorf("did not expect an error, got %v", err)
+	}
+	if out.Name != "Classpath exception 2.0" {
+		t.Errorf("expected Classpath exception 2.0, got %+v", out)
+	}
+	if _, err := diligent.GetLicenseExceptionFromIdentifier("woowoo"); err == nil {
+		t.Error("expected an error")
+	}
+}
@@ -0,0 +1,196 @@
+// Package markdown outputs diligent reports as Markdown suitable for posting as a pull request comment.
+//
+// The output summarises the dependencies by license and category, lists any violations, exceptions and warnings,
+// followed by the obligations the dependencies trigger under the distribution model, if one was provided, and holds
+// the full list of dependencies within a collapsible section. Comments are limited in length by most code hosting
+// services, so the output is capped at a maximum length. Sections are filled in order of importance, the summary first
+// and the full list of dependencies last, and a section which does not fit is cut short with a note of how many
+// entries were left out.
+package markdown
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/senseyeio/diligent"
+)
+
+// DefaultMaxLength is the default maximum length of the output in bytes, leaving headroom below the 65536 character
+// limit GitHub places on comments
+const DefaultMaxLength = 60000
+
+// Config allows the behaviour of the Reporter to be customised
+type Config struct {
+	// MaxLength is the maximum length of the output in bytes. DefaultMaxLength is used when zero.
+	MaxLength int
+}
+
+type markdown struct {
+	config Config
+}
+
+// section is a heading, followed by a list of entries and a footer
+type section struct {
+	header  string
+	entries []string
+	footer  string
+	// omitted describes the number of entries left out when the section is cut short
+	omitted string
+}
+
+// NewReporter returns a Reporter which outputs the report as Markdown using the default configuration
+func NewReporter() diligent.Reporter {
+	return NewReporterWithOptions(Config{})
+}
+
+// NewReporterWithOptions returns a Reporter which outputs the report as Markdown using the provided configuration
+func NewReporterWithOptions(c Config) diligent.Reporter {
+	if c.MaxLength <= 0 {
+		c.MaxLength = DefaultMaxLength
+	}
+	return &markdown{c}
+}
+
+// Report outputs the report as Markdown no longer than the configured maximum length
+func (m *markdown) Report(w io.Writer, r diligent.Report) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## Dependency licenses\n\n%d dependencies, %d violations, %d exceptions, %d warnings\n",
+		len(r.Deps), len(r.Violations), len(r.Exceptions), len(r.Warnings))
+	for _, s := range sections(r) {
+		b.WriteString(s.fit(m.config.MaxLength - b.Len()))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// fit renders as many entries of the section as fit within budget, or nothing if not even the header fits
+func (s section) fit(budget int) string {
+	if len(s.entries) == 0 {
+		return ""
+	}
+	reserved := len(s.header) + len(s.footer) + len(fmt.Sprintf(s.omitted, len(s.entries)))
+	if reserved > budget {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString(s.header)
+	n := 0
+	for _, e := range s.entries {
+		if b.Len()+len(e)+reserved-len(s.header) > budget {
+			break
+		}
+		b.WriteString(e)
+		n++
+	}
+	if n < len(s.entries) {
+		fmt.Fprintf(&b, s.omitted, len(s.entries)-n)
+	}
+	b.WriteString(s.footer)
+	return b.String()
+}
+
+// sections returns the sections following the title of the output, in order of importance
+func sections(r diligent.Report) []section {
+	out := []section{{
+		header:  "\n| License | Category | Dependencies |\n| --- | --- | --- |\n",
+		entries: summaryRows(r.Deps),
+		omitted: "| _%d more licenses not shown_ | | |\n",
+	}}
+
+	violations := make([]string, len(r.Violations))
+	for i, v := range r.Violations {
+		violations[i] = fmt.Sprintf("- **%s** %s (%s): %s\n", v.Dep.Name, v.Dep.Version, v.Dep.LicenseExpression(), v.Message)
+	}
+	out = append(out, section{header: "\n### Violations\n\n", entries: violations, omitted: "- _%d more violations not shown_\n"})
+
+	exceptions := make([]string, len(r.Exceptions))
+	for i, e := range r.Exceptions {
+		exceptions[i] = fmt.Sprintf("- **%s** (%s) permitted until %s, approved by %s: %s", e.Package, e.License, e.Expires, e.Approver, e.Ticket)
+		if !e.Applied {
+			exceptions[i] += " _(not used)_"
+		}
+		exceptions[i] += "\n"
+	}
+	out = append(out, section{header: "\n### Exceptions\n\n", entries: exceptions, omitted: "- _%d more exceptions not shown_\n"})
+
+	warnings := make([]string, len(r.Warnings))
+	for i, wa := range r.Warnings {
+		warnings[i] = fmt.Sprintf("- **%s** (%s) [%s]: %s\n", wa.Dependency(), wa.Ecosystem(), wa.Reason(), wa.Err())
+	}
+	out = append(out, section{header: "\n### Warnings\n\n", entries: warnings, omitted: "- _%d more warnings not shown_\n"})
+
+	obligations := make([]string, 0)
+	for _, d := range r.Deps {
+		for _, o := range d.DistributionObligations {
+			obligations = append(obligations, fmt.Sprintf("- **%s** %s (%s): %s\n", d.Name, d.Version, o.License, o.Description))
+		}
+	}
+	out = append(out, section{
+		header:  fmt.Sprintf("\n### Obligations when distributed as %s\n\n", r.Distribution),
+		entries: obligations,
+		omitted: "- _%d more obligations not shown_\n",
+	})
+
+	deps := make([]string, len(r.Deps))
+	for i, d := range r.Deps {
+		deps[i] = fmt.Sprintf("| %s | %s | %s | %s | %s |\n",
+			cell(d.Name), cell(d.Version), cell(d.LicenseExpression()), cell(d.Ecosystem), cell(string(d.Relationship)))
+	}
+	out = append(out, section{
+		header: fmt.Sprintf("\n<details>\n<summary>All dependencies (%d)</summary>\n\n", len(r.Deps)) +
+			"| Name | Version | License | Ecosystem | Relationship |\n| --- | --- | --- | --- | --- |\n",
+		entries: deps,
+		footer:  "\n</details>\n",
+		omitted: "| _%d more dependencies not shown_ | | | | |\n",
+	})
+	return out
+}
+
+// summaryRows counts the dependencies using each license, most used first
+func summaryRows(deps []diligent.Dep) []string {
+	counts := map[string]int{}
+	categories := map[string]string{}
+	for _, d := range deps {
+		l := d.LicenseExpression()
+		counts[l]++
+		categories[l] = category(d)
+	}
+	licenses := make([]string, 0, len(counts))
+	for l := range counts {
+		licenses = append(licenses, l)
+	}
+	sort.Slice(licenses, func(i, j int) bool {
+		if counts[licenses[i]] == counts[licenses[j]] {
+			return licenses[i] < licenses[j]
+		}
+		return counts[licenses[i]] > counts[licenses[j]]
+	})
+	rows := make([]string, len(licenses))
+	for i, l := range licenses {
+		rows[i] = fmt.Sprintf("| %s | %s | %d |\n", cell(l), cell(categories[l]), counts[l])
+	}
+	return rows
+}
+
+// category returns the distinct categories of the licenses of the dependency
+func category(d diligent.Dep) string {
+	seen := map[string]bool{}
+	out := make([]string, 0)
+	for _, l := range d.Licenses() {
+		c := string(l.Category)
+		if c != "" && !seen[c] {
+			seen[c] = true
+			out = append(out, c)
+		}
+	}
+	sort.Strings(out)
+	return strings.Join(out, ", ")
+}
+
+// cell escapes a value for use within a table cell
+func cell(s string) string {
+	s = strings.Replace(s, "|", `\|`, -1)
+	return strings.Replace(s, "\n", " ", -1)
+}
@@ -0,0 +1,99 @@
+package markdown_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/internal/reporttest"
+	"github.com/senseyeio/diligent/markdown"
+)
+
+// testReport returns the shared report with n further MIT licensed dependencies
+func testReport(t *testing.T, n int) diligent.Report {
+	r := reporttest.Report(t)
+	for i := 0; i < n; i++ {
+		d, err := diligent.NewDep(fmt.Sprintf("dep-%03d", i), "MIT")
+		if err != nil {
+			t.Fatal(err)
+		}
+		d.Version = "1.0.0"
+		d.Ecosystem = "npm"
+		d.Relationship = diligent.Direct
+		r.Deps = append(r.Deps, d)
+	}
+	return r
+}
+
+func TestReport(t *testing.T) {
+	r := testReport(t, 2)
+	r.Exceptions = append(r.Exceptions, diligent.Exception{Package: "left-pad", License: "WTFPL", Approver: "legal@example.com", Ticket: "LEGAL-43", Expires: "2018-07-31"})
+	r.Distribution = "saas"
+	r.Deps[2].DistributionObligations = []diligent.LicenseObligation{{License: "GPL-3.0", Description: "none while the software is only provided over a network"}}
+	var b bytes.Buffer
+	if err := markdown.NewReporter().Report(&b, r); err != nil {
+		t.Fatal(err)
+	}
+	out := b.String()
+
+	cases := []struct {
+		d        string
+		expected string
+	}{
+		{"counts", "7 dependencies, 1 violations, 2 exceptions, 1 warnings"},
+		{"summary by license", "| MIT | permissive | 4 |\n| BSD-2-Clause OR MIT | permissive | 1 |\n| GPL-3.0 | copyleft | 1 |\n| MIT OR GPL-3.0 | copyleft, permissive | 1 |\n"},
+		{"violations", "### Violations\n\n- **<cypress>** 2.1.0 (MIT OR GPL-3.0): not permitted\n"},
+		{"exceptions", "### Exceptions\n\n- **readline** (GPL-3.0) permitted until 2018-06-30, approved by legal@example.com: LEGAL-42\n"},
+		{"unused exceptions", "- **left-pad** (WTFPL) permitted until 2018-07-31, approved by legal@example.com: LEGAL-43 _(not used)_\n"},
+		{"warnings", "### Warnings\n\n- **left-pad** (npm) [not-found]: requested failed with status 404\n"},
+		{"obligations", "### Obligations when distributed as saas\n\n- **readline** 1.3.0 (GPL-3.0): none while the software is only provided over a network\n"},
+		{"collapsible list", "<details>\n<summary>All dependencies (7)</summary>\n\n"},
+		{"dependency row", "| dep-000 | 1.0.0 | MIT | npm | direct |\n"},
+	}
+	for _, tt := range cases {
+		t.Run(tt.d, func(t *testing.T) {
+			if !strings.Contains(out, tt.expected) {
+				t.Errorf("expected output to contain %q, got %s", tt.expected, out)
+			}
+		})
+	}
+	if strings.Contains(out, "not shown") {
+		t.Errorf("did not expect the output to be truncated, got %s", out)
+	}
+}
+
+func TestReportTruncates(t *testing.T) {
+	cases := []struct {
+		d         string
+		maxLength int
+		expected  []string
+		absent    []string
+	}{
+		{"dependency list is cut short first", 1000, []string{"- **<cypress>** 2.1.0", "- **left-pad**", "more dependencies not shown_", "</details>"}, nil},
+		{"list is dropped when it does not fit", 650, []string{"- **<cypress>** 2.1.0", "- **left-pad**"}, []string{"<details>"}},
+	}
+	for _, tt := range cases {
+		t.Run(tt.d, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := markdown.NewReporterWithOptions(markdown.Config{MaxLength: tt.maxLength}).Report(&b, testReport(t, 500)); err != nil {
+				t.Fatal(err)
+			}
+			out := b.String()
+			if len(out) > tt.maxLength {
+				t.Errorf("expected at most %d bytes, got %d", tt.maxLength, len(out))
+			}
+			for _, e := range tt.expected {
+				if !strings.Contains(out, e) {
+					t.Errorf("expected output to contain %q, got %s", e, out)
+				}
+			}
+			for _, a := range tt.absent {
+				if strings.Contains(out, a) {
+					t.Errorf("did not expect output to contain %q, got %s", a, out)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+// Package notice generates third party notices files, which reproduce the license texts and copyright notices of
+// dependencies as many licenses, including permissive ones, require.
+//
+// License texts are taken from the license files found whilst determining the licenses of dependencies. Where no
+// license file was found, as is the case for licenses declared in package metadata, the canonical text of the license
+// is used instead. Dependencies distributed under identical license texts, ignoring copyright notices, are grouped so
+// each text is reproduced once.
+package notice
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/classifier"
+)
+
+var noticeFileRegexp = regexp.MustCompile(`(?i)^notice`)
+
+// Package is a dependency distributed under a license text
+type Package struct {
+	Name    string
+	Version string
+	// Copyrights holds the copyright notices found within the license texts of the package
+	Copyrights []string
+	// Notices holds the content of any NOTICE files distributed with the package
+	Notices []string
+}
+
+// Group is a license text along with the packages distributed under it
+type Group struct {
+	// Licenses holds the license expression of each package within the group
+	Licenses []string
+	// Text is the license text without copyright notices
+	Text string
+	// Canonical is true if Text is the canonical text of the license rather than a text distributed with the packages
+	Canonical bool
+	Packages  []Package
+}
+
+// Gather groups the dependencies by their license texts
+func Gather(deps []diligent.Dep) []Group {
+	groups := map[string]*Group{}
+	for _, d := range deps {
+		texts, canonical := licenseTexts(d)
+		p := Package{
+			Name:       d.Name,
+			Version:    d.Version,
+			Copyrights: make([]string, 0),
+			Notices:    noticeTexts(d),
+		}
+		bodies := make([]string, len(texts))
+		for i, t := range texts {
+			p.Copyrights = appendDistinct(p.Copyrights, classifier.CopyrightLines(t)...)
+			bodies[i] = classifier.RemoveCopyrightLines(t)
+		}
+		text := strings.Join(bodies, "\n")
+		g, ok := groups[text]
+		if !ok {
+			g = &Group{Text: text, Canonical: canonical, Licenses: make([]string, 0)}
+			groups[text] = g
+		}
+		g.Licenses = appendDistinct(g.Licenses, d.LicenseExpression())
+		g.Packages = append(g.Packages, p)
+	}
+
+	out := make([]Group, 0, len(groups))
+	for _, g := range groups {
+		sort.Slice(g.Packages, func(i, j int) bool {
+			if g.Packages[i].Name == g.Packages[j].Name {
+				return g.Packages[i].Version < g.Packages[j].Version
+			}
+			return g.Packages[i].Name < g.Packages[j].Name
+		})
+		sort.Strings(g.Licenses)
+		out = append(out, *g)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		a, b := strings.Join(out[i].Licenses, ", "), strings.Join(out[j].Licenses, ", ")
+		if a == b {
+			return out[i].Text < out[j].Text
+		}
+		return a < b
+	})
+	return out
+}
+
+// licenseTexts returns the license texts distributed with the dependency or, if there are none, the canonical texts
+// of its licenses, in which case canonical is true. Only the texts of license files, whether found locally or through
+// a repository API, are distributed.
+func licenseTexts(d diligent.Dep) (texts []string, canonical bool) {
+	for _, p := range d.Provenance {
+		if p.Source != diligent.LicenseFile && p.Source != diligent.RepositoryAPI {
+			continue
+		}
+		if strings.TrimSpace(p.Text) != "" {
+			texts = appendDistinct(texts, p.Text)
+		}
+	}
+	if len(texts) > 0 {
+		return texts, false
+	}
+	for _, l := range d.Licenses() {
+		text, ok := classifier.LicenseText(l.Identifier)
+		if !ok {
+			text = l.Name + "\n"
+			if l.URL != "" {
+				text += "\nThe text of this license is available at " + l.URL + "\n"
+			}
+		}
+		texts = append(texts, text)
+	}
+	return texts, true
+}
+
+// noticeTexts returns the content of the NOTICE files held alongside the license files of the dependency
+func noticeTexts(d diligent.Dep) []string {
+	out := make([]string, 0)
+	dirs := map[string]bool{}
+	for _, p := range d.Provenance {
+		if p.Source != diligent.LicenseFile {
+			continue
+		}
+		dir := filepath.Dir(p.Location)
+		if dirs[dir] {
+			continue
+		}
+		dirs[dir] = true
+		infos, err := ioutil.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, info := range infos {
+			if !info.Mode().IsRegular() || !noticeFileRegexp.MatchString(info.Name()) {
+				continue
+			}
+			b, err := ioutil.ReadFile(filepath.Join(dir, info.Name()))
+			if err != nil {
+				continue
+			}
+			if text := strings.TrimSpace(string(b)); text != "" {
+				out = appendDistinct(out, text)
+			}
+		}
+	}
+	return out
+}
+
+func appendDistinct(ss []string, values ...string) []string {
+	for _, v := range values {
+		found := false
+		for _, s := range ss {
+			if s == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			ss = append(ss, v)
+		}
+	}
+	return ss
+}
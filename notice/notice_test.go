@@ -0,0 +1,140 @@
+package notice_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/classifier"
+	"github.com/senseyeio/diligent/notice"
+)
+
+const mitBody = `Permission is hereby granted, free of charge, to any person obtaining a copy of this software.`
+
+func newDep(t *testing.T, name, version, license string, provenance ...diligent.Provenance) diligent.Dep {
+	d, err := diligent.NewDep(name, license)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Version = version
+	d.Provenance = provenance
+	return d
+}
+
+func TestGather(t *testing.T) {
+	dir, err := ioutil.TempDir("", "notice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "NOTICE"), []byte("Includes software developed by Bar\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	foo := newDep(t, "foo", "1.0.0", "MIT", diligent.Provenance{
+		Source:   diligent.LicenseFile,
+		Location: filepath.Join(dir, "LICENSE"),
+		Text:     "MIT License\n\nCopyright (c) 2018 Foo\n\n" + mitBody,
+	})
+	bar := newDep(t, "bar", "2.0.0", "MIT", diligent.Provenance{
+		Source: diligent.RepositoryAPI,
+		Text:   "MIT License\n\nCopyright (c) 2017 Bar\n\n" + mitBody,
+	})
+	baz := newDep(t, "baz", "3.0.0", "Apache-2.0", diligent.Provenance{Source: diligent.Registry})
+	custom := newDep(t, "custom", "", "MIT")
+	custom.License = diligent.License{Identifier: "Custom", Name: "Custom License", URL: "https://example.com/license"}
+	fork, err := diligent.Override{Package: "fork", License: "ISC", Justification: "private fork of an ISC project"}.Apply(newDep(t, "fork", "4.0.0", "MIT"), ".diligent.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	groups := notice.Gather([]diligent.Dep{foo, bar, baz, custom, fork})
+	if len(groups) != 4 {
+		t.Fatalf("expected 4 groups, got %d: %+v", len(groups), groups)
+	}
+
+	cases := []struct {
+		d         string
+		group     notice.Group
+		licenses  string
+		packages  []string
+		canonical bool
+		text      string
+	}{
+		{"canonical text is used when no license file was found", groups[0], "Apache-2.0", []string{"baz"}, true, "Apache License"},
+		{"licenses without a canonical text refer to their URL", groups[1], "Custom", []string{"custom"}, true, "https://example.com/license"},
+		{"overridden licenses use the canonical text", groups[2], "ISC", []string{"fork"}, true, "Permission to use, copy, modify"},
+		{"identical texts with different copyright holders are grouped", groups[3], "MIT", []string{"bar", "foo"}, false, mitBody},
+	}
+	for _, tt := range cases {
+		t.Run(tt.d, func(t *testing.T) {
+			if l := strings.Join(tt.group.Licenses, ", "); l != tt.licenses {
+				t.Errorf("expected licenses %s, got %s", tt.licenses, l)
+			}
+			if len(tt.group.Packages) != len(tt.packages) {
+				t.Fatalf("expected packages %v, got %+v", tt.packages, tt.group.Packages)
+			}
+			for i, p := range tt.group.Packages {
+				if p.Name != tt.packages[i] {
+					t.Errorf("expected package %s, got %s", tt.packages[i], p.Name)
+				}
+			}
+			if tt.group.Canonical != tt.canonical {
+				t.Errorf("expected canonical %v, got %v", tt.canonical, tt.group.Canonical)
+			}
+			if !strings.Contains(tt.group.Text, tt.text) {
+				t.Errorf("expected text to contain %q, got %s", tt.text, tt.group.Text)
+			}
+			if c := classifier.CopyrightLines(tt.group.Text); len(c) > 0 {
+				t.Errorf("expected copyright notices to be removed from the text, got %s", tt.group.Text)
+			}
+		})
+	}
+
+	mit := groups[3]
+	if c := mit.Packages[0].Copyrights; len(c) != 1 || c[0] != "Copyright (c) 2017 Bar" {
+		t.Errorf("unexpected copyrights for bar %v", c)
+	}
+	if n := mit.Packages[1].Notices; len(n) != 1 || n[0] != "Includes software developed by Bar" {
+		t.Errorf("unexpected notices for foo %v", n)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	foo := newDep(t, "foo", "1.0.0", "MIT", diligent.Provenance{
+		Source: diligent.RepositoryAPI,
+		Text:   "Copyright (c) 2018 <Foo & Co>\n\n" + mitBody + "\n```\n",
+	})
+	groups := notice.Gather([]diligent.Dep{foo})
+
+	cases := []struct {
+		d        string
+		format   string
+		expected []string
+	}{
+		{"text", "text", []string{"THIRD PARTY NOTICES", "MIT\n", "  foo 1.0.0\n    Copyright (c) 2018 <Foo & Co>\n", mitBody}},
+		{"markdown", "markdown", []string{"# Third Party Notices", "## MIT", "- **foo** 1.0.0\n  - Copyright (c) 2018 <Foo & Co>", "````\n" + mitBody}},
+		{"html escapes text", "html", []string{"<h1>Third Party Notices</h1>", "<h2>MIT</h2>", "<li>Copyright (c) 2018 &lt;Foo &amp; Co&gt;</li>", mitBody}},
+	}
+	for _, tt := range cases {
+		t.Run(tt.d, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := notice.Write(&b, tt.format, groups); err != nil {
+				t.Fatal(err)
+			}
+			for _, e := range tt.expected {
+				if !strings.Contains(b.String(), e) {
+					t.Errorf("expected output to contain %q, got %s", e, b.String())
+				}
+			}
+		})
+	}
+
+	if err := notice.Write(&bytes.Buffer{}, "pdf", groups); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
@@ -0,0 +1,148 @@
+package notice
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"regexp"
+	"strings"
+)
+
+const (
+	title    = "Third Party Notices"
+	preamble = "This product includes the following third party software, which is distributed under the licenses reproduced below."
+)
+
+var backtickRunRegexp = regexp.MustCompile("`+")
+
+// Formats lists the formats in which notices can be written
+var Formats = []string{"text", "markdown", "html"}
+
+// Write writes the groups to w in the named format
+func Write(w io.Writer, format string, groups []Group) error {
+	switch format {
+	case "text":
+		return writeText(w, groups)
+	case "markdown":
+		return writeMarkdown(w, groups)
+	case "html":
+		return htmlTemplate.Execute(w, struct {
+			Title    string
+			Preamble string
+			Groups   []Group
+		}{title, preamble, groups})
+	}
+	return fmt.Errorf("unknown notices format '%s', expected one of: %s", format, strings.Join(Formats, ", "))
+}
+
+func writeText(w io.Writer, groups []Group) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n\n%s\n", strings.ToUpper(title), preamble)
+	for _, g := range groups {
+		fmt.Fprintf(&b, "\n%s\n%s\n\n", strings.Repeat("=", 80), strings.Join(g.Licenses, ", "))
+		for _, p := range g.Packages {
+			fmt.Fprintf(&b, "  %s\n", packageName(p))
+			for _, c := range p.Copyrights {
+				fmt.Fprintf(&b, "    %s\n", c)
+			}
+		}
+		fmt.Fprintf(&b, "\n%s\n", strings.TrimSpace(g.Text))
+		for _, p := range g.Packages {
+			for _, n := range p.Notices {
+				fmt.Fprintf(&b, "\nNotice for %s:\n\n%s\n", packageName(p), n)
+			}
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeMarkdown(w io.Writer, groups []Group) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n%s\n", title, preamble)
+	for _, g := range groups {
+		fmt.Fprintf(&b, "\n## %s\n\n", strings.Join(g.Licenses, ", "))
+		for _, p := range g.Packages {
+			fmt.Fprintf(&b, "- **%s**", p.Name)
+			if p.Version != "" {
+				fmt.Fprintf(&b, " %s", p.Version)
+			}
+			b.WriteString("\n")
+			for _, c := range p.Copyrights {
+				fmt.Fprintf(&b, "  - %s\n", c)
+			}
+		}
+		fmt.Fprintf(&b, "\n%s\n", fenced(g.Text))
+		for _, p := range g.Packages {
+			for _, n := range p.Notices {
+				fmt.Fprintf(&b, "\n### Notice for %s\n\n%s\n", packageName(p), fenced(n))
+			}
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// fenced returns text within a code block whose fence is longer than any run of backticks held in the text
+func fenced(text string) string {
+	fence := "```"
+	for _, run := range backtickRunRegexp.FindAllString(text, -1) {
+		if len(run) >= len(fence) {
+			fence = strings.Repeat("`", len(run)+1)
+		}
+	}
+	return fence + "\n" + strings.TrimSpace(text) + "\n" + fence
+}
+
+func packageName(p Package) string {
+	if p.Version == "" {
+		return p.Name
+	}
+	return p.Name + " " + p.Version
+}
+
+var htmlTemplate = template.Must(template.New("notices").Funcs(template.FuncMap{
+	"join": strings.Join,
+	"trim": strings.TrimSpace,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em auto; max-width: 60em; }
+pre { background: #f6f8fa; padding: 1em; white-space: pre-wrap; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<p>{{.Preamble}}</p>
+{{- range .Groups}}
+<section>
+<h2>{{join .Licenses ", "}}</h2>
+<ul>
+{{- range .Packages}}
+<li><strong>{{.Name}}</strong>{{if .Version}} {{.Version}}{{end}}
+{{- if .Copyrights}}
+<ul>
+{{- range .Copyrights}}
+<li>{{.}}</li>
+{{- end}}
+</ul>
+{{- end}}
+</li>
+{{- end}}
+</ul>
+<pre>{{trim .Text}}</pre>
+{{- range .Packages}}
+{{- $p := .}}
+{{- range .Notices}}
+<h3>Notice for {{$p.Name}}{{if $p.Version}} {{$p.Version}}{{end}}</h3>
+<pre>{{.}}</pre>
+{{- end}}
+{{- end}}
+</section>
+{{- end}}
+</body>
+</html>
+`))
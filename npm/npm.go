@@ -71,12 +71,19 @@ func (n *npmDeper) Dependencies(file []byte) ([]diligent.Dep, []diligent.Warning
 
 	deps := make([]diligent.Dep, 0, len(licensesToGet))
 	warns := make([]diligent.Warning, 0, len(licensesToGet))
-	for pkg, version := range licensesToGet {
-		l, err := n.getNPMLicense(pkg, version)
+	for name, version := range licensesToGet {
+		d, err := n.getNPMLicense(name, version)
 		if err != nil {
-			warns = append(warns, warning.New(pkg, err.Error()))
+			warns = append(warns, warning.NewWithVersion(name, version, n.Name(), err))
 		} else {
-			deps = append(deps, l)
+			d.Version = version
+			d.Ecosystem = n.Name()
+			d.Relationship = diligent.Direct
+			d.Scope = diligent.Runtime
+			if _, ok := pkg.Deps[name]; !ok {
+				d.Scope = diligent.Development
+			}
+			deps = append(deps, d)
 		}
 	}
 	return deps, warns, nil
@@ -90,37 +97,51 @@ func (n *npmDeper) IsCompatible(filename string) bool {
 func getNPMLicenseFromURL(pkgName, url string) (diligent.Dep, error) {
 	resp, err := http.Get(url)
 	if err != nil {
-		return diligent.Dep{}, err
+		return diligent.Dep{}, diligent.WithReason(diligent.NetworkError, err)
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != 200 {
-		return diligent.Dep{}, fmt.Errorf("requested failed with status %v", resp.StatusCode)
+		return diligent.Dep{}, diligent.WithReason(statusReason(resp.StatusCode), fmt.Errorf("requested failed with status %v", resp.StatusCode))
 	}
 
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return diligent.Dep{}, err
+		return diligent.Dep{}, diligent.WithReason(diligent.NetworkError, err)
 	}
 
 	var packageInfo npmPackage
 	err = json.Unmarshal(body, &packageInfo)
 	if err != nil {
-		return diligent.Dep{}, errors.New("parsing NPM response failed - invalid JSON")
+		return diligent.Dep{}, diligent.WithReason(diligent.ParseError, errors.New("parsing NPM response failed - invalid JSON"))
 	}
 
 	if packageInfo.License == nil {
-		return diligent.Dep{}, errors.New("no license information in NPM")
+		return diligent.Dep{}, diligent.WithReason(diligent.NoLicenseDeclared, errors.New("no license information in NPM"))
 	}
 
-	l, err := diligent.GetLicenseFromIdentifier(*packageInfo.License)
+	d, err := diligent.NewDep(pkgName, *packageInfo.License)
 	if err != nil {
 		return diligent.Dep{}, err
 	}
+	d.Provenance = []diligent.Provenance{{
+		Source:     diligent.Registry,
+		License:    *packageInfo.License,
+		Location:   url,
+		Snippet:    diligent.Snippet(fmt.Sprintf(`"license": %q`, *packageInfo.License)),
+		Confidence: 1,
+	}}
+	return d, nil
+}
 
-	return diligent.Dep{
-		Name:    pkgName,
-		License: l,
-	}, nil
+// statusReason returns the reason a request to the registry failed with the provided status code
+func statusReason(code int) diligent.Reason {
+	switch code {
+	case http.StatusNotFound:
+		return diligent.NotFound
+	case http.StatusTooManyRequests:
+		return diligent.RateLimited
+	}
+	return diligent.NetworkError
 }
 
 func (n *npmDeper) getNPMLicense(pkgName, version string) (diligent.Dep, error) {
@@ -1,6 +1,9 @@
 package npm_test
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"testing"
 
 	"net/http"
@@ -144,7 +147,7 @@ func TestDependencies(t *testing.T) {
 			"d3": "GPL-3.0",
 		},
 		[]diligent.Warning{
-			warning.New("cypress", "requested failed with status 500"),
+			warning.NewWithVersion("cypress", "2.1.0", "npm", diligent.WithReason(diligent.NetworkError, errors.New("requested failed with status 500"))),
 		},
 		false,
 	}, {
@@ -172,8 +175,8 @@ func TestDependencies(t *testing.T) {
 		}),
 		map[string]string{},
 		[]diligent.Warning{
-			warning.New("d3", "requested failed with status 500"),
-			warning.New("cypress", "requested failed with status 500")},
+			warning.NewWithVersion("d3", "5.0.0", "npm", diligent.WithReason(diligent.NetworkError, errors.New("requested failed with status 500"))),
+			warning.NewWithVersion("cypress", "2.1.0", "npm", diligent.WithReason(diligent.NetworkError, errors.New("requested failed with status 500")))},
 		false,
 	}, {
 		"should be capable of including devDependencies",
@@ -228,9 +231,28 @@ func TestDependencies(t *testing.T) {
 		}),
 		map[string]string{},
 		[]diligent.Warning{
-			warning.New("d3", "license identifier woowoo is not known to diligent"),
+			warning.NewWithVersion("d3", "5.0.0", "npm", diligent.WithReason(diligent.UnknownIdentifier, errors.New("license identifier woowoo is not known to diligent"))),
 		},
 		false,
+	}, {
+		"should support license expressions",
+		npm.Config{},
+		[]byte(`
+			{
+				"dependencies": {
+					"d3": "5.0.0"
+				}
+			}
+		`),
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte("{\"license\":\"(MIT OR Apache-2.0)\"}"))
+		}),
+		map[string]string{
+			"d3": "(MIT OR Apache-2.0)",
+		},
+		[]diligent.Warning{},
+		false,
 	}, {
 		"should fail if response is not valid JSON",
 		npm.Config{},
@@ -247,7 +269,7 @@ func TestDependencies(t *testing.T) {
 		}),
 		map[string]string{},
 		[]diligent.Warning{
-			warning.New("d3", "parsing NPM response failed - invalid JSON"),
+			warning.NewWithVersion("d3", "5.0.0", "npm", diligent.WithReason(diligent.ParseError, errors.New("parsing NPM response failed - invalid JSON"))),
 		},
 		false,
 	}, {
@@ -266,7 +288,34 @@ func TestDependencies(t *testing.T) {
 		}),
 		map[string]string{},
 		[]diligent.Warning{
-			warning.New("d3", "no license information in NPM"),
+			warning.NewWithVersion("d3", "5.0.0", "npm", diligent.WithReason(diligent.NoLicenseDeclared, errors.New("no license information in NPM"))),
+		},
+		false,
+	}, {
+		"should record why requests failed",
+		npm.Config{},
+		[]byte(`
+			{
+				"dependencies": {
+					"d3": "5.0.0",
+					"cypress": "2.1.0"
+				}
+			}
+		`),
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch pathAndQuery(r.URL) {
+			case "/d3?version=5.0.0":
+				w.WriteHeader(http.StatusNotFound)
+			case "/cypress?version=2.1.0":
+				w.WriteHeader(http.StatusTooManyRequests)
+			default:
+				t.Errorf("unexpected path %s", pathAndQuery(r.URL))
+			}
+		}),
+		map[string]string{},
+		[]diligent.Warning{
+			warning.NewWithVersion("d3", "5.0.0", "npm", diligent.WithReason(diligent.NotFound, errors.New("requested failed with status 404"))),
+			warning.NewWithVersion("cypress", "2.1.0", "npm", diligent.WithReason(diligent.RateLimited, errors.New("requested failed with status 429"))),
 		},
 		false,
 	}}
@@ -277,10 +326,30 @@ func TestDependencies(t *testing.T) {
 			defer ts.Close()
 			target := npm.NewWithOptions(ts.URL, tt.config)
 			d, w, e := target.Dependencies(tt.in)
+			var manifest struct {
+				Deps    map[string]string `json:"dependencies"`
+				DevDeps map[string]string `json:"devDependencies"`
+			}
+			json.Unmarshal(tt.in, &manifest)
 			expectedDeps := make([]diligent.Dep, 0, len(tt.depsOut))
 			for depID, lID := range tt.depsOut {
-				l, _ := diligent.GetLicenseFromIdentifier(lID)
-				expectedDeps = append(expectedDeps, diligent.Dep{depID, l})
+				dep, _ := diligent.NewDep(depID, lID)
+				dep.Version = manifest.Deps[depID]
+				dep.Scope = diligent.Runtime
+				if dep.Version == "" {
+					dep.Version = manifest.DevDeps[depID]
+					dep.Scope = diligent.Development
+				}
+				dep.Ecosystem = "npm"
+				dep.Relationship = diligent.Direct
+				dep.Provenance = []diligent.Provenance{{
+					Source:     diligent.Registry,
+					License:    lID,
+					Location:   fmt.Sprintf("%s/%s?version=%s", ts.URL, depID, url.QueryEscape(dep.Version)),
+					Snippet:    fmt.Sprintf(`"license": %q`, lID),
+					Confidence: 1,
+				}}
+				expectedDeps = append(expectedDeps, dep)
 			}
 			if len(d) > 0 || len(expectedDeps) > 0 {
 				sort.Sort(diligent.DepsByName(d))
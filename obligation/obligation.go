@@ -0,0 +1,69 @@
+// Package obligation aggregates the obligations of the licenses of dependencies into a list of what must be done to
+// use and distribute them, such as including copyright notices or providing source code.
+//
+// The obligations of each license follow the conditions described by choosealicense.com. Where a dependency offers a
+// choice of licenses, the license with the fewest obligations is assumed to be chosen. Dependencies whose obligations
+// are not known are listed separately so their licenses can be reviewed.
+package obligation
+
+import (
+	"sort"
+
+	"github.com/senseyeio/diligent"
+)
+
+// Package is a dependency which triggers an obligation
+type Package struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	License string `json:"license"`
+}
+
+// Item is an obligation along with the packages which trigger it
+type Item struct {
+	Obligation  diligent.Obligation `json:"obligation"`
+	Description string              `json:"description"`
+	Packages    []Package           `json:"packages"`
+}
+
+// List holds the obligations triggered by a set of dependencies
+type List struct {
+	Items []Item `json:"obligations"`
+	// Unknown holds the packages whose obligations are not known
+	Unknown []Package `json:"unknown"`
+}
+
+// Gather returns the obligations triggered by the dependencies, in the order of diligent.Obligations
+func Gather(deps []diligent.Dep) List {
+	packages := map[diligent.Obligation][]Package{}
+	unknown := make([]Package, 0)
+	for _, d := range deps {
+		p := Package{Name: d.Name, Version: d.Version, License: d.LicenseExpression()}
+		oo, known := d.Obligations()
+		if !known {
+			unknown = append(unknown, p)
+		}
+		for _, o := range oo {
+			packages[o] = append(packages[o], p)
+		}
+	}
+
+	l := List{Items: make([]Item, 0), Unknown: sortPackages(unknown)}
+	for _, o := range diligent.Obligations {
+		if len(packages[o]) == 0 {
+			continue
+		}
+		l.Items = append(l.Items, Item{Obligation: o, Description: o.Description(), Packages: sortPackages(packages[o])})
+	}
+	return l
+}
+
+func sortPackages(pp []Package) []Package {
+	sort.Slice(pp, func(i, j int) bool {
+		if pp[i].Name == pp[j].Name {
+			return pp[i].Version < pp[j].Version
+		}
+		return pp[i].Name < pp[j].Name
+	})
+	return pp
+}
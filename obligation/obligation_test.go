@@ -0,0 +1,91 @@
+package obligation_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/obligation"
+)
+
+func newDep(t *testing.T, name, version, license string) diligent.Dep {
+	d, err := diligent.NewDep(name, license)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Version = version
+	return d
+}
+
+func testDeps(t *testing.T) []diligent.Dep {
+	return []diligent.Dep{
+		newDep(t, "foo", "1.0.0", "MIT"),
+		newDep(t, "bar", "2.0.0", "Apache-2.0"),
+		newDep(t, "baz", "", "GPL-3.0 OR MIT"),
+		newDep(t, "qux", "0.1.0", "AGPL-3.0"),
+		newDep(t, "json", "1.0.0", "JSON"),
+	}
+}
+
+func TestGather(t *testing.T) {
+	l := obligation.Gather(testDeps(t))
+
+	expected := []struct {
+		obligation diligent.Obligation
+		packages   []string
+	}{
+		{diligent.IncludeCopyright, []string{"bar", "baz", "foo", "qux"}},
+		{diligent.DocumentChanges, []string{"bar", "qux"}},
+		{diligent.DiscloseSource, []string{"qux"}},
+		{diligent.NetworkUseDisclose, []string{"qux"}},
+		{diligent.SameLicense, []string{"qux"}},
+	}
+	if len(l.Items) != len(expected) {
+		t.Fatalf("expected %d obligations, got %+v", len(expected), l.Items)
+	}
+	for i, e := range expected {
+		item := l.Items[i]
+		names := make([]string, len(item.Packages))
+		for j, p := range item.Packages {
+			names[j] = p.Name
+		}
+		if item.Obligation != e.obligation || strings.Join(names, ",") != strings.Join(e.packages, ",") || item.Description == "" {
+			t.Errorf("expected %s triggered by %v, got %+v", e.obligation, e.packages, item)
+		}
+	}
+	if len(l.Unknown) != 1 || l.Unknown[0].Name != "json" {
+		t.Errorf("expected json to have unknown obligations, got %+v", l.Unknown)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	l := obligation.Gather(testDeps(t))
+
+	cases := []struct {
+		d        string
+		format   string
+		expected []string
+	}{
+		{"text", "text", []string{"LICENSE OBLIGATIONS", "[ ] Include a copy of the license and copyright notice with the software (include-copyright)\n      bar 2.0.0 (Apache-2.0)\n      baz (GPL-3.0 OR MIT)\n", "must be reviewed.\n      json 1.0.0 (JSON)\n"}},
+		{"markdown", "markdown", []string{"# License Obligations", "- [ ] Offer the source of the software to users interacting with it over a network (`network-use-disclose`)\n  - **qux 0.1.0** (AGPL-3.0)\n", "## Unknown Obligations", "- **json 1.0.0** (JSON)"}},
+		{"json", "json", []string{`"obligation": "disclose-source"`, `"name": "qux"`, `"unknown": [`}},
+	}
+	for _, tt := range cases {
+		t.Run(tt.d, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := obligation.Write(&b, tt.format, l); err != nil {
+				t.Fatal(err)
+			}
+			for _, e := range tt.expected {
+				if !strings.Contains(b.String(), e) {
+					t.Errorf("expected output to contain %q, got %s", e, b.String())
+				}
+			}
+		})
+	}
+
+	if err := obligation.Write(&bytes.Buffer{}, "pdf", l); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
@@ -0,0 +1,77 @@
+package obligation
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	title    = "License Obligations"
+	preamble = "Using and distributing the dependencies requires the following to be done."
+	review   = "The obligations of the following dependencies are not known and their licenses must be reviewed."
+)
+
+// Formats lists the formats in which obligations can be written
+var Formats = []string{"text", "markdown", "json"}
+
+// Write writes the list to w in the named format
+func Write(w io.Writer, format string, l List) error {
+	switch format {
+	case "text":
+		return writeText(w, l)
+	case "markdown":
+		return writeMarkdown(w, l)
+	case "json":
+		e := json.NewEncoder(w)
+		e.SetIndent("", "  ")
+		return e.Encode(l)
+	}
+	return fmt.Errorf("unknown obligations format '%s', expected one of: %s", format, strings.Join(Formats, ", "))
+}
+
+func writeText(w io.Writer, l List) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n\n%s\n", strings.ToUpper(title), preamble)
+	for _, i := range l.Items {
+		fmt.Fprintf(&b, "\n[ ] %s (%s)\n", i.Description, i.Obligation)
+		for _, p := range i.Packages {
+			fmt.Fprintf(&b, "      %s (%s)\n", packageName(p), p.License)
+		}
+	}
+	if len(l.Unknown) > 0 {
+		fmt.Fprintf(&b, "\n%s\n", review)
+		for _, p := range l.Unknown {
+			fmt.Fprintf(&b, "      %s (%s)\n", packageName(p), p.License)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeMarkdown(w io.Writer, l List) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n%s\n\n", title, preamble)
+	for _, i := range l.Items {
+		fmt.Fprintf(&b, "- [ ] %s (`%s`)\n", i.Description, i.Obligation)
+		for _, p := range i.Packages {
+			fmt.Fprintf(&b, "  - **%s** (%s)\n", packageName(p), p.License)
+		}
+	}
+	if len(l.Unknown) > 0 {
+		fmt.Fprintf(&b, "\n## Unknown Obligations\n\n%s\n\n", review)
+		for _, p := range l.Unknown {
+			fmt.Fprintf(&b, "- **%s** (%s)\n", packageName(p), p.License)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func packageName(p Package) string {
+	if p.Version == "" {
+		return p.Name
+	}
+	return p.Name + " " + p.Version
+}
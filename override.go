@@ -0,0 +1,213 @@
+package diligent
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var constraintRegexp = regexp.MustCompile(`^(>=|<=|>|<|=)?(\S+)$`)
+
+// Override assigns a license to a package whose license cannot be determined automatically, for example a private fork
+// or a package distributed under a custom license text
+type Override struct {
+	// Package is the name of the dependency
+	Package string `toml:"package" yaml:"package"`
+	// Version restricts the override to versions of the dependency. It may be an exact version, a wildcard such as
+	// 1.2.x, or space separated comparisons such as ">=1.2.0 <2.0.0". All versions match when Version is empty.
+	Version string `toml:"version" yaml:"version,omitempty"`
+	// License is the license identifier or SPDX license expression assigned to the dependency
+	License string `toml:"license" yaml:"license"`
+	// Justification records why the license was assigned
+	Justification string `toml:"justification" yaml:"justification"`
+}
+
+// Validate returns an error if the override does not name a package, its license is not a valid expression of known
+// licenses or it has no justification
+func (o Override) Validate() error {
+	if o.Package == "" {
+		return fmt.Errorf("override of license '%s' does not name a package", o.License)
+	}
+	if _, err := NewDep(o.Package, o.License); err != nil {
+		return fmt.Errorf("override of package '%s' has an invalid license: %v", o.Package, err)
+	}
+	if strings.TrimSpace(o.Justification) == "" {
+		return fmt.Errorf("override of package '%s' has no justification", o.Package)
+	}
+	return nil
+}
+
+// Matches returns true if the override applies to the named dependency at version. Versions which are npm ranges,
+// such as ^1.2.0, are compared using the lowest version they permit.
+func (o Override) Matches(name, version string) bool {
+	if o.Package != name {
+		return false
+	}
+	if o.Version == "" || o.Version == version {
+		return true
+	}
+	v, ok := parseVersion(strings.TrimLeft(version, "^~="))
+	if !ok {
+		return false
+	}
+	for _, c := range strings.Fields(o.Version) {
+		if !satisfies(v, c) {
+			return false
+		}
+	}
+	return true
+}
+
+// Apply returns the dependency with the license of the override. The override is recorded as the first provenance of
+// the dependency, with location describing where the override was defined and the justification as its snippet.
+func (o Override) Apply(d Dep, location string) (Dep, error) {
+	od, err := NewDep(d.Name, o.License)
+	if err != nil {
+		return Dep{}, err
+	}
+	d.License = od.License
+	d.Expression = od.Expression
+	d.Provenance = append([]Provenance{{
+		Source:     ManualOverride,
+		License:    o.License,
+		Location:   location,
+		Snippet:    Snippet(o.Justification),
+		Confidence: 1,
+	}}, d.Provenance...)
+	return d, nil
+}
+
+// ApplyOverrides applies the first matching override to each dependency. Warnings about dependencies with a matching
+// override are replaced by dependencies, declared by manifest, holding the license of the override.
+func ApplyOverrides(overrides []Override, location, manifest string, deps []Dep, warnings []Warning) ([]Dep, []Warning) {
+	find := func(name, version string) (Override, bool) {
+		for _, o := range overrides {
+			if o.Matches(name, version) {
+				return o, true
+			}
+		}
+		return Override{}, false
+	}
+
+	depsOut := make([]Dep, 0, len(deps)+len(warnings))
+	for _, d := range deps {
+		if o, ok := find(d.Name, d.Version); ok {
+			if od, err := o.Apply(d, location); err == nil {
+				d = od
+			}
+		}
+		depsOut = append(depsOut, d)
+	}
+	warningsOut := make([]Warning, 0, len(warnings))
+	for _, w := range warnings {
+		o, ok := find(w.Dependency(), w.Version())
+		if !ok {
+			warningsOut = append(warningsOut, w)
+			continue
+		}
+		d, err := o.Apply(Dep{
+			Name:      w.Dependency(),
+			Version:   w.Version(),
+			Ecosystem: w.Ecosystem(),
+			Manifest:  manifest,
+		}, location)
+		if err != nil {
+			warningsOut = append(warningsOut, w)
+			continue
+		}
+		depsOut = append(depsOut, d)
+	}
+	return depsOut, warningsOut
+}
+
+// satisfies returns true if the version satisfies the constraint, a version optionally preceded by a comparison
+// operator
+func satisfies(v []int, constraint string) bool {
+	m := constraintRegexp.FindStringSubmatch(constraint)
+	if m == nil {
+		return false
+	}
+	op, target := m[1], m[2]
+	if op == "" && isWildcard(target) {
+		return matchesWildcard(v, target)
+	}
+	t, ok := parseVersion(target)
+	if !ok {
+		return false
+	}
+	c := compareVersions(v, t)
+	switch op {
+	case ">=":
+		return c >= 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case "<":
+		return c < 0
+	default:
+		return c == 0
+	}
+}
+
+func isWildcard(version string) bool {
+	for _, s := range strings.Split(version, ".") {
+		if s == "x" || s == "X" || s == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesWildcard returns true if each segment of the version before the first wildcard equals that of the pattern
+func matchesWildcard(v []int, pattern string) bool {
+	for i, s := range strings.Split(strings.TrimPrefix(pattern, "v"), ".") {
+		if s == "x" || s == "X" || s == "*" {
+			return true
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil || i >= len(v) || v[i] != n {
+			return false
+		}
+	}
+	return true
+}
+
+// parseVersion returns the numeric segments of a version such as v1.2.3, ignoring any pre-release or build suffix
+func parseVersion(version string) ([]int, bool) {
+	version = strings.TrimPrefix(version, "v")
+	if i := strings.IndexAny(version, "-+"); i >= 0 {
+		version = version[:i]
+	}
+	segments := strings.Split(version, ".")
+	out := make([]int, len(segments))
+	for i, s := range segments {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, false
+		}
+		out[i] = n
+	}
+	return out, true
+}
+
+// compareVersions returns -1, 0 or 1 if a is lower than, equal to or greater than b
+func compareVersions(a, b []int) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var x, y int
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,164 @@
+package diligent_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/warning"
+)
+
+func TestOverrideMatches(t *testing.T) {
+	cases := []struct {
+		d       string
+		version string
+		name    string
+		in      string
+		out     bool
+	}{
+		{"any version", "", "fork", "1.0.0", true},
+		{"different package", "", "other", "1.0.0", false},
+		{"exact version", "1.2.3", "fork", "1.2.3", true},
+		{"different exact version", "1.2.3", "fork", "1.2.4", false},
+		{"wildcard", "1.2.x", "fork", "1.2.9", true},
+		{"wildcard mismatch", "1.2.x", "fork", "1.3.0", false},
+		{"major wildcard", "v1.*", "fork", "v1.9.0", true},
+		{"range", ">=1.2.0 <2.0.0", "fork", "1.5.0", true},
+		{"range lower bound", ">=1.2.0 <2.0.0", "fork", "1.2.0", true},
+		{"range upper bound", ">=1.2.0 <2.0.0", "fork", "2.0.0", false},
+		{"npm range", ">=1.2.0 <2.0.0", "fork", "^1.3.0", true},
+		{"pre-release", "1.2.x", "fork", "1.2.0-beta.1", true},
+		{"revision", ">=1.0.0", "fork", "76626ae9c91c4f2a10f34cad8ce83ea42c93bb75", false},
+		{"exact revision", "76626ae9c91c4f2a10f34cad8ce83ea42c93bb75", "fork", "76626ae9c91c4f2a10f34cad8ce83ea42c93bb75", true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			o := diligent.Override{Package: "fork", Version: c.version, License: "MIT", Justification: "j"}
+			if out := o.Matches(c.name, c.in); out != c.out {
+				t.Errorf("expected %v, got %v", c.out, out)
+			}
+		})
+	}
+}
+
+func TestOverrideValidate(t *testing.T) {
+	cases := []struct {
+		d     string
+		in    diligent.Override
+		isErr bool
+	}{
+		{"valid", diligent.Override{Package: "fork", License: "MIT", Justification: "LICENSE reviewed"}, false},
+		{"valid expression", diligent.Override{Package: "fork", License: "MIT OR Apache-2.0", Justification: "LICENSE reviewed"}, false},
+		{"no package", diligent.Override{License: "MIT", Justification: "LICENSE reviewed"}, true},
+		{"unknown license", diligent.Override{Package: "fork", License: "woowoo", Justification: "LICENSE reviewed"}, true},
+		{"no justification", diligent.Override{Package: "fork", License: "MIT", Justification: " "}, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			err := c.in.Validate()
+			if (err != nil) != c.isErr {
+				t.Errorf("expected error %v, got %v", c.isErr, err)
+			}
+		})
+	}
+}
+
+func TestApplyOverrides(t *testing.T) {
+	overrides := []diligent.Override{
+		{Package: "fork", Version: "1.x", License: "MIT", Justification: "private fork of an MIT project"},
+		{Package: "custom", License: "Apache-2.0", Justification: "custom LICENSE reviewed"},
+	}
+	forkProvenance := diligent.Provenance{
+		Source:     diligent.ManualOverride,
+		License:    "MIT",
+		Location:   ".diligent.yml",
+		Snippet:    "private fork of an MIT project",
+		Confidence: 1,
+	}
+	customProvenance := diligent.Provenance{
+		Source:     diligent.ManualOverride,
+		License:    "Apache-2.0",
+		Location:   ".diligent.yml",
+		Snippet:    "custom LICENSE reviewed",
+		Confidence: 1,
+	}
+	declared := diligent.Provenance{Source: diligent.Registry, License: "GPL-3.0"}
+	mit, _ := diligent.GetLicenseFromIdentifier("MIT")
+	apache, _ := diligent.GetLicenseFromIdentifier("Apache-2.0")
+	gpl, _ := diligent.GetLicenseFromIdentifier("GPL-3.0")
+	unrelated := warning.NewWithVersion("other", "1.0.0", "npm", errors.New("failed"))
+
+	cases := []struct {
+		d           string
+		deps        []diligent.Dep
+		warnings    []diligent.Warning
+		expDeps     []diligent.Dep
+		expWarnings []diligent.Warning
+	}{{
+		"replaces warnings",
+		[]diligent.Dep{},
+		[]diligent.Warning{warning.NewWithVersion("custom", "2.0.0", "npm", errors.New("failed")), unrelated},
+		[]diligent.Dep{{
+			Name:       "custom",
+			Version:    "2.0.0",
+			Ecosystem:  "npm",
+			Manifest:   "package.json",
+			License:    apache,
+			Provenance: []diligent.Provenance{customProvenance},
+		}},
+		[]diligent.Warning{unrelated},
+	}, {
+		"overrides determined licenses",
+		[]diligent.Dep{{
+			Name:       "fork",
+			Version:    "1.4.0",
+			Ecosystem:  "npm",
+			Manifest:   "package.json",
+			License:    gpl,
+			Provenance: []diligent.Provenance{declared},
+		}},
+		[]diligent.Warning{},
+		[]diligent.Dep{{
+			Name:       "fork",
+			Version:    "1.4.0",
+			Ecosystem:  "npm",
+			Manifest:   "package.json",
+			License:    mit,
+			Provenance: []diligent.Provenance{forkProvenance, declared},
+		}},
+		[]diligent.Warning{},
+	}, {
+		"ignores other versions",
+		[]diligent.Dep{{
+			Name:      "fork",
+			Version:   "2.0.0",
+			Ecosystem: "npm",
+			Manifest:  "package.json",
+			License:   gpl,
+		}},
+		[]diligent.Warning{warning.NewWithVersion("fork", "2.1.0", "npm", errors.New("failed"))},
+		[]diligent.Dep{{
+			Name:      "fork",
+			Version:   "2.0.0",
+			Ecosystem: "npm",
+			Manifest:  "package.json",
+			License:   gpl,
+		}},
+		[]diligent.Warning{warning.NewWithVersion("fork", "2.1.0", "npm", errors.New("failed"))},
+	}}
+
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			deps, warnings := diligent.ApplyOverrides(overrides, ".diligent.yml", "package.json", c.deps, c.warnings)
+			if !reflect.DeepEqual(deps, c.expDeps) {
+				t.Errorf("deps: expected %+v, got %+v", c.expDeps, deps)
+			}
+			if !reflect.DeepEqual(warnings, c.expWarnings) {
+				t.Errorf("warnings: expected %+v, got %+v", c.expWarnings, warnings)
+			}
+		})
+	}
+}
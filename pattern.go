@@ -0,0 +1,135 @@
+package diligent
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// equivalentGNURegexp matches the identifiers of the GNU licenses, whose deprecated identifiers such as GPL-3.0 and
+// GPL-3.0+ are equivalent to GPL-3.0-only and GPL-3.0-or-later
+var equivalentGNURegexp = regexp.MustCompile(`^((?:A|L)?GPL|GFDL)-(\d\.\d)(\+|-only|-or-later)?$`)
+
+// ExpandLicensePatterns returns the identifiers of the licenses matched by the patterns, in the order they are first
+// matched. Patterns starting with ! remove the licenses they match from those matched by the other patterns, whatever
+// their order, so permissive,!JSON matches every permissive license other than JSON. An error is returned if a pattern
+// is not known or does not match any license.
+func ExpandLicensePatterns(patterns []string) ([]string, error) {
+	excluded := map[string]bool{}
+	for _, p := range patterns {
+		if !strings.HasPrefix(p, "!") {
+			continue
+		}
+		identifiers, err := ExpandLicensePattern(p)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range identifiers {
+			excluded[i] = true
+		}
+	}
+
+	out := make([]string, 0, len(patterns))
+	for _, p := range patterns {
+		if strings.HasPrefix(p, "!") {
+			continue
+		}
+		identifiers, err := ExpandLicensePattern(p)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range identifiers {
+			if !excluded[i] {
+				excluded[i] = true
+				out = append(out, i)
+			}
+		}
+	}
+	return out, nil
+}
+
+// ExpandLicensePattern returns the identifiers of the licenses matched by a pattern, ignoring any leading !. A pattern
+// is a license identifier, a category such as permissive, a license family such as GPL or BSD, or a glob such as BSD-*
+// or GPL-*-or-later. Identifiers take precedence over families of the same name, so MIT matches only the MIT license.
+// Non standard identifiers, such as NewBSD, match the license they refer to. Deprecated identifiers and their current
+// equivalents, such as GPL-3.0 and GPL-3.0-only, match each other.
+func ExpandLicensePattern(pattern string) ([]string, error) {
+	identifiers, err := expandLicensePattern(pattern)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]string, 0, len(identifiers))
+	found := map[string]bool{}
+	for _, i := range identifiers {
+		for _, e := range append([]string{i}, equivalentIdentifiers(i)...) {
+			if !found[e] {
+				found[e] = true
+				out = append(out, e)
+			}
+		}
+	}
+	return out, nil
+}
+
+func expandLicensePattern(pattern string) ([]string, error) {
+	p := strings.TrimPrefix(pattern, "!")
+	if getCategoryFromString(p) != nil {
+		return ReplaceCategoriesWithIdentifiers([]string{p}), nil
+	}
+	if l, err := GetLicenseFromIdentifier(p); err == nil {
+		return []string{l.Identifier}, nil
+	}
+	if strings.ContainsAny(p, "*?[") {
+		if _, err := path.Match(p, ""); err != nil {
+			return nil, fmt.Errorf("license pattern '%s' is invalid: %v", p, err)
+		}
+		matched := identifiers(getLicenses(func(l License) bool {
+			ok, _ := path.Match(p, l.Identifier)
+			return ok
+		}))
+		if len(matched) == 0 {
+			return nil, fmt.Errorf("license pattern '%s' does not match any known license", p)
+		}
+		return matched, nil
+	}
+	if family := identifiers(GetFamilyLicenses(p)); len(family) > 0 {
+		return family, nil
+	}
+	return nil, fmt.Errorf("license '%s' is not a known license identifier, category, family or pattern", p)
+}
+
+// equivalentIdentifiers returns the other known identifiers of the same license, such as GPL-3.0 for GPL-3.0-only
+func equivalentIdentifiers(identifier string) []string {
+	m := equivalentGNURegexp.FindStringSubmatch(identifier)
+	if m == nil {
+		return nil
+	}
+	suffixes := []string{"", "-only"}
+	if m[3] == "+" || m[3] == "-or-later" {
+		suffixes = []string{"+", "-or-later"}
+	}
+	out := make([]string, 0, 1)
+	for _, s := range suffixes {
+		e := m[1] + "-" + m[2] + s
+		if _, ok := findLicense(e); ok && e != identifier {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// GetFamilyLicenses returns all the licenses belonging to a family, for example GPL
+func GetFamilyLicenses(family string) []License {
+	return getLicenses(func(l License) bool {
+		return l.Family == family
+	})
+}
+
+func identifiers(ll []License) []string {
+	out := make([]string, len(ll))
+	for i, l := range ll {
+		out[i] = l.Identifier
+	}
+	return out
+}
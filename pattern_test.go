@@ -0,0 +1,101 @@
+package diligent_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+)
+
+func TestExpandLicensePattern(t *testing.T) {
+	cases := []struct {
+		d        string
+		in       string
+		contains []string
+		excludes []string
+		isErr    bool
+	}{
+		{"identifier", "MIT", []string{"MIT"}, []string{"MIT-0"}, false},
+		{"non standard identifier", "NewBSD", []string{"BSD-3-Clause"}, []string{"NewBSD"}, false},
+		{"category", "proprietary-free", []string{"SISSL", "Watcom-1.0"}, []string{"MIT"}, false},
+		{"family", "GPL", []string{"GPL-2.0-only", "GPL-3.0-or-later"}, []string{"LGPL-2.1", "AGPL-3.0"}, false},
+		{"family of variants", "BSD", []string{"BSD-2-Clause", "BSD-3-Clause-Clear", "BSD-4-Clause"}, []string{"0BSD"}, false},
+		{"glob", "BSD-*", []string{"BSD-2-Clause", "BSD-Source-Code"}, []string{"0BSD"}, false},
+		{"glob within identifier", "GPL-*-or-later", []string{"GPL-2.0-or-later", "GPL-3.0-or-later"}, []string{"GPL-2.0-only", "LGPL-2.1-or-later"}, false},
+		{"glob matches deprecated equivalents", "GPL-*-or-later", []string{"GPL-2.0+", "GPL-3.0+"}, []string{"GPL-2.0", "GPL-3.0"}, false},
+		{"current identifier matches its deprecated equivalent", "LGPL-2.1-only", []string{"LGPL-2.1-only", "LGPL-2.1"}, []string{"LGPL-2.1+"}, false},
+		{"deprecated identifier matches its current equivalent", "AGPL-3.0", []string{"AGPL-3.0", "AGPL-3.0-only"}, []string{"AGPL-3.0-or-later"}, false},
+		{"negation is ignored", "!JSON", []string{"JSON"}, nil, false},
+		{"unknown", "woowoo", nil, nil, true},
+		{"glob matching nothing", "woowoo-*", nil, nil, true},
+		{"invalid glob", "BSD-[", nil, nil, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			out, err := diligent.ExpandLicensePattern(c.in)
+			if (err != nil) != c.isErr {
+				t.Fatalf("expected error %v, got %v", c.isErr, err)
+			}
+			m := map[string]bool{}
+			for _, o := range out {
+				m[o] = true
+			}
+			for _, e := range c.contains {
+				if !m[e] {
+					t.Errorf("expected %s, got %v", e, out)
+				}
+			}
+			for _, e := range c.excludes {
+				if m[e] {
+					t.Errorf("did not expect %s, got %v", e, out)
+				}
+			}
+		})
+	}
+}
+
+func TestExpandLicensePatterns(t *testing.T) {
+	cases := []struct {
+		d     string
+		in    []string
+		out   string
+		isErr bool
+	}{
+		{"identifiers in order", []string{"MIT", "Apache-2.0", "MIT"}, "MIT,Apache-2.0", false},
+		{"negation", []string{"MIT", "Apache-2.0", "!MIT"}, "Apache-2.0", false},
+		{"negation before pattern", []string{"!GPL-*-only", "GPL-3.0*"}, "GPL-3.0+,GPL-3.0-or-later,GPL-3.0-with-GCC-exception,GPL-3.0-with-autoconf-exception", false},
+		{"negation removes deprecated equivalents", []string{"GPL-3.0", "MIT", "!GPL-3.0-only"}, "MIT", false},
+		{"negation of a deprecated identifier removes its current equivalent", []string{"GPL-2.0-or-later", "MIT", "!GPL-2.0+"}, "MIT", false},
+		{"non standard identifiers are deduplicated", []string{"NewBSD", "BSD-3-Clause"}, "BSD-3-Clause", false},
+		{"negation of a non standard identifier", []string{"BSD-3-Clause", "MIT", "!NewBSD"}, "MIT", false},
+		{"unknown negation", []string{"MIT", "!woowoo"}, "", true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			out, err := diligent.ExpandLicensePatterns(c.in)
+			if (err != nil) != c.isErr {
+				t.Fatalf("expected error %v, got %v", c.isErr, err)
+			}
+			if strings.Join(out, ",") != c.out {
+				t.Errorf("expected %s, got %v", c.out, out)
+			}
+		})
+	}
+}
+
+func TestExpandLicensePatternsCategory(t *testing.T) {
+	out, err := diligent.ExpandLicensePatterns([]string{"permissive", "!JSON"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, o := range out {
+		if o == "JSON" {
+			t.Error("expected JSON to be removed")
+		}
+	}
+	if len(out) != len(diligent.GetCategoryLicenses(diligent.Permissive))-1 {
+		t.Errorf("expected every permissive license other than JSON, got %d", len(out))
+	}
+}
@@ -0,0 +1,213 @@
+// Package policy decides whether the licenses of dependencies are permitted using allow and deny rules.
+//
+// Each rule matches licenses by identifier or category, OSI approval or FSF libre status, and dependencies by
+// ecosystem, name and scope. A rule matches when every condition it holds is met, and conditions left empty match
+// anything. A license is permitted for a dependency when at least one allow rule matches and no deny rule does, so deny
+// rules always take precedence regardless of the order in which rules are given. Where several rules could explain a
+// decision the first, in the order given, is reported. For example:
+//
+//	rules:
+//	  - name: permissive
+//	    action: allow
+//	    licenses: [permissive]
+//	  - name: no-json
+//	    action: deny
+//	    licenses: [JSON]
+//	  - name: copyleft-limited-dev
+//	    action: allow
+//	    licenses: [copyleft-limited]
+//	    scopes: [development]
+package policy
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/senseyeio/diligent"
+)
+
+// Action is the effect of a rule which matches a license
+type Action string
+
+const (
+	// Allow rules permit the licenses they match, unless a deny rule also matches
+	Allow Action = "allow"
+	// Deny rules forbid the licenses they match
+	Deny Action = "deny"
+)
+
+// Rule allows or denies the licenses of the dependencies it matches
+type Rule struct {
+	// Name identifies the rule in violation messages
+	Name   string `yaml:"name,omitempty" toml:"name"`
+	Action Action `yaml:"action" toml:"action"`
+	// Licenses holds license identifiers, categories, families and patterns, as accepted by the whitelist
+	Licenses []string `yaml:"licenses,omitempty" toml:"licenses"`
+	// OSIApproved matches licenses which are, or are not, approved by the Open Source Initiative
+	OSIApproved *bool `yaml:"osi-approved,omitempty" toml:"osi-approved"`
+	// FSFLibre matches licenses which are, or are not, considered free by the Free Software Foundation
+	FSFLibre *bool `yaml:"fsf-libre,omitempty" toml:"fsf-libre"`
+	// Ecosystems holds the names of the Depers which found the dependency, such as npm
+	Ecosystems []string `yaml:"ecosystems,omitempty" toml:"ecosystems"`
+	// Packages holds regular expressions matching the names of dependencies
+	Packages []string `yaml:"packages,omitempty" toml:"packages"`
+	// Scopes holds the scopes of dependencies. Dependencies whose scope is not known are treated as runtime
+	// dependencies.
+	Scopes []diligent.Scope `yaml:"scopes,omitempty" toml:"scopes"`
+}
+
+// Decision is the outcome of evaluating a dependency against a Policy
+type Decision struct {
+	Permitted bool
+	// License is the identifier of the license which is not permitted
+	License string
+	// Rule is the name of the deny rule which forbade the license, or empty if no rule allowed it
+	Rule string
+	// Reason describes why the dependency is not permitted
+	Reason string
+}
+
+// Policy holds a set of rules
+type Policy struct {
+	rules []rule
+}
+
+type rule struct {
+	Rule
+	licenses map[string]bool
+	packages []*regexp.Regexp
+}
+
+// New returns a Policy holding the rules. Rules without a name are named after their position. An error is returned
+// if a rule has an unknown action, license pattern or scope, or an invalid package pattern.
+func New(rules []Rule) (*Policy, error) {
+	p := &Policy{rules: make([]rule, len(rules))}
+	for i, r := range rules {
+		if r.Name == "" {
+			r.Name = fmt.Sprintf("rule %d", i+1)
+		}
+		if r.Action != Allow && r.Action != Deny {
+			return nil, fmt.Errorf("rule '%s' has action '%s', expected allow or deny", r.Name, r.Action)
+		}
+		cr := rule{Rule: r}
+		if len(r.Licenses) > 0 {
+			cr.licenses = map[string]bool{}
+			identifiers, err := diligent.ExpandLicensePatterns(r.Licenses)
+			if err != nil {
+				return nil, fmt.Errorf("rule '%s' is invalid: %v", r.Name, err)
+			}
+			for _, id := range identifiers {
+				cr.licenses[id] = true
+			}
+		}
+		for _, pattern := range r.Packages {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("rule '%s' has an invalid package pattern: %v", r.Name, err)
+			}
+			cr.packages = append(cr.packages, re)
+		}
+		for _, s := range r.Scopes {
+			if s != diligent.Runtime && s != diligent.Development {
+				return nil, fmt.Errorf("rule '%s' has scope '%s', expected %s or %s", r.Name, s, diligent.Runtime, diligent.Development)
+			}
+		}
+		p.rules[i] = cr
+	}
+	return p, nil
+}
+
+// Evaluate decides whether the licenses of the dependency are permitted. Where the dependency is covered by a license
+// expression, every license combined with AND must be permitted but only one of those combined with OR.
+func (p *Policy) Evaluate(d diligent.Dep) Decision {
+	e, err := diligent.ParseExpression(d.LicenseExpression())
+	if err != nil {
+		return Decision{Reason: fmt.Sprintf("its license expression is invalid: %v", err)}
+	}
+	var first *Decision
+	permitted := e.IsSatisfiedBy(func(identifier string) bool {
+		decision := p.evaluateLicense(d, identifier)
+		if !decision.Permitted && first == nil {
+			first = &decision
+		}
+		return decision.Permitted
+	})
+	if permitted || first == nil {
+		return Decision{Permitted: true}
+	}
+	return *first
+}
+
+// evaluateLicense decides whether a single license of the dependency is permitted
+func (p *Policy) evaluateLicense(d diligent.Dep, identifier string) Decision {
+	l, err := diligent.GetLicenseFromIdentifier(identifier)
+	if err != nil {
+		return Decision{License: identifier, Reason: fmt.Sprintf("license '%s' is not known", identifier)}
+	}
+	allowed := false
+	for _, r := range p.rules {
+		if !r.matches(d, l) {
+			continue
+		}
+		if r.Action == Deny {
+			return Decision{License: identifier, Rule: r.Name, Reason: fmt.Sprintf("license '%s' is denied by rule '%s'", identifier, r.Name)}
+		}
+		allowed = true
+	}
+	if !allowed {
+		return Decision{License: identifier, Reason: fmt.Sprintf("license '%s' is not allowed by any rule", identifier)}
+	}
+	return Decision{Permitted: true}
+}
+
+// matches returns true if every condition of the rule is met by the license of the dependency
+func (r rule) matches(d diligent.Dep, l diligent.License) bool {
+	if r.licenses != nil && !r.licenses[l.Identifier] {
+		return false
+	}
+	if r.OSIApproved != nil && *r.OSIApproved != l.IsOSIApproved {
+		return false
+	}
+	if r.FSFLibre != nil && *r.FSFLibre != l.IsFSFLibre {
+		return false
+	}
+	if len(r.Ecosystems) > 0 && !containsFold(r.Ecosystems, d.Ecosystem) {
+		return false
+	}
+	if len(r.packages) > 0 && !matchesAny(r.packages, d.Name) {
+		return false
+	}
+	if len(r.Scopes) > 0 {
+		scope := d.Scope
+		if scope == "" {
+			scope = diligent.Runtime
+		}
+		found := false
+		for _, s := range r.Scopes {
+			found = found || s == scope
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func containsFold(ss []string, s string) bool {
+	for _, v := range ss {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesAny(rr []*regexp.Regexp, s string) bool {
+	for _, r := range rr {
+		if r.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package policy_test
+
+import (
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/policy"
+)
+
+func dep(t *testing.T, name, expression, ecosystem string, scope diligent.Scope) diligent.Dep {
+	d, err := diligent.NewDep(name, expression)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Ecosystem = ecosystem
+	d.Scope = scope
+	return d
+}
+
+func TestEvaluate(t *testing.T) {
+	yes, no := true, false
+	p, err := policy.New([]policy.Rule{
+		{Name: "permissive", Action: policy.Allow, Licenses: []string{"permissive"}},
+		{Name: "no-json", Action: policy.Deny, Licenses: []string{"JSON"}},
+		{Name: "no-agpl", Action: policy.Deny, Licenses: []string{"AGPL-3.0"}},
+		{Name: "copyleft-limited-dev", Action: policy.Allow, Licenses: []string{"copyleft-limited"}, Scopes: []diligent.Scope{diligent.Development}},
+		{Name: "osi-go", Action: policy.Allow, OSIApproved: &yes, Ecosystems: []string{"dep"}},
+		{Name: "acme", Action: policy.Allow, Packages: []string{"^@acme/"}},
+		{Name: "no-unapproved", Action: policy.Deny, OSIApproved: &no, FSFLibre: &no, Packages: []string{"^@acme/"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		d         string
+		in        diligent.Dep
+		permitted bool
+		rule      string
+		reason    string
+	}{
+		{"allowed by category", dep(t, "d3", "MIT", "npm", diligent.Runtime), true, "", ""},
+		{"denied despite category", dep(t, "json", "JSON", "npm", diligent.Runtime), false, "no-json", "license 'JSON' is denied by rule 'no-json'"},
+		{"denied everywhere", dep(t, "agpl", "AGPL-3.0", "dep", diligent.Runtime), false, "no-agpl", "license 'AGPL-3.0' is denied by rule 'no-agpl'"},
+		{"allowed for development", dep(t, "lgpl", "LGPL-3.0", "npm", diligent.Development), true, "", ""},
+		{"not allowed at runtime", dep(t, "lgpl", "LGPL-3.0", "npm", diligent.Runtime), false, "", "license 'LGPL-3.0' is not allowed by any rule"},
+		{"unknown scope is runtime", dep(t, "lgpl", "LGPL-3.0", "npm", ""), false, "", "license 'LGPL-3.0' is not allowed by any rule"},
+		{"allowed by ecosystem and OSI approval", dep(t, "github.com/lib/gpl", "GPL-3.0", "dep", ""), true, "", ""},
+		{"ecosystem does not match", dep(t, "gpl", "GPL-3.0", "npm", ""), false, "", "license 'GPL-3.0' is not allowed by any rule"},
+		{"allowed by package", dep(t, "@acme/ui", "GPL-3.0", "npm", ""), true, "", ""},
+		{"OR needs one permitted license", dep(t, "dual", "JSON OR MIT", "npm", ""), true, "", ""},
+		{"AND needs every license permitted", dep(t, "both", "MIT AND JSON", "npm", ""), false, "no-json", "license 'JSON' is denied by rule 'no-json'"},
+		{"first failing license is reported", dep(t, "neither", "GPL-3.0 OR JSON", "npm", ""), false, "", "license 'GPL-3.0' is not allowed by any rule"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			out := p.Evaluate(c.in)
+			if out.Permitted != c.permitted || out.Rule != c.rule || out.Reason != c.reason {
+				t.Errorf("expected %v %q %q, got %+v", c.permitted, c.rule, c.reason, out)
+			}
+		})
+	}
+}
+
+func TestDenyTakesPrecedence(t *testing.T) {
+	d := dep(t, "json", "JSON", "npm", "")
+	for _, rules := range [][]policy.Rule{
+		{{Name: "deny", Action: policy.Deny, Licenses: []string{"JSON"}}, {Name: "allow", Action: policy.Allow, Licenses: []string{"all"}}},
+		{{Name: "allow", Action: policy.Allow, Licenses: []string{"all"}}, {Name: "deny", Action: policy.Deny, Licenses: []string{"JSON"}}},
+	} {
+		p, err := policy.New(rules)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out := p.Evaluate(d); out.Permitted || out.Rule != "deny" {
+			t.Errorf("expected the deny rule to take precedence, got %+v", out)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	cases := []struct {
+		d     string
+		in    policy.Rule
+		isErr bool
+	}{
+		{"valid", policy.Rule{Action: policy.Allow, Licenses: []string{"MIT", "copyleft"}}, false},
+		{"unknown action", policy.Rule{Action: "permit"}, true},
+		{"unknown license", policy.Rule{Action: policy.Deny, Licenses: []string{"woowoo"}}, true},
+		{"invalid package pattern", policy.Rule{Action: policy.Deny, Packages: []string{"("}}, true},
+		{"unknown scope", policy.Rule{Action: policy.Deny, Scopes: []diligent.Scope{"test"}}, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			_, err := policy.New([]policy.Rule{c.in})
+			if (err != nil) != c.isErr {
+				t.Errorf("expected error %v, got %v", c.isErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package policy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/senseyeio/diligent"
+)
+
+// Distribution describes how the software using the dependencies reaches its users
+type Distribution string
+
+const (
+	// Internal software is only used within the organisation which develops it
+	Internal Distribution = "internal"
+	// SaaS software is hosted by the organisation which develops it and used by others over a network
+	SaaS Distribution = "saas"
+	// OnPremises software is distributed to others as binaries which they run themselves
+	OnPremises Distribution = "on-prem"
+	// Library software is distributed to others to be built into their own software
+	Library Distribution = "library"
+)
+
+// Distributions lists the distribution models for which presets are available
+var Distributions = []Distribution{Internal, SaaS, OnPremises, Library}
+
+// NetworkCopyleft lists the licenses whose source obligations are triggered by making the software available to users
+// over a network, as well as by distributing it
+var NetworkCopyleft = []string{
+	"AGPL-1.0", "AGPL-1.0-only", "AGPL-1.0-or-later", "AGPL-3.0", "AGPL-3.0-only", "AGPL-3.0-or-later",
+	"CPAL-1.0", "EUPL-1.0", "EUPL-1.1", "EUPL-1.2", "OSL-1.0", "OSL-1.1", "OSL-2.0", "OSL-2.1", "OSL-3.0",
+	"RPL-1.1", "RPL-1.5", "SSPL-1.0",
+}
+
+// LesserCopyleft lists the licenses which permit proprietary software to link with the licensed library, provided
+// that users can relink the software with a modified version of the library
+var LesserCopyleft = []string{
+	"LGPL-2.0", "LGPL-2.0+", "LGPL-2.0-only", "LGPL-2.0-or-later", "LGPL-2.1", "LGPL-2.1+", "LGPL-2.1-only",
+	"LGPL-2.1-or-later", "LGPL-3.0", "LGPL-3.0+", "LGPL-3.0-only", "LGPL-3.0-or-later",
+}
+
+// StaticallyLinked lists the ecosystems whose dependencies are statically linked into the binaries built from them
+var StaticallyLinked = []string{"dep", "govendor"}
+
+// Preset returns the rules permitting the licenses whose obligations are acceptable under the distribution model:
+//
+//   - internal software may use any open source license, as copyleft obligations are only triggered by distribution
+//   - saas software may use any open source license except those whose obligations are triggered by network use
+//   - on-prem software may not use copyleft licenses, nor lesser copyleft licenses where dependencies are linked
+//     statically, as users could not relink the binaries against modified versions of the libraries
+//   - library software may not use copyleft licenses, as they would impose their terms on the software using it
+func Preset(d Distribution) ([]Rule, error) {
+	rules := []Rule{{
+		Name:     fmt.Sprintf("%s-permissive", d),
+		Action:   Allow,
+		Licenses: []string{string(diligent.Permissive), string(diligent.PublicDomain)},
+	}}
+	switch d {
+	case Internal:
+		rules = append(rules, Rule{
+			Name:     "internal-copyleft",
+			Action:   Allow,
+			Licenses: []string{string(diligent.CopyLeftLimited), string(diligent.CopyLeft)},
+		})
+	case SaaS:
+		rules = append(rules, Rule{
+			Name:     "saas-copyleft",
+			Action:   Allow,
+			Licenses: []string{string(diligent.CopyLeftLimited), string(diligent.CopyLeft)},
+		}, Rule{
+			Name:     "saas-network-copyleft",
+			Action:   Deny,
+			Licenses: NetworkCopyleft,
+		})
+	case OnPremises:
+		rules = append(rules, Rule{
+			Name:     "on-prem-copyleft-limited",
+			Action:   Allow,
+			Licenses: []string{string(diligent.CopyLeftLimited)},
+		}, Rule{
+			Name:     "on-prem-copyleft",
+			Action:   Deny,
+			Licenses: []string{string(diligent.CopyLeft)},
+		}, Rule{
+			Name:       "on-prem-static-linking",
+			Action:     Deny,
+			Licenses:   LesserCopyleft,
+			Ecosystems: StaticallyLinked,
+		})
+	case Library:
+		rules = append(rules, Rule{
+			Name:     "library-copyleft-limited",
+			Action:   Allow,
+			Licenses: []string{string(diligent.CopyLeftLimited)},
+		}, Rule{
+			Name:     "library-copyleft",
+			Action:   Deny,
+			Licenses: []string{string(diligent.CopyLeft)},
+		})
+	default:
+		return nil, fmt.Errorf("unknown distribution model '%s', expected one of: %s", d, joinDistributions())
+	}
+	return rules, nil
+}
+
+// Obligation describes the obligation which the license of a dependency triggers under the distribution model
+func Obligation(d Distribution, l diligent.License) string {
+	network := contains(NetworkCopyleft, l.Identifier)
+	switch {
+	case l.Category == diligent.Permissive || l.Category == diligent.PublicDomain:
+		return "retain the copyright and license notices"
+	case l.Category == diligent.FreeRestricted || l.Category == diligent.ProprietaryFree:
+		return "review the license terms, which restrict how the software may be used or distributed"
+	case l.Category != diligent.CopyLeft && l.Category != diligent.CopyLeftLimited:
+		return "the obligations of the license are not known and must be reviewed"
+	case d == Internal && network:
+		return "none while the software is only used within the organisation, but making it available to others over a network requires its complete source to be offered to them under the same license"
+	case d == Internal:
+		return "none while the software is only used within the organisation, as the license is triggered by distribution"
+	case d == SaaS && network:
+		return "the complete source of the software must be offered, under the same license, to everyone using it over a network"
+	case d == SaaS:
+		return "none while the software is only provided over a network, as the license is triggered by distribution"
+	case l.Category == diligent.CopyLeft:
+		if d == Library {
+			return "software built with the library must be released, with its complete source, under the same license"
+		}
+		return "the complete source of the software must be distributed under the same license"
+	case contains(LesserCopyleft, l.Identifier):
+		return "the source of the dependency, including any changes, must be provided and users must be able to relink the software against a modified version of it, for example by linking dynamically"
+	default:
+		return "the source of the licensed files, including any changes, must be made available under the same license"
+	}
+}
+
+func joinDistributions() string {
+	ss := make([]string, len(Distributions))
+	for i, d := range Distributions {
+		ss[i] = string(d)
+	}
+	return strings.Join(ss, ", ")
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
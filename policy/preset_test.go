@@ -0,0 +1,89 @@
+package policy_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/policy"
+)
+
+func TestPreset(t *testing.T) {
+	cases := []struct {
+		d            string
+		distribution policy.Distribution
+		in           diligent.Dep
+		permitted    bool
+		rule         string
+	}{
+		{"internal permits AGPL", policy.Internal, dep(t, "agpl", "AGPL-3.0", "npm", ""), true, ""},
+		{"internal permits GPL", policy.Internal, dep(t, "gpl", "GPL-3.0", "dep", ""), true, ""},
+		{"internal does not permit free restricted", policy.Internal, dep(t, "busl", "BUSL-1.1", "npm", ""), false, ""},
+		{"saas permits MIT", policy.SaaS, dep(t, "mit", "MIT", "npm", ""), true, ""},
+		{"saas permits GPL", policy.SaaS, dep(t, "gpl", "GPL-3.0", "npm", ""), true, ""},
+		{"saas denies AGPL", policy.SaaS, dep(t, "agpl", "AGPL-3.0", "npm", ""), false, "saas-network-copyleft"},
+		{"saas denies SSPL", policy.SaaS, dep(t, "sspl", "SSPL-1.0", "npm", ""), false, "saas-network-copyleft"},
+		{"on-prem permits dynamically linked LGPL", policy.OnPremises, dep(t, "lgpl", "LGPL-3.0", "npm", ""), true, ""},
+		{"on-prem denies statically linked LGPL", policy.OnPremises, dep(t, "lgpl", "LGPL-3.0", "dep", ""), false, "on-prem-static-linking"},
+		{"on-prem permits statically linked MPL", policy.OnPremises, dep(t, "mpl", "MPL-2.0", "govendor", ""), true, ""},
+		{"on-prem denies GPL", policy.OnPremises, dep(t, "gpl", "GPL-3.0", "npm", ""), false, "on-prem-copyleft"},
+		{"library permits statically linked LGPL", policy.Library, dep(t, "lgpl", "LGPL-3.0", "dep", ""), true, ""},
+		{"library denies AGPL", policy.Library, dep(t, "agpl", "AGPL-3.0", "npm", ""), false, "library-copyleft"},
+		{"library permits GPL dual licensed", policy.Library, dep(t, "dual", "GPL-3.0 OR MIT", "npm", ""), true, ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			rules, err := policy.Preset(c.distribution)
+			if err != nil {
+				t.Fatal(err)
+			}
+			p, err := policy.New(rules)
+			if err != nil {
+				t.Fatal(err)
+			}
+			out := p.Evaluate(c.in)
+			if out.Permitted != c.permitted || out.Rule != c.rule {
+				t.Errorf("expected %v %q, got %+v", c.permitted, c.rule, out)
+			}
+		})
+	}
+}
+
+func TestPresetUnknown(t *testing.T) {
+	if _, err := policy.Preset("cloud"); err == nil {
+		t.Error("expected an error for an unknown distribution model")
+	}
+}
+
+func TestObligation(t *testing.T) {
+	cases := []struct {
+		d            string
+		distribution policy.Distribution
+		license      string
+		contains     string
+	}{
+		{"permissive", policy.SaaS, "MIT", "notices"},
+		{"free restricted", policy.OnPremises, "BUSL-1.1", "review the license terms"},
+		{"AGPL internally", policy.Internal, "AGPL-3.0", "over a network requires"},
+		{"GPL internally", policy.Internal, "GPL-3.0", "triggered by distribution"},
+		{"AGPL as a service", policy.SaaS, "AGPL-3.0", "everyone using it over a network"},
+		{"GPL as a service", policy.SaaS, "GPL-3.0", "only provided over a network"},
+		{"GPL on-prem", policy.OnPremises, "GPL-3.0", "complete source of the software"},
+		{"GPL in a library", policy.Library, "GPL-3.0", "software built with the library"},
+		{"LGPL on-prem", policy.OnPremises, "LGPL-2.1", "relink"},
+		{"MPL in a library", policy.Library, "MPL-2.0", "licensed files"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			l, err := diligent.GetLicenseFromIdentifier(c.license)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out := policy.Obligation(c.distribution, l); !strings.Contains(out, c.contains) {
+				t.Errorf("expected obligation to contain %q, got %q", c.contains, out)
+			}
+		})
+	}
+}
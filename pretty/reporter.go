@@ -35,17 +35,72 @@ func writeStrings(w io.Writer, strings ...string) error {
 	return nil
 }
 
-// Report outputs the dependencies and their licenses in tabulated form to stdout
-func (c *pretty) Report(w io.Writer, deps []diligent.Dep) error {
+// licenseName returns the name of the dependency's license, or its license expression if it has more than one
+func licenseName(d diligent.Dep) string {
+	if d.Expression != "" {
+		return d.Expression
+	}
+	return d.License.Name
+}
+
+// Report outputs the dependencies and their licenses in tabulated form, followed by the obligations they trigger under
+// the distribution model and any violations, exceptions and warnings
+func (c *pretty) Report(w io.Writer, r diligent.Report) error {
 	writer := tabwriter.NewWriter(w, minColWidth, tabWidth, padding, padChar, flags)
 
-	for _, d := range deps {
-		err := writeStrings(writer, d.Name, tab, d.License.Name, newline)
+	for _, d := range r.Deps {
+		err := writeStrings(writer, d.Name, tab, d.Version, tab, licenseName(d), tab, d.Ecosystem, tab, string(d.Relationship), tab, d.Manifest, newline)
 		if err != nil {
 			return err
 		}
 	}
-
 	writer.Flush()
+
+	if r.Distribution != "" {
+		if err := writeStrings(w, newline, "Obligations when distributed as ", r.Distribution, ":", newline); err != nil {
+			return err
+		}
+		for _, d := range r.Deps {
+			for _, o := range d.DistributionObligations {
+				if err := writeStrings(w, "  ", d.Name, " (", o.License, "): ", o.Description, newline); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if len(r.Violations) > 0 {
+		if err := writeStrings(w, newline, "Violations:", newline); err != nil {
+			return err
+		}
+		for _, v := range r.Violations {
+			if err := writeStrings(w, "  ", v.Message, newline); err != nil {
+				return err
+			}
+		}
+	}
+	if len(r.Exceptions) > 0 {
+		if err := writeStrings(w, newline, "Exceptions:", newline); err != nil {
+			return err
+		}
+		for _, e := range r.Exceptions {
+			s := e.String()
+			if !e.Applied {
+				s += " (not used)"
+			}
+			if err := writeStrings(w, "  ", s, newline); err != nil {
+				return err
+			}
+		}
+	}
+	if len(r.Warnings) > 0 {
+		if err := writeStrings(w, newline, "Warnings:", newline); err != nil {
+			return err
+		}
+		for _, wa := range r.Warnings {
+			if err := writeStrings(w, "  [", string(wa.Reason()), "] ", wa.Warning(), newline); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
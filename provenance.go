@@ -0,0 +1,57 @@
+package diligent
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Source identifies the kind of source from which a license was determined
+type Source string
+
+const (
+	// Registry licenses are declared in package metadata retrieved from a package registry, such as npm
+	Registry Source = "registry"
+	// RepositoryAPI licenses are reported by a code hosting service, such as the GitHub license API
+	RepositoryAPI Source = "repository-api"
+	// LicenseFile licenses are identified from the text of a license file
+	LicenseFile Source = "license-file"
+	// SourceHeader licenses are declared by SPDX-License-Identifier headers within source files
+	SourceHeader Source = "source-header"
+	// ManualOverride licenses are assigned by an Override rather than determined from the dependency
+	ManualOverride Source = "manual-override"
+)
+
+// snippetLength is the maximum length of a Provenance snippet
+const snippetLength = 200
+
+// Provenance records how the license of a dependency was determined
+type Provenance struct {
+	Source Source
+	// License is the license identifier, or SPDX license expression, determined from the source
+	License string
+	// Location is the URL or file path from which the license was determined
+	Location string
+	// Snippet is an extract of the text from which the license was determined
+	Snippet string
+	// Text is the full text from which the license was determined, such as the content of a license file, when it is
+	// available
+	Text string
+	// Confidence is a value between 0 and 1 describing how certain the license is. Licenses declared using an
+	// identifier have a confidence of 1. Confidence is 0 when the source does not report one.
+	Confidence float64
+}
+
+// Snippet returns an extract of text suitable for use within a Provenance. Whitespace is collapsed and long text is
+// truncated.
+func Snippet(text string) string {
+	s := strings.Join(strings.Fields(text), " ")
+	if len(s) <= snippetLength {
+		return s
+	}
+	// avoid splitting a multi-byte character
+	cut := snippetLength
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return s[:cut] + "..."
+}
@@ -0,0 +1,31 @@
+package diligent_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+)
+
+func TestSnippet(t *testing.T) {
+	cases := []struct {
+		d   string
+		in  string
+		out string
+	}{
+		{"short text", "MIT License", "MIT License"},
+		{"collapses whitespace", "  MIT\n\tLicense \n", "MIT License"},
+		{"truncates long text", strings.Repeat("a", 250), strings.Repeat("a", 200) + "..."},
+		{"multi-byte characters within the limit", strings.Repeat("a", 198) + "©", strings.Repeat("a", 198) + "©"},
+		{"truncates before multi-byte characters", strings.Repeat("a", 199) + "©b", strings.Repeat("a", 199) + "..."},
+		{"empty", "", ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			if out := diligent.Snippet(c.in); out != c.out {
+				t.Errorf("expected %q, got %q", c.out, out)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package diligent
+
+import "errors"
+
+// Reason is a machine readable code describing why the license of a dependency could not be determined
+type Reason string
+
+const (
+	// NotFound indicates the dependency, or the source of its license, could not be found
+	NotFound Reason = "not-found"
+	// NoLicenseDeclared indicates the dependency was found but does not declare a license
+	NoLicenseDeclared Reason = "no-license-declared"
+	// UnknownIdentifier indicates the dependency declares a license which diligent does not know
+	UnknownIdentifier Reason = "unknown-identifier"
+	// NetworkError indicates a request for the license of the dependency failed
+	NetworkError Reason = "network-error"
+	// RateLimited indicates a request for the license of the dependency was refused due to rate limiting
+	RateLimited Reason = "rate-limited"
+	// ParseError indicates a manifest or response describing the dependency could not be parsed
+	ParseError Reason = "parse-error"
+	// UnknownReason is used for errors which have not been associated with a Reason
+	UnknownReason Reason = "unknown"
+)
+
+type reasonError struct {
+	reason Reason
+	err    error
+}
+
+func (e *reasonError) Error() string { return e.err.Error() }
+func (e *reasonError) Unwrap() error { return e.err }
+
+// WithReason associates a Reason with err, without altering its message. It returns nil if err is nil.
+func WithReason(r Reason, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &reasonError{r, err}
+}
+
+// ReasonOf returns the Reason associated with err, or any error it wraps, by WithReason. UnknownReason is returned if
+// there is no such Reason.
+func ReasonOf(err error) Reason {
+	var re *reasonError
+	if errors.As(err, &re) {
+		return re.reason
+	}
+	return UnknownReason
+}
@@ -0,0 +1,59 @@
+package diligent_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+)
+
+func TestReasonOf(t *testing.T) {
+	cases := []struct {
+		d   string
+		in  error
+		out diligent.Reason
+	}{
+		{"reason", diligent.WithReason(diligent.RateLimited, errors.New("slow down")), diligent.RateLimited},
+		{"wrapped reason", fmt.Errorf("request failed: %w", diligent.WithReason(diligent.NetworkError, errors.New("timeout"))), diligent.NetworkError},
+		{"no reason", errors.New("eeek"), diligent.UnknownReason},
+		{"nil", nil, diligent.UnknownReason},
+	}
+
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			if out := diligent.ReasonOf(c.in); out != c.out {
+				t.Errorf("expected %s, got %s", c.out, out)
+			}
+		})
+	}
+}
+
+func TestWithReason(t *testing.T) {
+	err := errors.New("eeek")
+	out := diligent.WithReason(diligent.ParseError, err)
+	if out.Error() != "eeek" {
+		t.Errorf("expected message to be unchanged, got %s", out.Error())
+	}
+	if !errors.Is(out, err) {
+		t.Error("expected the original error to be wrapped")
+	}
+	if diligent.WithReason(diligent.ParseError, nil) != nil {
+		t.Error("expected nil")
+	}
+}
+
+func TestUnknownIdentifierReason(t *testing.T) {
+	if _, err := diligent.NewDep("dep", "woowoo"); diligent.ReasonOf(err) != diligent.UnknownIdentifier {
+		t.Errorf("expected %s, got %v", diligent.UnknownIdentifier, err)
+	}
+	if _, err := diligent.NewDep("dep", "SEE LICENSE IN LICENSE.txt"); diligent.ReasonOf(err) != diligent.UnknownIdentifier {
+		t.Errorf("expected %s, got %v", diligent.UnknownIdentifier, err)
+	}
+	if _, err := diligent.NewDep("dep", "MIT WITH Bogus-exception"); diligent.ReasonOf(err) != diligent.UnknownIdentifier {
+		t.Errorf("expected %s, got %v", diligent.UnknownIdentifier, err)
+	}
+	if _, err := diligent.NewDep("dep", ""); diligent.ReasonOf(err) != diligent.NoLicenseDeclared {
+		t.Errorf("expected %s, got %v", diligent.NoLicenseDeclared, err)
+	}
+}
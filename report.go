@@ -0,0 +1,50 @@
+package diligent
+
+import "time"
+
+// Violation is a dependency whose license does not comply with the licenses permitted
+type Violation struct {
+	Dep Dep
+	// Message describes why the dependency does not comply
+	Message string
+}
+
+// Error implements error
+func (v Violation) Error() string {
+	return v.Message
+}
+
+// Report describes the outcome of determining the licenses of the dependencies declared by a set of manifests
+type Report struct {
+	// Deps holds the dependencies whose licenses were determined
+	Deps []Dep
+	// Warnings holds the dependencies whose licenses could not be determined
+	Warnings []Warning
+	// Violations holds the dependencies whose licenses are not permitted
+	Violations []Violation
+	// Exceptions holds every configured exception which has not expired. Those under which dependencies whose licenses
+	// are not whitelisted were permitted are marked as applied.
+	Exceptions []Exception
+	// Manifests holds the path of each manifest processed
+	Manifests []string
+	// Root is the path which was searched for manifests. It may be empty, in which case manifest paths are relative to
+	// the working directory.
+	Root string
+	// Distribution is the distribution model under which the obligations of the dependencies were determined. It is
+	// empty when no distribution model was provided.
+	Distribution string
+	// ToolVersion is the version of diligent which produced the report
+	ToolVersion string
+	// Timestamp is the time at which the report was produced
+	Timestamp time.Time
+}
+
+// Exception returns the applied exception under which the dependency is permitted, if any
+func (r Report) Exception(d Dep) (Exception, bool) {
+	for _, e := range r.Exceptions {
+		if e.Applied && e.Covers(d) {
+			return e, true
+		}
+	}
+	return Exception{}, false
+}
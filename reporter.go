@@ -2,7 +2,7 @@ package diligent
 
 import "io"
 
-// Reporter takes an array of dependencies and outputs them to a certain medium
+// Reporter takes a Report and outputs it to a certain medium
 type Reporter interface {
-	Report(w io.Writer, deps []Dep) error
+	Report(w io.Writer, r Report) error
 }
@@ -0,0 +1,285 @@
+// Package sarif outputs diligent reports as SARIF 2.1.0 logs, which code scanning tools such as GitHub code scanning
+// display as alerts.
+//
+// A result is recorded for each dependency whose license is not permitted and each dependency whose license could not
+// be determined. Dependencies permitted by an exception are recorded as suppressed results. Results point at the
+// manifest declaring the dependency and, where the dependency is named within the manifest, the line naming it.
+// Manifests within the scanned root are referred to relative to the %SRCROOT% base URI, so that the log is portable.
+package sarif
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/senseyeio/diligent"
+)
+
+const (
+	// Version is the version of the SARIF specification to which logs conform
+	Version = "2.1.0"
+
+	schema         = "https://json.schemastore.org/sarif-2.1.0.json"
+	informationURI = "https://github.com/senseyeio/diligent"
+
+	rootBaseID = "%SRCROOT%"
+
+	notPermittedRule = "license-not-permitted"
+	undeterminedRule = "license-undetermined"
+)
+
+var rules = []rule{
+	{
+		ID:               notPermittedRule,
+		ShortDescription: message{"Dependency license is not permitted"},
+		FullDescription:  message{"The license of the dependency is not permitted by the license whitelist."},
+		DefaultConfig:    configuration{"error"},
+	},
+	{
+		ID:               undeterminedRule,
+		ShortDescription: message{"Dependency license could not be determined"},
+		FullDescription:  message{"diligent was unable to determine the license of the dependency."},
+		DefaultConfig:    configuration{"warning"},
+	},
+}
+
+type document struct {
+	Schema  string `json:"$schema"`
+	Version string `json:"version"`
+	Runs    []run  `json:"runs"`
+}
+
+type run struct {
+	Tool               tool                        `json:"tool"`
+	OriginalURIBaseIDs map[string]artifactLocation `json:"originalUriBaseIds,omitempty"`
+	Results            []result                    `json:"results"`
+}
+
+type tool struct {
+	Driver driver `json:"driver"`
+}
+
+type driver struct {
+	Name           string `json:"name"`
+	Version        string `json:"version,omitempty"`
+	InformationURI string `json:"informationUri"`
+	Rules          []rule `json:"rules"`
+}
+
+type rule struct {
+	ID               string        `json:"id"`
+	ShortDescription message       `json:"shortDescription"`
+	FullDescription  message       `json:"fullDescription"`
+	DefaultConfig    configuration `json:"defaultConfiguration"`
+}
+
+type configuration struct {
+	Level string `json:"level"`
+}
+
+type message struct {
+	Text string `json:"text"`
+}
+
+type result struct {
+	RuleID       string        `json:"ruleId"`
+	RuleIndex    int           `json:"ruleIndex"`
+	Level        string        `json:"level"`
+	Message      message       `json:"message"`
+	Locations    []location    `json:"locations,omitempty"`
+	Suppressions []suppression `json:"suppressions,omitempty"`
+}
+
+type suppression struct {
+	Kind          string `json:"kind"`
+	Status        string `json:"status"`
+	Justification string `json:"justification"`
+}
+
+type location struct {
+	PhysicalLocation physicalLocation `json:"physicalLocation"`
+}
+
+type physicalLocation struct {
+	ArtifactLocation artifactLocation `json:"artifactLocation"`
+	Region           *region          `json:"region,omitempty"`
+}
+
+type artifactLocation struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId,omitempty"`
+}
+
+type region struct {
+	StartLine int `json:"startLine"`
+}
+
+type sarifReporter struct{}
+
+// NewReporter returns a Reporter which outputs the report as a SARIF log
+func NewReporter() diligent.Reporter {
+	return &sarifReporter{}
+}
+
+// Report outputs the report as an indented SARIF log
+func (s *sarifReporter) Report(w io.Writer, r diligent.Report) error {
+	root := rootDir(r.Root)
+	lines := lineFinder{root: root, lines: map[string][]string{}}
+	results := make([]result, 0, len(r.Violations)+len(r.Warnings))
+	for _, v := range r.Violations {
+		res := result{
+			RuleID:    notPermittedRule,
+			RuleIndex: 0,
+			Level:     "error",
+			Message:   message{fmt.Sprintf("%s %s is licensed under %s: %s", v.Dep.Name, v.Dep.Version, v.Dep.LicenseExpression(), v.Message)},
+		}
+		if v.Dep.Manifest != "" {
+			res.Locations = []location{lines.locate(v.Dep.Manifest, v.Dep.Name)}
+		}
+		results = append(results, res)
+	}
+	for _, d := range r.Deps {
+		e, ok := r.Exception(d)
+		if !ok {
+			continue
+		}
+		res := result{
+			RuleID:    notPermittedRule,
+			RuleIndex: 0,
+			Level:     "error",
+			Message:   message{fmt.Sprintf("%s %s is licensed under %s", d.Name, d.Version, d.LicenseExpression())},
+			Suppressions: []suppression{{
+				Kind:          "external",
+				Status:        "accepted",
+				Justification: fmt.Sprintf("exception %s, approved by %s, until %s", e.Ticket, e.Approver, e.Expires),
+			}},
+		}
+		if d.Manifest != "" {
+			res.Locations = []location{lines.locate(d.Manifest, d.Name)}
+		}
+		results = append(results, res)
+	}
+	for _, wa := range r.Warnings {
+		res := result{
+			RuleID:    undeterminedRule,
+			RuleIndex: 1,
+			Level:     "warning",
+			Message:   message{fmt.Sprintf("the license of %s could not be determined (%s): %s", wa.Dependency(), wa.Reason(), wa.Err())},
+		}
+		// warnings do not record their manifest, so the first manifest naming the dependency is used
+		for _, m := range r.Manifests {
+			if l := lines.locate(m, wa.Dependency()); l.PhysicalLocation.Region != nil {
+				res.Locations = []location{l}
+				break
+			}
+		}
+		results = append(results, res)
+	}
+
+	doc := document{
+		Schema:  schema,
+		Version: Version,
+		Runs: []run{{
+			Tool: tool{Driver: driver{
+				Name:           "diligent",
+				Version:        r.ToolVersion,
+				InformationURI: informationURI,
+				Rules:          rules,
+			}},
+			Results: results,
+		}},
+	}
+	if root != "" {
+		doc.Runs[0].OriginalURIBaseIDs = map[string]artifactLocation{rootBaseID: {URI: strings.TrimSuffix(fileURI(root), "/") + "/"}}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+// lineFinder finds the lines of manifests naming dependencies, caching the content of each manifest read
+type lineFinder struct {
+	// root is the absolute path of the scanned directory, or empty if it is not known
+	root  string
+	lines map[string][]string
+}
+
+// locate returns the location of the manifest, including the first line naming the dependency, in quotes, if there
+// is one
+func (f lineFinder) locate(manifest, name string) location {
+	l := location{PhysicalLocation: physicalLocation{ArtifactLocation: f.artifact(manifest)}}
+	lines, ok := f.lines[manifest]
+	if !ok {
+		lines = readLines(manifest)
+		f.lines[manifest] = lines
+	}
+	quoted := `"` + name + `"`
+	for i, line := range lines {
+		if strings.Contains(line, quoted) {
+			l.PhysicalLocation.Region = &region{StartLine: i + 1}
+			break
+		}
+	}
+	return l
+}
+
+// artifact returns the location of the manifest relative to the root where it is within the root, and its absolute
+// file URI otherwise
+func (f lineFinder) artifact(manifest string) artifactLocation {
+	abs, err := filepath.Abs(manifest)
+	if err != nil || f.root == "" {
+		return artifactLocation{URI: strings.TrimPrefix(filepath.ToSlash(manifest), "./")}
+	}
+	rel, err := filepath.Rel(f.root, abs)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return artifactLocation{URI: fileURI(abs)}
+	}
+	u := url.URL{Path: filepath.ToSlash(rel)}
+	return artifactLocation{URI: u.EscapedPath(), URIBaseID: rootBaseID}
+}
+
+// rootDir returns the absolute path of the directory which was scanned, or an empty string if it is not known. The
+// directory holding the manifest is used when a single manifest was scanned.
+func rootDir(root string) string {
+	if root == "" {
+		return ""
+	}
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		return ""
+	}
+	if info, err := os.Stat(abs); err == nil && !info.IsDir() {
+		abs = filepath.Dir(abs)
+	}
+	return abs
+}
+
+// fileURI returns the file URI of an absolute path
+func fileURI(path string) string {
+	p := filepath.ToSlash(path)
+	if !strings.HasPrefix(p, "/") {
+		p = "/" + p
+	}
+	u := url.URL{Scheme: "file", Path: p}
+	return u.String()
+}
+
+// readLines returns the lines of the file, or nothing if it cannot be read
+func readLines(path string) []string {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer file.Close()
+	lines := make([]string, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines
+}
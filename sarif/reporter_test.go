@@ -0,0 +1,238 @@
+package sarif_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/sarif"
+	"github.com/senseyeio/diligent/warning"
+)
+
+const packageJSON = `{
+  "name": "example",
+  "dependencies": {
+    "d3": "^5.0.0",
+    "cypress": "^2.1.0",
+    "left-pad": "^1.0.0"
+  }
+}
+`
+
+type sarifLog struct {
+	Version string `json:"version"`
+	Runs    []struct {
+		Tool struct {
+			Driver struct {
+				Name    string `json:"name"`
+				Version string `json:"version"`
+				Rules   []struct {
+					ID string `json:"id"`
+				} `json:"rules"`
+			} `json:"driver"`
+		} `json:"tool"`
+		OriginalURIBaseIDs map[string]struct {
+			URI string `json:"uri"`
+		} `json:"originalUriBaseIds"`
+		Results []struct {
+			RuleID    string `json:"ruleId"`
+			RuleIndex int    `json:"ruleIndex"`
+			Level     string `json:"level"`
+			Locations []struct {
+				PhysicalLocation struct {
+					ArtifactLocation struct {
+						URI       string `json:"uri"`
+						URIBaseID string `json:"uriBaseId"`
+					} `json:"artifactLocation"`
+					Region *struct {
+						StartLine int `json:"startLine"`
+					} `json:"region"`
+				} `json:"physicalLocation"`
+			} `json:"locations"`
+			Suppressions []struct {
+				Kind          string `json:"kind"`
+				Status        string `json:"status"`
+				Justification string `json:"justification"`
+			} `json:"suppressions"`
+		} `json:"results"`
+	} `json:"runs"`
+}
+
+func TestReport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sarif")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	manifest := filepath.Join(dir, "package.json")
+	if err := ioutil.WriteFile(manifest, []byte(packageJSON), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	gpl, err := diligent.NewDep("cypress", "GPL-3.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gpl.Version = "2.1.0"
+	gpl.Manifest = manifest
+	transitive, err := diligent.NewDep("gpl-transitive", "GPL-3.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	transitive.Manifest = manifest
+	excepted, err := diligent.NewDep("d3", "GPL-3.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	excepted.Version = "5.0.0"
+	excepted.Manifest = manifest
+	r := diligent.Report{
+		Deps: []diligent.Dep{gpl, transitive, excepted},
+		Warnings: []diligent.Warning{
+			warning.New("left-pad", "npm", diligent.WithReason(diligent.NotFound, errors.New("requested failed with status 404"))),
+			warning.New("unlisted", "npm", diligent.WithReason(diligent.NotFound, errors.New("requested failed with status 404"))),
+		},
+		Violations: []diligent.Violation{
+			{Dep: gpl, Message: "not permitted"},
+			{Dep: transitive, Message: "not permitted"},
+		},
+		Exceptions:  []diligent.Exception{{Package: "d3", License: "GPL-3.0", Approver: "legal@example.com", Ticket: "LEGAL-42", Expires: "2018-06-30", Applied: true}},
+		Manifests:   []string{manifest},
+		Root:        dir,
+		ToolVersion: "1.2.3",
+	}
+
+	var b bytes.Buffer
+	if err := sarif.NewReporter().Report(&b, r); err != nil {
+		t.Fatal(err)
+	}
+	var doc sarifLog
+	if err := json.Unmarshal(b.Bytes(), &doc); err != nil {
+		t.Fatalf("unable to parse output: %v\n%s", err, b.String())
+	}
+	if doc.Version != sarif.Version || len(doc.Runs) != 1 {
+		t.Fatalf("unexpected log %s", b.String())
+	}
+	driver := doc.Runs[0].Tool.Driver
+	if driver.Name != "diligent" || driver.Version != "1.2.3" || len(driver.Rules) != 2 {
+		t.Errorf("unexpected driver %+v", driver)
+	}
+	results := doc.Runs[0].Results
+	if len(results) != 5 {
+		t.Fatalf("expected 5 results, got %s", b.String())
+	}
+
+	cases := []struct {
+		d         string
+		index     int
+		level     string
+		located   bool
+		startLine int
+	}{
+		{"violations point at the line declaring the dependency", 0, "error", true, 5},
+		{"violations point at the manifest when the dependency is not named", 1, "error", true, 0},
+		{"exceptions point at the line declaring the dependency", 2, "error", true, 4},
+		{"warnings point at the line declaring the dependency", 3, "warning", true, 6},
+		{"warnings have no location when the dependency is not named", 4, "warning", false, 0},
+	}
+	for _, tt := range cases {
+		t.Run(tt.d, func(t *testing.T) {
+			res := results[tt.index]
+			if res.Level != tt.level || driver.Rules[res.RuleIndex].ID != res.RuleID {
+				t.Errorf("unexpected result %+v", res)
+			}
+			if suppressed := len(res.Suppressions) > 0; suppressed != (tt.index == 2) {
+				t.Errorf("unexpected suppressions %+v", res.Suppressions)
+			}
+			if !tt.located {
+				if len(res.Locations) != 0 {
+					t.Errorf("expected no location, got %+v", res.Locations)
+				}
+				return
+			}
+			if len(res.Locations) != 1 {
+				t.Fatalf("expected a location, got %+v", res.Locations)
+			}
+			loc := res.Locations[0].PhysicalLocation
+			if loc.ArtifactLocation.URI != "package.json" || loc.ArtifactLocation.URIBaseID != "%SRCROOT%" {
+				t.Errorf("expected uri package.json relative to the root, got %+v", loc.ArtifactLocation)
+			}
+			line := 0
+			if loc.Region != nil {
+				line = loc.Region.StartLine
+			}
+			if line != tt.startLine {
+				t.Errorf("expected start line %d, got %d", tt.startLine, line)
+			}
+		})
+	}
+	if sup := results[2].Suppressions; len(sup) != 1 || sup[0].Kind != "external" || sup[0].Status != "accepted" || sup[0].Justification != "exception LEGAL-42, approved by legal@example.com, until 2018-06-30" {
+		t.Errorf("unexpected suppressions %+v", sup)
+	}
+}
+
+func TestArtifactLocation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sarif")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	web := filepath.Join(dir, "web")
+	if err := os.Mkdir(web, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{filepath.Join(dir, "package.json"), filepath.Join(web, "package.json")} {
+		if err := ioutil.WriteFile(path, []byte(packageJSON), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cases := []struct {
+		d         string
+		root      string
+		manifest  string
+		uri       string
+		uriBaseID string
+		baseURI   string
+	}{
+		{"manifest in the root", dir, filepath.Join(dir, "package.json"), "package.json", "%SRCROOT%", dir},
+		{"manifest below the root", dir, filepath.Join(web, "package.json"), "web/package.json", "%SRCROOT%", dir},
+		{"root is a manifest", filepath.Join(web, "package.json"), filepath.Join(web, "package.json"), "package.json", "%SRCROOT%", web},
+		{"manifest outside the root", web, filepath.Join(dir, "package.json"), "file://" + filepath.ToSlash(dir) + "/package.json", "", web},
+	}
+	for _, tt := range cases {
+		t.Run(tt.d, func(t *testing.T) {
+			d, err := diligent.NewDep("cypress", "GPL-3.0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			d.Manifest = tt.manifest
+			r := diligent.Report{
+				Deps:       []diligent.Dep{d},
+				Violations: []diligent.Violation{{Dep: d, Message: "not permitted"}},
+				Manifests:  []string{tt.manifest},
+				Root:       tt.root,
+			}
+			var b bytes.Buffer
+			if err := sarif.NewReporter().Report(&b, r); err != nil {
+				t.Fatal(err)
+			}
+			var doc sarifLog
+			if err := json.Unmarshal(b.Bytes(), &doc); err != nil {
+				t.Fatalf("unable to parse output: %v\n%s", err, b.String())
+			}
+			if base := doc.Runs[0].OriginalURIBaseIDs["%SRCROOT%"].URI; base != "file://"+filepath.ToSlash(tt.baseURI)+"/" {
+				t.Errorf("unexpected root %s", base)
+			}
+			loc := doc.Runs[0].Results[0].Locations[0].PhysicalLocation.ArtifactLocation
+			if loc.URI != tt.uri || loc.URIBaseID != tt.uriBaseID {
+				t.Errorf("expected uri %s relative to %q, got %+v", tt.uri, tt.uriBaseID, loc)
+			}
+		})
+	}
+}
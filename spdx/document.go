@@ -0,0 +1,251 @@
+// Package spdx outputs diligent reports as SPDX 2.3 software bill of materials documents, in either the tag-value or
+// the JSON format.
+//
+// Each dependency is described by a package, as is each manifest processed. SPDX identifiers are derived from the
+// ecosystem, name and version of each dependency, rather than the order in which they were found, so that documents
+// describing the same dependencies are identical other than their creation time.
+package spdx
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/senseyeio/diligent"
+)
+
+const (
+	// Version is the version of the SPDX specification to which documents conform
+	Version = "SPDX-2.3"
+	// NoAssertion is used where diligent makes no assertion about a value, such as the license of a dependency whose
+	// license could not be determined
+	NoAssertion = "NOASSERTION"
+
+	dataLicense   = "CC0-1.0"
+	documentID    = "SPDXRef-DOCUMENT"
+	documentName  = "diligent"
+	namespaceBase = "https://spdx.org/spdxdocs/diligent-"
+
+	describes = "DESCRIBES"
+	dependsOn = "DEPENDS_ON"
+)
+
+var invalidIDChars = regexp.MustCompile(`[^A-Za-z0-9.-]+`)
+
+type document struct {
+	name              string
+	namespace         string
+	creator           string
+	created           time.Time
+	packages          []pkg
+	relationships     []relationship
+	extractedLicenses []extractedLicense
+}
+
+type pkg struct {
+	id        string
+	name      string
+	version   string
+	concluded string
+	declared  string
+	comment   string
+}
+
+type relationship struct {
+	element string
+	kind    string
+	related string
+	comment string
+}
+
+type extractedLicense struct {
+	id   string
+	name string
+	text string
+	url  string
+}
+
+// newDocument describes the report as an SPDX document
+func newDocument(r diligent.Report) document {
+	doc := document{
+		name:     documentName,
+		creator:  "Tool: diligent-" + r.ToolVersion,
+		created:  r.Timestamp.UTC(),
+		packages: make([]pkg, 0, len(r.Deps)+len(r.Warnings)+len(r.Manifests)),
+	}
+	extracted := map[string]extractedLicense{}
+	// a dependency may be declared by several manifests, or fail to be processed by each of them
+	seen := map[string]bool{}
+
+	manifestIDs := map[string]string{}
+	for _, m := range r.Manifests {
+		id := elementID("Manifest", m)
+		manifestIDs[m] = id
+		doc.packages = append(doc.packages, pkg{
+			id:        id,
+			name:      m,
+			concluded: NoAssertion,
+			declared:  NoAssertion,
+			comment:   "Manifest processed by diligent",
+		})
+		doc.relationships = append(doc.relationships, relationship{element: documentID, kind: describes, related: id})
+	}
+
+	for _, d := range r.Deps {
+		id := elementID("Package", d.Ecosystem, d.Name, d.Version)
+		if !seen[id] {
+			seen[id] = true
+			concluded := licenseExpression(d, extracted)
+			declared := declaredExpression(d, concluded)
+			p := pkg{
+				id:        id,
+				name:      d.Name,
+				version:   d.Version,
+				concluded: concluded,
+				declared:  declared,
+			}
+			if e, ok := r.Exception(d); ok {
+				p.comment = fmt.Sprintf("License permitted by exception %s, approved by %s, until %s", e.Ticket, e.Approver, e.Expires)
+			}
+			doc.packages = append(doc.packages, p)
+		}
+		if manifestID, ok := manifestIDs[d.Manifest]; ok {
+			rel := relationship{element: manifestID, kind: dependsOn, related: id}
+			if d.Relationship != "" {
+				rel.comment = string(d.Relationship) + " dependency"
+			}
+			doc.relationships = append(doc.relationships, rel)
+		} else {
+			doc.relationships = append(doc.relationships, relationship{element: documentID, kind: describes, related: id})
+		}
+	}
+
+	for _, w := range r.Warnings {
+		id := elementID("Package", w.Ecosystem(), w.Dependency())
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		doc.packages = append(doc.packages, pkg{
+			id:        id,
+			name:      w.Dependency(),
+			concluded: NoAssertion,
+			declared:  NoAssertion,
+			comment:   "diligent could not determine the license (" + string(w.Reason()) + "): " + w.Err().Error(),
+		})
+		doc.relationships = append(doc.relationships, relationship{element: documentID, kind: describes, related: id})
+	}
+
+	for _, e := range extracted {
+		doc.extractedLicenses = append(doc.extractedLicenses, e)
+	}
+	sort.Slice(doc.packages, func(i, j int) bool {
+		return doc.packages[i].id < doc.packages[j].id
+	})
+	sort.Slice(doc.relationships, func(i, j int) bool {
+		a, b := doc.relationships[i], doc.relationships[j]
+		if a.element != b.element {
+			return a.element < b.element
+		}
+		return a.related < b.related
+	})
+	sort.Slice(doc.extractedLicenses, func(i, j int) bool {
+		return doc.extractedLicenses[i].id < doc.extractedLicenses[j].id
+	})
+	doc.namespace = namespaceBase + doc.digest()
+	return doc
+}
+
+// digest identifies the content of the document, excluding its creation time
+func (d document) digest() string {
+	h := sha256.New()
+	for _, p := range d.packages {
+		h.Write([]byte(strings.Join([]string{p.id, p.name, p.version, p.concluded, p.declared, p.comment}, "\x00")))
+	}
+	for _, r := range d.relationships {
+		h.Write([]byte(strings.Join([]string{r.element, r.kind, r.related, r.comment}, "\x00")))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// elementID returns an SPDX identifier derived from the provided values. The values are included, as far as the
+// characters permitted in identifiers allow, to aid readability, along with a hash ensuring uniqueness.
+func elementID(kind string, values ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(values, "\x00")))
+	readable := make([]string, 0, len(values))
+	for _, v := range values {
+		if v = strings.Trim(invalidIDChars.ReplaceAllString(v, "-"), "-"); v != "" {
+			readable = append(readable, v)
+		}
+	}
+	return "SPDXRef-" + kind + "-" + strings.Join(append(readable, hex.EncodeToString(sum[:4])), "-")
+}
+
+// licenseExpression returns the license expression of the dependency using canonical SPDX license identifiers. Licenses
+// which are not on the SPDX license list, including user defined licenses, are referred to using LicenseRef identifiers
+// and recorded in extracted.
+func licenseExpression(d diligent.Dep, extracted map[string]extractedLicense) string {
+	e, err := diligent.ParseExpression(d.LicenseExpression())
+	if err != nil {
+		return NoAssertion
+	}
+	return canonicalise(e, extracted).String()
+}
+
+func canonicalise(e diligent.Expression, extracted map[string]extractedLicense) diligent.Expression {
+	if e.Operator != "" {
+		operands := make([]diligent.Expression, len(e.Operands))
+		for i, o := range e.Operands {
+			operands[i] = canonicalise(o, extracted)
+		}
+		e.Operands = operands
+		return e
+	}
+	suffix := ""
+	identifier := e.Identifier
+	if strings.HasSuffix(identifier, "+") {
+		suffix = "+"
+		identifier = strings.TrimSuffix(identifier, "+")
+	}
+	// SPDX does not permit "+" to follow a LicenseRef
+	l, err := diligent.GetLicenseFromIdentifier(identifier)
+	if err != nil {
+		e.Identifier = licenseRef(identifier)
+		extracted[e.Identifier] = extractedLicense{id: e.Identifier, name: identifier, text: identifier}
+		return e
+	}
+	if !l.IsSPDXListed {
+		e.Identifier = licenseRef(l.Identifier)
+		extracted[e.Identifier] = extractedLicense{id: e.Identifier, name: l.Name, text: l.Name, url: l.URL}
+		return e
+	}
+	e.Identifier = l.Identifier + suffix
+	return e
+}
+
+// licenseRef returns a LicenseRef identifier for a license which is not on the SPDX license list
+func licenseRef(identifier string) string {
+	if strings.HasPrefix(identifier, "LicenseRef-") {
+		return identifier
+	}
+	return "LicenseRef-" + strings.Trim(invalidIDChars.ReplaceAllString(identifier, "-"), "-")
+}
+
+// declaredExpression returns the concluded license as the license declared by the package when it was determined from
+// the package itself. The license of an overridden dependency is the conclusion of a reviewer, so it is not asserted
+// to be the license the package declares.
+func declaredExpression(d diligent.Dep, concluded string) string {
+	if len(d.Provenance) == 0 {
+		return NoAssertion
+	}
+	for _, p := range d.Provenance {
+		if p.Source == diligent.ManualOverride {
+			return NoAssertion
+		}
+	}
+	return concluded
+}
@@ -0,0 +1,110 @@
+package spdx
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/senseyeio/diligent"
+)
+
+// timeFormat is the format of SPDX timestamps
+const timeFormat = "2006-01-02T15:04:05Z"
+
+type jsonDocument struct {
+	SPDXVersion                string                 `json:"spdxVersion"`
+	DataLicense                string                 `json:"dataLicense"`
+	SPDXID                     string                 `json:"SPDXID"`
+	Name                       string                 `json:"name"`
+	DocumentNamespace          string                 `json:"documentNamespace"`
+	CreationInfo               jsonCreationInfo       `json:"creationInfo"`
+	Packages                   []jsonPackage          `json:"packages"`
+	HasExtractedLicensingInfos []jsonExtractedLicense `json:"hasExtractedLicensingInfos,omitempty"`
+	Relationships              []jsonRelationship     `json:"relationships"`
+}
+
+type jsonCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type jsonPackage struct {
+	SPDXID           string `json:"SPDXID"`
+	Name             string `json:"name"`
+	VersionInfo      string `json:"versionInfo,omitempty"`
+	DownloadLocation string `json:"downloadLocation"`
+	FilesAnalyzed    bool   `json:"filesAnalyzed"`
+	LicenseConcluded string `json:"licenseConcluded"`
+	LicenseDeclared  string `json:"licenseDeclared"`
+	CopyrightText    string `json:"copyrightText"`
+	Comment          string `json:"comment,omitempty"`
+}
+
+type jsonExtractedLicense struct {
+	LicenseID     string   `json:"licenseId"`
+	ExtractedText string   `json:"extractedText"`
+	Name          string   `json:"name"`
+	SeeAlsos      []string `json:"seeAlsos,omitempty"`
+}
+
+type jsonRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+	Comment            string `json:"comment,omitempty"`
+}
+
+type jsonReporter struct{}
+
+// NewJSONReporter returns a Reporter which outputs an SPDX document in the JSON format
+func NewJSONReporter() diligent.Reporter {
+	return &jsonReporter{}
+}
+
+// Report outputs the report as an SPDX document in the JSON format
+func (j *jsonReporter) Report(w io.Writer, r diligent.Report) error {
+	doc := newDocument(r)
+	out := jsonDocument{
+		SPDXVersion:       Version,
+		DataLicense:       dataLicense,
+		SPDXID:            documentID,
+		Name:              doc.name,
+		DocumentNamespace: doc.namespace,
+		CreationInfo: jsonCreationInfo{
+			Created:  doc.created.Format(timeFormat),
+			Creators: []string{doc.creator},
+		},
+		Packages:      make([]jsonPackage, len(doc.packages)),
+		Relationships: make([]jsonRelationship, len(doc.relationships)),
+	}
+	for i, p := range doc.packages {
+		out.Packages[i] = jsonPackage{
+			SPDXID:           p.id,
+			Name:             p.name,
+			VersionInfo:      p.version,
+			DownloadLocation: NoAssertion,
+			LicenseConcluded: p.concluded,
+			LicenseDeclared:  p.declared,
+			CopyrightText:    NoAssertion,
+			Comment:          p.comment,
+		}
+	}
+	for _, e := range doc.extractedLicenses {
+		l := jsonExtractedLicense{LicenseID: e.id, ExtractedText: e.text, Name: e.name}
+		if e.url != "" {
+			l.SeeAlsos = []string{e.url}
+		}
+		out.HasExtractedLicensingInfos = append(out.HasExtractedLicensingInfos, l)
+	}
+	for i, rel := range doc.relationships {
+		out.Relationships[i] = jsonRelationship{
+			SPDXElementID:      rel.element,
+			RelationshipType:   rel.kind,
+			RelatedSPDXElement: rel.related,
+			Comment:            rel.comment,
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
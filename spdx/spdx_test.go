@@ -0,0 +1,243 @@
+package spdx_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/internal/reporttest"
+	"github.com/senseyeio/diligent/spdx"
+)
+
+var idRegexp = regexp.MustCompile(`^SPDXRef-[A-Za-z0-9.-]+$`)
+
+// testReport returns the shared report with the npm manifest nested in web, d3 declared by a version range,
+// github.com/pkg/errors licensed under a deprecated identifier and a dependency whose license is not on the SPDX
+// License List
+func testReport(t *testing.T) diligent.Report {
+	r := reporttest.Report(t)
+	for i := range r.Deps {
+		if r.Deps[i].Manifest == "package.json" {
+			r.Deps[i].Manifest = "web/package.json"
+		}
+	}
+	r.Manifests = []string{"Gopkg.lock", "web/package.json"}
+	r.Deps[0].Version = "^5.0.0"
+
+	errs, err := diligent.NewDep("github.com/pkg/errors", "BSD-2-Clause OR GPL-2.0+")
+	if err != nil {
+		t.Fatal(err)
+	}
+	errs.Version, errs.Ecosystem, errs.Manifest, errs.Relationship = r.Deps[3].Version, r.Deps[3].Ecosystem, r.Deps[3].Manifest, r.Deps[3].Relationship
+	r.Deps[3] = errs
+
+	r.Deps = append(r.Deps, diligent.Dep{Name: "github.com/acme/internal", Expression: "MIT AND Acme-Internal", Ecosystem: "dep", Manifest: "Gopkg.lock"})
+	return r
+}
+
+func TestTagValue(t *testing.T) {
+	var buf bytes.Buffer
+	if err := spdx.NewTagValueReporter().Report(&buf, testReport(t)); err != nil {
+		t.Fatalf("did not expect an error, got %v", err)
+	}
+	out := buf.String()
+
+	for _, line := range []string{
+		"SPDXVersion: SPDX-2.3",
+		"DataLicense: CC0-1.0",
+		"SPDXID: SPDXRef-DOCUMENT",
+		"Creator: Tool: diligent-1.2.3",
+		"Created: 2018-05-01T12:00:00Z",
+		"PackageName: d3",
+		"PackageVersion: ^5.0.0",
+		"PackageComment: License permitted by exception LEGAL-42, approved by legal@example.com, until 2018-06-30",
+		"PackageLicenseConcluded: BSD-2-Clause OR GPL-2.0+",
+		"PackageLicenseConcluded: MIT AND LicenseRef-Acme-Internal",
+		"LicenseID: LicenseRef-Acme-Internal",
+		"PackageName: left-pad",
+		"RelationshipComment: transitive dependency",
+	} {
+		if !strings.Contains(out, line+"\n") {
+			t.Errorf("expected output to contain %q", line)
+		}
+	}
+	if !regexp.MustCompile(`(?m)^Relationship: SPDXRef-Manifest-web-package.json-[0-9a-f]+ DEPENDS_ON SPDXRef-Package-npm-d3-5.0.0-[0-9a-f]+$`).MatchString(out) {
+		t.Errorf("expected the manifest to depend on d3, got\n%s", out)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := spdx.NewJSONReporter().Report(&buf, testReport(t)); err != nil {
+		t.Fatalf("did not expect an error, got %v", err)
+	}
+	var doc struct {
+		SPDXVersion       string `json:"spdxVersion"`
+		SPDXID            string `json:"SPDXID"`
+		DocumentNamespace string `json:"documentNamespace"`
+		Packages          []struct {
+			SPDXID           string `json:"SPDXID"`
+			Name             string `json:"name"`
+			LicenseConcluded string `json:"licenseConcluded"`
+			LicenseDeclared  string `json:"licenseDeclared"`
+		} `json:"packages"`
+		Relationships []struct {
+			SPDXElementID      string `json:"spdxElementId"`
+			RelationshipType   string `json:"relationshipType"`
+			RelatedSPDXElement string `json:"relatedSpdxElement"`
+		} `json:"relationships"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("expected valid JSON, got %v", err)
+	}
+	if doc.SPDXVersion != "SPDX-2.3" || doc.SPDXID != "SPDXRef-DOCUMENT" || doc.DocumentNamespace == "" {
+		t.Errorf("unexpected document %+v", doc)
+	}
+
+	ids := map[string]bool{"SPDXRef-DOCUMENT": true}
+	licenses := map[string][2]string{}
+	for _, p := range doc.Packages {
+		if !idRegexp.MatchString(p.SPDXID) {
+			t.Errorf("invalid SPDXID %s", p.SPDXID)
+		}
+		if ids[p.SPDXID] {
+			t.Errorf("duplicate SPDXID %s", p.SPDXID)
+		}
+		ids[p.SPDXID] = true
+		licenses[p.Name] = [2]string{p.LicenseConcluded, p.LicenseDeclared}
+	}
+	if len(doc.Packages) != 9 {
+		t.Errorf("expected 9 packages, got %d", len(doc.Packages))
+	}
+	expLicenses := map[string][2]string{
+		"d3":                       {"MIT", "MIT"},
+		"github.com/pkg/errors":    {"BSD-2-Clause OR GPL-2.0+", spdx.NoAssertion},
+		"left-pad":                 {spdx.NoAssertion, spdx.NoAssertion},
+		"github.com/acme/internal": {"MIT AND LicenseRef-Acme-Internal", spdx.NoAssertion},
+	}
+	for name, exp := range expLicenses {
+		if licenses[name] != exp {
+			t.Errorf("expected %s to have licenses %v, got %v", name, exp, licenses[name])
+		}
+	}
+
+	dependsOn := 0
+	for _, r := range doc.Relationships {
+		if !ids[r.SPDXElementID] || !ids[r.RelatedSPDXElement] {
+			t.Errorf("relationship refers to an unknown element %+v", r)
+		}
+		if r.RelationshipType == "DEPENDS_ON" {
+			dependsOn++
+		}
+	}
+	if dependsOn != 6 {
+		t.Errorf("expected 6 DEPENDS_ON relationships, got %d", dependsOn)
+	}
+}
+
+func TestLicenseRef(t *testing.T) {
+	if !diligent.IsUserDefined("Acme-Proprietary") {
+		if err := diligent.RegisterLicense(diligent.License{Identifier: "Acme-Proprietary", Name: "Acme Proprietary License"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cases := []struct {
+		d          string
+		expression string
+		concluded  string
+		extracted  string
+	}{
+		{"license only known to diligent", "WXwindows", "LicenseRef-WXwindows", "LicenseName: wxWindows Library Licence 3.1"},
+		{"variant only known to diligent", "MIT OR D-FSL-1.0-DE", "MIT OR LicenseRef-D-FSL-1.0-DE", "LicenseID: LicenseRef-D-FSL-1.0-DE"},
+		{"user defined license", "Acme-Proprietary", "LicenseRef-Acme-Proprietary", "LicenseName: Acme Proprietary License"},
+		{"license on the SPDX license list", "D-FSL-1.0", "D-FSL-1.0", ""},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.d, func(t *testing.T) {
+			d, err := diligent.NewDep("dep", tt.expression)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d.Ecosystem = "npm"
+			var buf bytes.Buffer
+			if err := spdx.NewTagValueReporter().Report(&buf, diligent.Report{Deps: []diligent.Dep{d}, Timestamp: time.Now()}); err != nil {
+				t.Fatal(err)
+			}
+			out := buf.String()
+			if !strings.Contains(out, "PackageLicenseConcluded: "+tt.concluded+"\n") {
+				t.Errorf("expected the concluded license %s, got\n%s", tt.concluded, out)
+			}
+			if tt.extracted == "" && strings.Contains(out, "LicenseID:") {
+				t.Errorf("did not expect an extracted license, got\n%s", out)
+			}
+			if tt.extracted != "" && !strings.Contains(out, tt.extracted+"\n") {
+				t.Errorf("expected output to contain %q, got\n%s", tt.extracted, out)
+			}
+		})
+	}
+}
+
+func TestOverrideNotDeclared(t *testing.T) {
+	cases := []struct {
+		d          string
+		provenance []diligent.Provenance
+		out        string
+	}{
+		{"only overridden", []diligent.Provenance{{Source: diligent.ManualOverride, License: "MIT"}}, spdx.NoAssertion},
+		{"overridden registry license", []diligent.Provenance{
+			{Source: diligent.ManualOverride, License: "MIT"},
+			{Source: diligent.Registry, License: "GPL-3.0"},
+		}, spdx.NoAssertion},
+		{"registry license", []diligent.Provenance{{Source: diligent.Registry, License: "MIT"}}, "MIT"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			d, err := diligent.NewDep("fork", "MIT")
+			if err != nil {
+				t.Fatal(err)
+			}
+			d.Ecosystem = "npm"
+			d.Provenance = c.provenance
+			var buf bytes.Buffer
+			err = spdx.NewTagValueReporter().Report(&buf, diligent.Report{Deps: []diligent.Dep{d}, Timestamp: time.Now()})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out := buf.String(); !strings.Contains(out, "PackageLicenseConcluded: MIT\nPackageLicenseDeclared: "+c.out+"\n") {
+				t.Errorf("expected the declared license %s, got\n%s", c.out, out)
+			}
+		})
+	}
+}
+
+func TestDeterministic(t *testing.T) {
+	reporters := map[string]diligent.Reporter{
+		"tag-value": spdx.NewTagValueReporter(),
+		"json":      spdx.NewJSONReporter(),
+	}
+	for name, reporter := range reporters {
+		t.Run(name, func(t *testing.T) {
+			r := testReport(t)
+			var first bytes.Buffer
+			if err := reporter.Report(&first, r); err != nil {
+				t.Fatal(err)
+			}
+
+			r.Deps[0], r.Deps[2] = r.Deps[2], r.Deps[0]
+			r.Manifests[0], r.Manifests[1] = r.Manifests[1], r.Manifests[0]
+			var second bytes.Buffer
+			if err := reporter.Report(&second, r); err != nil {
+				t.Fatal(err)
+			}
+			if first.String() != second.String() {
+				t.Errorf("expected identical documents, got\n%s\nand\n%s", first.String(), second.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package spdx
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/senseyeio/diligent"
+)
+
+type tagValue struct{}
+
+// NewTagValueReporter returns a Reporter which outputs an SPDX document in the tag-value format
+func NewTagValueReporter() diligent.Reporter {
+	return &tagValue{}
+}
+
+// Report outputs the report as an SPDX document in the tag-value format
+func (t *tagValue) Report(w io.Writer, r diligent.Report) error {
+	doc := newDocument(r)
+	bw := bufio.NewWriter(w)
+	tag := func(name, value string) {
+		fmt.Fprintf(bw, "%s: %s\n", name, textValue(value))
+	}
+
+	tag("SPDXVersion", Version)
+	tag("DataLicense", dataLicense)
+	tag("SPDXID", documentID)
+	tag("DocumentName", doc.name)
+	tag("DocumentNamespace", doc.namespace)
+	tag("Creator", doc.creator)
+	tag("Created", doc.created.Format(timeFormat))
+
+	for _, p := range doc.packages {
+		fmt.Fprintf(bw, "\n##### Package: %s\n\n", p.name)
+		tag("PackageName", p.name)
+		tag("SPDXID", p.id)
+		if p.version != "" {
+			tag("PackageVersion", p.version)
+		}
+		tag("PackageDownloadLocation", NoAssertion)
+		tag("FilesAnalyzed", "false")
+		tag("PackageLicenseConcluded", p.concluded)
+		tag("PackageLicenseDeclared", p.declared)
+		tag("PackageCopyrightText", NoAssertion)
+		if p.comment != "" {
+			tag("PackageComment", p.comment)
+		}
+	}
+
+	if len(doc.extractedLicenses) > 0 {
+		fmt.Fprint(bw, "\n##### Other Licenses\n")
+	}
+	for _, e := range doc.extractedLicenses {
+		fmt.Fprintln(bw)
+		tag("LicenseID", e.id)
+		tag("ExtractedText", e.text)
+		tag("LicenseName", e.name)
+		if e.url != "" {
+			tag("LicenseCrossReference", e.url)
+		}
+	}
+
+	fmt.Fprint(bw, "\n##### Relationships\n\n")
+	for _, rel := range doc.relationships {
+		tag("Relationship", rel.element+" "+rel.kind+" "+rel.related)
+		if rel.comment != "" {
+			tag("RelationshipComment", rel.comment)
+		}
+	}
+	return bw.Flush()
+}
+
+// textValue wraps values spanning several lines, or which could be mistaken for markup, in text tags
+func textValue(s string) string {
+	if strings.ContainsAny(s, "\n\r") || strings.Contains(s, "<text>") {
+		return "<text>" + strings.Replace(s, "</text>", "&lt;/text&gt;", -1) + "</text>"
+	}
+	return s
+}
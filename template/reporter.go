@@ -0,0 +1,149 @@
+// Package template outputs diligent reports using user supplied Go templates, allowing the layout of the output to be
+// customised without changing diligent.
+//
+// Templates are executed with the diligent.Report as their data. Templates whose file names end in .html or .htm are
+// parsed as html/template templates, escaping their output, and all others as text/template templates. In addition to
+// the standard template functions, the following are available:
+//
+//	groupByLicense   groups dependencies by their license expression
+//	groupByCategory  groups dependencies by the categories of their licenses
+//	join             joins a list of strings using a separator, e.g. {{join .Manifests ", "}}
+//	sort             sorts a list of strings
+//	sortDeps         sorts dependencies by "name" or "license", e.g. {{range sortDeps "license" .Deps}}
+//	licenseText      returns the canonical text of a license identifier, or an empty string if it is not known
+package template
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/classifier"
+)
+
+// Group is a set of dependencies sharing a license expression or category
+type Group struct {
+	Key  string
+	Deps []diligent.Dep
+}
+
+// executor is implemented by both text and HTML templates
+type executor interface {
+	Execute(w io.Writer, data interface{}) error
+}
+
+type templateReporter struct {
+	tmpl executor
+}
+
+// NewReporter returns a Reporter which outputs the report using the template held in the file at path
+func NewReporter(path string) (diligent.Reporter, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	name := filepath.Base(path)
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".html", ".htm":
+		t, err := htmltemplate.New(name).Funcs(funcs).Parse(string(b))
+		if err != nil {
+			return nil, err
+		}
+		return &templateReporter{t}, nil
+	default:
+		t, err := texttemplate.New(name).Funcs(funcs).Parse(string(b))
+		if err != nil {
+			return nil, err
+		}
+		return &templateReporter{t}, nil
+	}
+}
+
+// Report executes the template with the report as its data
+func (t *templateReporter) Report(w io.Writer, r diligent.Report) error {
+	return t.tmpl.Execute(w, r)
+}
+
+var funcs = map[string]interface{}{
+	"groupByLicense":  groupByLicense,
+	"groupByCategory": groupByCategory,
+	"join":            strings.Join,
+	"sort":            sortStrings,
+	"sortDeps":        sortDeps,
+	"licenseText":     licenseText,
+}
+
+func groupByLicense(deps []diligent.Dep) []Group {
+	return group(deps, func(d diligent.Dep) []string {
+		return []string{d.LicenseExpression()}
+	})
+}
+
+// groupByCategory places each dependency in the group of each category of its licenses
+func groupByCategory(deps []diligent.Dep) []Group {
+	return group(deps, func(d diligent.Dep) []string {
+		keys := make([]string, 0)
+		for _, l := range d.Licenses() {
+			keys = append(keys, string(l.Category))
+		}
+		return keys
+	})
+}
+
+// group returns the groups, ordered by key, holding the dependencies in their original order
+func group(deps []diligent.Dep, keys func(d diligent.Dep) []string) []Group {
+	groups := map[string]*Group{}
+	for _, d := range deps {
+		seen := map[string]bool{}
+		for _, k := range keys(d) {
+			if seen[k] {
+				continue
+			}
+			seen[k] = true
+			g, ok := groups[k]
+			if !ok {
+				g = &Group{Key: k}
+				groups[k] = g
+			}
+			g.Deps = append(g.Deps, d)
+		}
+	}
+	out := make([]Group, 0, len(groups))
+	for _, g := range groups {
+		out = append(out, *g)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Key < out[j].Key
+	})
+	return out
+}
+
+func sortStrings(ss []string) []string {
+	out := append([]string{}, ss...)
+	sort.Strings(out)
+	return out
+}
+
+func sortDeps(by string, deps []diligent.Dep) ([]diligent.Dep, error) {
+	out := append([]diligent.Dep{}, deps...)
+	switch by {
+	case "name":
+		sort.Sort(diligent.DepsByName(out))
+	case "license":
+		sort.Sort(diligent.DepsByLicense(out))
+	default:
+		return nil, fmt.Errorf("unable to sort dependencies by '%s', expected name or license", by)
+	}
+	return out, nil
+}
+
+func licenseText(identifier string) string {
+	text, _ := classifier.LicenseText(identifier)
+	return text
+}
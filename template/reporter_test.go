@@ -0,0 +1,93 @@
+package template_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent/internal/reporttest"
+	"github.com/senseyeio/diligent/template"
+)
+
+func TestReport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "template")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cases := []struct {
+		d        string
+		filename string
+		template string
+		expected string
+		errors   bool
+	}{
+		{"fields", "report.txt", `{{range .Deps}}{{.Name}} {{.LicenseExpression}};{{end}}`, "d3 MIT;<cypress> MIT OR GPL-3.0;", false},
+		{"warnings", "report.txt", `{{range .Warnings}}{{.Dependency}} {{.Reason}}{{end}}`, "left-pad not-found", false},
+		{"join and sort", "report.txt", `{{join (sort .Manifests) ", "}}`, "Gopkg.lock, package.json", false},
+		{"sort dependencies", "report.txt", `{{range sortDeps "name" .Deps}}{{.Name}};{{end}}`, "<cypress>;d3;", false},
+		{"group by license", "report.txt", `{{range groupByLicense .Deps}}{{.Key}}={{len .Deps}};{{end}}`, "BSD-2-Clause OR MIT=1;GPL-3.0=1;MIT=2;MIT OR GPL-3.0=1;", false},
+		{"group by category", "report.txt", `{{range groupByCategory .Deps}}{{.Key}}={{len .Deps}};{{end}}`, "copyleft=2;permissive=4;", false},
+		{"license text", "report.txt", `{{licenseText "MIT"}}`, "Permission is hereby granted", false},
+		{"html templates escape output", "report.html", `{{range .Deps}}<li>{{.Name}}</li>{{end}}`, "<li>d3</li><li>&lt;cypress&gt;</li>", false},
+		{"text templates do not escape output", "report.md", `{{range .Deps}}{{.Name}} {{end}}`, "d3 <cypress> ", false},
+		{"unknown sort", "report.txt", `{{range sortDeps "age" .Deps}}{{end}}`, "", true},
+	}
+	for _, tt := range cases {
+		t.Run(tt.d, func(t *testing.T) {
+			path := filepath.Join(dir, tt.filename)
+			if err := ioutil.WriteFile(path, []byte(tt.template), 0644); err != nil {
+				t.Fatal(err)
+			}
+			r, err := template.NewReporter(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var b bytes.Buffer
+			err = r.Report(&b, reporttest.Report(t))
+			if tt.errors {
+				if err == nil {
+					t.Error("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(b.String(), tt.expected) {
+				t.Errorf("expected output to contain %q, got %q", tt.expected, b.String())
+			}
+		})
+	}
+}
+
+func TestNewReporterErrors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "template")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	invalid := filepath.Join(dir, "invalid.txt")
+	if err := ioutil.WriteFile(invalid, []byte(`{{range .Deps}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		d    string
+		path string
+	}{
+		{"missing file", filepath.Join(dir, "missing.txt")},
+		{"invalid template", invalid},
+	}
+	for _, tt := range cases {
+		t.Run(tt.d, func(t *testing.T) {
+			if _, err := template.NewReporter(tt.path); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
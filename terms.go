@@ -0,0 +1,129 @@
+package diligent
+
+import (
+	"sort"
+	"strings"
+)
+
+// Permission is something a license allows those receiving the software to do, as described by choosealicense.com
+type Permission string
+
+const (
+	CommercialUse Permission = "commercial-use"
+	Modification  Permission = "modifications"
+	Distribution  Permission = "distribution"
+	PrivateUse    Permission = "private-use"
+	PatentUse     Permission = "patent-use"
+)
+
+// Obligation is something a license requires of those using or distributing the software, known as a condition by
+// choosealicense.com
+type Obligation string
+
+const (
+	IncludeCopyright       Obligation = "include-copyright"
+	IncludeCopyrightSource Obligation = "include-copyright--source"
+	DocumentChanges        Obligation = "document-changes"
+	DiscloseSource         Obligation = "disclose-source"
+	NetworkUseDisclose     Obligation = "network-use-disclose"
+	SameLicense            Obligation = "same-license"
+	SameLicenseFile        Obligation = "same-license--file"
+	SameLicenseLibrary     Obligation = "same-license--library"
+)
+
+// Obligations lists every obligation, in the order they are reported
+var Obligations = []Obligation{
+	IncludeCopyright, IncludeCopyrightSource, DocumentChanges, DiscloseSource, NetworkUseDisclose, SameLicense,
+	SameLicenseFile, SameLicenseLibrary,
+}
+
+var obligationDescriptions = map[Obligation]string{
+	IncludeCopyright:       "Include a copy of the license and copyright notice with the software",
+	IncludeCopyrightSource: "Include a copy of the license and copyright notice with the software when it is distributed as source, but not as binaries",
+	DocumentChanges:        "Document the changes made to the software",
+	DiscloseSource:         "Make the source of the software available when distributing it",
+	NetworkUseDisclose:     "Offer the source of the software to users interacting with it over a network",
+	SameLicense:            "Release modifications under the same license when distributing the software",
+	SameLicenseFile:        "Release modifications to existing files under the same license when distributing the software",
+	SameLicenseLibrary:     "Release modifications under the same license when distributing the software, unless it is only used as a library",
+}
+
+// Description describes what must be done to meet the obligation
+func (o Obligation) Description() string {
+	if d, ok := obligationDescriptions[o]; ok {
+		return d
+	}
+	return string(o)
+}
+
+// Limitation is something a license does not grant, as described by choosealicense.com
+type Limitation string
+
+const (
+	NoTrademarkUse Limitation = "trademark-use"
+	NoLiability    Limitation = "liability"
+	NoPatentUse    Limitation = "patent-use"
+	NoWarranty     Limitation = "warranty"
+)
+
+// Obligations returns the obligations of the dependency's license expression. Every license combined using AND must be
+// met, whereas where licenses are combined using OR the license with the fewest known obligations is assumed to be
+// chosen. The second value is false if the obligations of a license required are not known.
+func (d Dep) Obligations() ([]Obligation, bool) {
+	e, err := ParseExpression(d.LicenseExpression())
+	if err != nil {
+		return nil, false
+	}
+	oo, known := e.obligations()
+	sort.Slice(oo, func(i, j int) bool {
+		return obligationIndex(oo[i]) < obligationIndex(oo[j])
+	})
+	return oo, known
+}
+
+func (e Expression) obligations() ([]Obligation, bool) {
+	switch e.Operator {
+	case And:
+		found := map[Obligation]bool{}
+		out := make([]Obligation, 0)
+		known := true
+		for _, o := range e.Operands {
+			oo, ok := o.obligations()
+			known = known && ok
+			for _, ob := range oo {
+				if !found[ob] {
+					found[ob] = true
+					out = append(out, ob)
+				}
+			}
+		}
+		return out, known
+	case Or:
+		var best []Obligation
+		bestKnown := false
+		for i, o := range e.Operands {
+			oo, ok := o.obligations()
+			if i == 0 || (ok && !bestKnown) || (ok && len(oo) < len(best)) {
+				best, bestKnown = oo, ok
+			}
+		}
+		return best, bestKnown
+	}
+	l, err := GetLicenseFromIdentifier(e.Identifier)
+	if err != nil {
+		l, err = GetLicenseFromIdentifier(strings.TrimSuffix(e.Identifier, "+"))
+	}
+	if err != nil || len(l.Permissions)+len(l.Obligations)+len(l.Limitations) == 0 {
+		return nil, false
+	}
+	return append([]Obligation{}, l.Obligations...), true
+}
+
+func obligationIndex(o Obligation) int {
+	for i, v := range Obligations {
+		if v == o {
+			return i
+		}
+	}
+	return len(Obligations)
+}
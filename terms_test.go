@@ -0,0 +1,50 @@
+package diligent_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+)
+
+func TestDepObligations(t *testing.T) {
+	cases := []struct {
+		d          string
+		expression string
+		out        []diligent.Obligation
+		known      bool
+	}{
+		{"permissive", "MIT", []diligent.Obligation{diligent.IncludeCopyright}, true},
+		{"no obligations", "Unlicense", []diligent.Obligation{}, true},
+		{"or later variant", "GPL-2.0-or-later", []diligent.Obligation{diligent.IncludeCopyright, diligent.DocumentChanges, diligent.DiscloseSource, diligent.SameLicense}, true},
+		{"AND combines obligations", "MPL-2.0 AND Apache-2.0", []diligent.Obligation{diligent.IncludeCopyright, diligent.DocumentChanges, diligent.DiscloseSource, diligent.SameLicenseFile}, true},
+		{"OR chooses fewest obligations", "GPL-3.0 OR MIT", []diligent.Obligation{diligent.IncludeCopyright}, true},
+		{"OR prefers known obligations", "JSON OR AGPL-3.0", []diligent.Obligation{diligent.IncludeCopyright, diligent.DocumentChanges, diligent.DiscloseSource, diligent.NetworkUseDisclose, diligent.SameLicense}, true},
+		{"unknown", "JSON", []diligent.Obligation{}, false},
+		{"AND with unknown", "MIT AND JSON", []diligent.Obligation{diligent.IncludeCopyright}, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			d, err := diligent.NewDep("dep", c.expression)
+			if err != nil {
+				t.Fatal(err)
+			}
+			out, known := d.Obligations()
+			if len(out) == 0 {
+				out = []diligent.Obligation{}
+			}
+			if !reflect.DeepEqual(out, c.out) || known != c.known {
+				t.Errorf("expected %v %v, got %v %v", c.out, c.known, out, known)
+			}
+		})
+	}
+}
+
+func TestObligationDescription(t *testing.T) {
+	for _, o := range diligent.Obligations {
+		if o.Description() == string(o) {
+			t.Errorf("expected obligation %s to be described", o)
+		}
+	}
+}
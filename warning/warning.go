@@ -2,20 +2,57 @@ package warning
 
 import "github.com/senseyeio/diligent"
 
-// New returns a Warning. It includes the name of the dependency and a message describing the problem.
-func New(dependency string, message string) diligent.Warning {
-	return &Warn{
-		Msg: message,
-		Dep: dependency,
+// New returns a Warning. It includes the name of the dependency, the ecosystem it belongs to and the error describing
+// the problem. The reason for the warning is taken from the error, see diligent.WithReason.
+func New(dependency, ecosystem string, err error) diligent.Warning {
+	return NewWithVersion(dependency, "", ecosystem, err)
+}
+
+// NewWithVersion is identical to New but includes the version of the dependency, as specified by the manifest
+func NewWithVersion(dependency, version, ecosystem string, err error) diligent.Warning {
+	return &warn{
+		dependency: dependency,
+		version:    version,
+		ecosystem:  ecosystem,
+		reason:     diligent.ReasonOf(err),
+		err:        err,
 	}
 }
 
-type Warn struct {
-	Msg string
-	Dep string
+type warn struct {
+	dependency string
+	version    string
+	ecosystem  string
+	reason     diligent.Reason
+	err        error
 }
 
 // Warning implements diligent.Warning
-func (w *Warn) Warning() string {
-	return "Failed to determine license for " + w.Dep + ": " + w.Msg
+func (w *warn) Warning() string {
+	return "Failed to determine license for " + w.dependency + ": " + w.err.Error()
+}
+
+// Dependency implements diligent.Warning
+func (w *warn) Dependency() string {
+	return w.dependency
+}
+
+// Version implements diligent.Warning
+func (w *warn) Version() string {
+	return w.version
+}
+
+// Ecosystem implements diligent.Warning
+func (w *warn) Ecosystem() string {
+	return w.ecosystem
+}
+
+// Reason implements diligent.Warning
+func (w *warn) Reason() diligent.Reason {
+	return w.reason
+}
+
+// Err implements diligent.Warning
+func (w *warn) Err() error {
+	return w.err
 }